@@ -0,0 +1,139 @@
+// Copyright 2024 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package antiquary
+
+import (
+	"context"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/kv"
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon/cl/clparams"
+	"github.com/erigontech/erigon/cl/cltypes"
+	"github.com/erigontech/erigon/cl/cltypes/lightclient_utils"
+	"github.com/erigontech/erigon/cl/cltypes/solid"
+	"github.com/erigontech/erigon/cl/persistence/beacon_indicies"
+	"github.com/erigontech/erigon/cl/phase1/core/state"
+	"github.com/erigontech/erigon/turbo/snapshotsync/freezeblocks"
+)
+
+// lightClientUpdatesCollector computes the best light client update of each sync committee period while
+// historical states are reconstructed, so that the light client server can serve periods it has not seen live.
+type lightClientUpdatesCollector struct {
+	cfg      *clparams.BeaconChainConfig
+	snReader freezeblocks.BeaconSnapshotReader
+
+	attestedBlock  *cltypes.SignedBeaconBlock
+	finalizedBlock *cltypes.SignedBeaconBlock
+	finalizedRoot  libcommon.Hash
+	// sync committee period => best update of the period
+	best map[uint64]*cltypes.LightClientUpdate
+}
+
+func newLightClientUpdatesCollector(cfg *clparams.BeaconChainConfig, snReader freezeblocks.BeaconSnapshotReader, attestedBlock *cltypes.SignedBeaconBlock) *lightClientUpdatesCollector {
+	return &lightClientUpdatesCollector{
+		cfg:           cfg,
+		snReader:      snReader,
+		attestedBlock: attestedBlock,
+		best:          make(map[uint64]*cltypes.LightClientUpdate),
+	}
+}
+
+// collect must be called before block is applied to attestedState, that is while attestedState is still the post-state of the parent block.
+func (l *lightClientUpdatesCollector) collect(ctx context.Context, tx kv.Tx, attestedState *state.CachingBeaconState, block *cltypes.SignedBeaconBlock) error {
+	attestedBlock := l.attestedBlock
+	l.attestedBlock = block
+	if attestedBlock == nil || attestedBlock.Version() < clparams.AltairVersion || attestedState.Slot() != attestedBlock.Block.Slot {
+		return nil
+	}
+	if block.Block.Body.SyncAggregate.Sum() < int(l.cfg.MinSyncCommitteeParticipants) {
+		return nil
+	}
+
+	finalizedCheckpoint := attestedState.FinalizedCheckpoint()
+	if finalizedCheckpoint.Root != l.finalizedRoot || l.finalizedBlock == nil {
+		l.finalizedRoot = finalizedCheckpoint.Root
+		l.finalizedBlock = nil
+		if finalizedCheckpoint.Root != (libcommon.Hash{}) {
+			finalizedBlock, err := l.snReader.ReadBlockByRoot(ctx, tx, finalizedCheckpoint.Root)
+			if err != nil {
+				return err
+			}
+			l.finalizedBlock = finalizedBlock
+		}
+	}
+
+	// Ranking updates only depends on whether their branches are set, so the merkle branches are only
+	// computed for the candidates which become the best update of their period.
+	update, err := lightclient_utils.CreateLightClientUpdate(l.cfg, block, l.finalizedBlock, attestedBlock, attestedState.Slot(),
+		attestedState.NextSyncCommittee(), finalizedCheckpoint, pendingBranch, pendingBranch)
+	if err != nil {
+		log.Debug("[Caplin-Archive] Could not create light client update", "slot", block.Block.Slot, "err", err)
+		return nil
+	}
+
+	period := l.cfg.SyncCommitteePeriod(attestedState.Slot())
+	if best, ok := l.best[period]; ok && !lightclient_utils.IsBetterLightClientUpdate(l.cfg, update, best) {
+		return nil
+	}
+
+	if update.NextSyncCommitteeBranch == pendingBranch {
+		nextSyncCommitteeBranch, err := attestedState.NextSyncCommitteeBranch()
+		if err != nil {
+			return err
+		}
+		update.NextSyncCommitteeBranch = hashSliceToHashVector(nextSyncCommitteeBranch)
+	}
+	if update.FinalityBranch == pendingBranch {
+		finalityBranch, err := attestedState.FinalityRootBranch()
+		if err != nil {
+			return err
+		}
+		update.FinalityBranch = hashSliceToHashVector(finalityBranch)
+	}
+	l.best[period] = update
+	return nil
+}
+
+// flush merges the collected updates with the persisted ones, keeping the best of each period.
+func (l *lightClientUpdatesCollector) flush(tx kv.RwTx) error {
+	for period, update := range l.best {
+		persisted, err := beacon_indicies.ReadLightClientUpdate(tx, period)
+		if err != nil {
+			return err
+		}
+		if persisted != nil && !lightclient_utils.IsBetterLightClientUpdate(l.cfg, update, persisted) {
+			continue
+		}
+		if err := beacon_indicies.WriteLightClientUpdate(tx, period, update); err != nil {
+			return err
+		}
+	}
+	clear(l.best)
+	return nil
+}
+
+// pendingBranch stands for a branch which is set but not computed yet.
+var pendingBranch = hashSliceToHashVector([][32]byte{{1}})
+
+func hashSliceToHashVector(hashes [][32]byte) solid.HashVectorSSZ {
+	vector := solid.NewHashVector(len(hashes))
+	for i, h := range hashes {
+		vector.Set(i, h)
+	}
+	return vector
+}
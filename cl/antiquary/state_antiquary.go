@@ -249,6 +249,14 @@ func (s *Antiquary) IncrementBeaconState(ctx context.Context, to uint64) error {
 	// Use this as the event slot (it will be incremented by 1 each time we process a block)
 	slot := s.currentState.Slot() + 1
 
+	var attestedBlock *cltypes.SignedBeaconBlock
+	if s.currentState.Version() >= clparams.AltairVersion {
+		if attestedBlock, err = s.snReader.ReadBlockBySlot(ctx, tx, s.currentState.Slot()); err != nil {
+			return err
+		}
+	}
+	lightClientUpdates := newLightClientUpdatesCollector(s.cfg, s.snReader, attestedBlock)
+
 	var prevValSet []byte
 	events := state_accessors.NewStateEvents()
 	slashingOccured := false
@@ -383,6 +391,10 @@ func (s *Antiquary) IncrementBeaconState(ctx context.Context, to uint64) error {
 		prevValSet = prevValSet[:0]
 		prevValSet = append(prevValSet, s.currentState.RawValidatorSet()...)
 
+		if err := lightClientUpdates.collect(ctx, tx, s.currentState, block); err != nil {
+			return err
+		}
+
 		fullValidation := slot%1000 == 0 || first
 		blockRewardsCollector := &eth2.BlockRewardsCollector{}
 		// We sanity check the state every 1k slots or when we start.
@@ -467,6 +479,9 @@ func (s *Antiquary) IncrementBeaconState(ctx context.Context, to uint64) error {
 	if err := stateAntiquaryCollector.flush(ctx, rwTx); err != nil {
 		return err
 	}
+	if err := lightClientUpdates.flush(rwTx); err != nil {
+		return err
+	}
 
 	if err := state_accessors.SetStateProcessingProgress(rwTx, s.currentState.Slot()); err != nil {
 		return err
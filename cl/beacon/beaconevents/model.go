@@ -48,6 +48,12 @@ const (
 	StatePayloadAttributes           EventTopic = "payload_attributes"
 )
 
+// Validator event topics, computed once per epoch for the validators a subscriber is interested in
+const (
+	ValidatorAttestationRewards EventTopic = "attestation_rewards"
+	ValidatorMissedDuties       EventTopic = "missed_duties"
+)
+
 // State event data types
 type HeadData struct {
 	Slot                      uint64      `json:"slot,string"`
@@ -92,6 +92,11 @@ func (a *ApiHandler) PostEthV1BeaconRewardsAttestations(w http.ResponseWriter, r
 	if err != nil {
 		return nil, err
 	}
+	return a.computeAttestationsRewards(tx, epoch, filterIndicies)
+}
+
+// computeAttestationsRewards computes the attestation rewards of the epoch before the given one, restricted to filterIndicies if not empty.
+func (a *ApiHandler) computeAttestationsRewards(tx kv.Tx, epoch uint64, filterIndicies []uint64) (*beaconhttp.BeaconResponse, error) {
 	_, headSlot, statusCode, err := a.getHead()
 	if err != nil {
 		return nil, beaconhttp.NewEndpointError(statusCode, err)
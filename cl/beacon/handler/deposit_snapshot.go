@@ -0,0 +1,33 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/erigontech/erigon/cl/beacon/beaconhttp"
+)
+
+// GetEthV1BeaconDepositSnapshot serves the EIP-4881 snapshot of the finalized deposit tree.
+func (a *ApiHandler) GetEthV1BeaconDepositSnapshot(w http.ResponseWriter, r *http.Request) (*beaconhttp.BeaconResponse, error) {
+	snapshot := a.forkchoiceStore.GetDepositSnapshot()
+	if snapshot == nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusNotFound, errors.New("no finalized deposit snapshot available"))
+	}
+	return newBeaconResponse(snapshot), nil
+}
@@ -45,6 +45,9 @@ var validTopics = map[event.EventTopic]struct{}{
 	event.StateHead:                        {},
 	event.StateLightClientOptimisticUpdate: {},
 	event.StatePayloadAttributes:           {},
+	// validator events
+	event.ValidatorAttestationRewards: {},
+	event.ValidatorMissedDuties:       {},
 }
 
 func (a *ApiHandler) EventSourceGetV1Events(w http.ResponseWriter, r *http.Request) {
@@ -66,9 +69,39 @@ func (a *ApiHandler) EventSourceGetV1Events(w http.ResponseWriter, r *http.Reque
 		}
 		subscribeTopics.Add(topic)
 	}
+	var (
+		wantRewards      = subscribeTopics.Contains(event.ValidatorAttestationRewards)
+		wantMissedDuties = subscribeTopics.Contains(event.ValidatorMissedDuties)
+		// the first summaries are sent when the head enters the next epoch
+		lastSummaryEpoch = a.syncedData.HeadSlot() / a.beaconChainCfg.SlotsPerEpoch
+	)
+	validatorIndicies, err := a.parseEventsValidatorIndicies(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if wantMissedDuties && len(validatorIndicies) == 0 {
+		http.Error(w, "validator_indices is required for the missed_duties topic", http.StatusBadRequest)
+		return
+	}
 	log.Info("Subscribed to event stream topics", "topics", subscribeTopics)
 
+	writeEvent := func(e *event.EventStream) {
+		// marshal and send
+		buf, err := json.Marshal(e.Data)
+		if err != nil {
+			log.Warn("failed to encode data", "err", err, "topic", e.Event)
+			return
+		}
+		if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Event, string(buf)); err != nil {
+			log.Warn("failed to write event", "err", err)
+			return
+		}
+		w.(http.Flusher).Flush()
+	}
+
 	eventCh := make(chan *event.EventStream, 128)
+	summaryCh := make(chan []*event.EventStream, 1)
 	opSub := a.emitters.Operation().Subscribe(eventCh)
 	stateSub := a.emitters.State().Subscribe(eventCh)
 	defer opSub.Unsubscribe()
@@ -80,6 +113,25 @@ func (a *ApiHandler) EventSourceGetV1Events(w http.ResponseWriter, r *http.Reque
 	for {
 		select {
 		case e := <-eventCh:
+			if head, ok := e.Data.(*event.HeadData); ok && e.Event == event.StateHead && (wantRewards || wantMissedDuties) {
+				// per-epoch summaries are sent for the first head of each epoch, they are computed once for all subscribers.
+				headEpoch := head.Slot / a.beaconChainCfg.SlotsPerEpoch
+				// They are computed in the background, the events keep being streamed meanwhile.
+				if headEpoch > lastSummaryEpoch {
+					lastSummaryEpoch = headEpoch
+					go func() {
+						summaries, err := a.epochSummaryEvents(r.Context(), headEpoch, wantRewards, wantMissedDuties, validatorIndicies)
+						if err != nil {
+							log.Warn("failed to compute epoch summary events", "epoch", headEpoch, "err", err)
+							return
+						}
+						select {
+						case summaryCh <- summaries:
+						case <-r.Context().Done():
+						}
+					}()
+				}
+			}
 			if !subscribeTopics.Contains(e.Event) {
 				continue
 			}
@@ -87,17 +139,11 @@ func (a *ApiHandler) EventSourceGetV1Events(w http.ResponseWriter, r *http.Reque
 				log.Warn("event data is nil", "event", e)
 				continue
 			}
-			// marshal and send
-			buf, err := json.Marshal(e.Data)
-			if err != nil {
-				log.Warn("failed to encode data", "err", err, "topic", e.Event)
-				continue
+			writeEvent(e)
+		case summaries := <-summaryCh:
+			for _, summary := range summaries {
+				writeEvent(summary)
 			}
-			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Event, string(buf)); err != nil {
-				log.Warn("failed to write event", "err", err)
-				continue
-			}
-			w.(http.Flusher).Flush()
 		case <-ticker.C:
 			// keep connection alive
 			if _, err := w.Write([]byte(":\n\n")); err != nil {
@@ -0,0 +1,396 @@
+// Copyright 2024 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/kv"
+	event "github.com/erigontech/erigon/cl/beacon/beaconevents"
+	"github.com/erigontech/erigon/cl/clparams"
+	"github.com/erigontech/erigon/cl/persistence/beacon_indicies"
+	"github.com/erigontech/erigon/cl/phase1/core/state"
+	"github.com/erigontech/erigon/cl/transition"
+	"github.com/erigontech/erigon/cl/utils"
+)
+
+type attestationRewardsEvent struct {
+	Epoch        uint64        `json:"epoch,string"`
+	IdealRewards []IdealReward `json:"ideal_rewards"`
+	TotalRewards []TotalReward `json:"total_rewards"`
+}
+
+type missedProposal struct {
+	ValidatorIndex uint64 `json:"validator_index,string"`
+	Slot           uint64 `json:"slot,string"`
+}
+
+type missedSyncDuty struct {
+	ValidatorIndex uint64   `json:"validator_index,string"`
+	Slots          []uint64 `json:"slots"`
+}
+
+type missedDutiesEvent struct {
+	Epoch            uint64           `json:"epoch,string"`
+	MissedProposals  []missedProposal `json:"missed_proposals"`
+	MissedSyncDuties []missedSyncDuty `json:"missed_sync_duties"`
+}
+
+// parseEventsValidatorIndicies parses the validator_indices query parameter of the event stream, which can be repeated
+// and/or comma separated, and accepts both indicies and public keys.
+func (a *ApiHandler) parseEventsValidatorIndicies(r *http.Request) ([]uint64, error) {
+	ids := []string{}
+	for _, v := range r.URL.Query()["validator_indices"] {
+		for _, id := range strings.Split(v, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return parseQueryValidatorIndicies(a.syncedData, ids)
+}
+
+// epochSummaries shares the per-epoch validator summaries between the event stream subscribers: each summary is
+// computed at most once per epoch, for all the validators, and then filtered for each subscriber. Failed computations
+// are not kept, so that the next subscriber retries them.
+type epochSummaries struct {
+	mu    sync.Mutex
+	epoch uint64
+
+	rewards      *summaryCall[*attestationRewardsEvent]
+	missedDuties *summaryCall[*missedDutiesEvent]
+
+	computeRewards      func(ctx context.Context, headEpoch uint64) (*attestationRewardsEvent, error)
+	computeMissedDuties func(ctx context.Context, headEpoch uint64) (*missedDutiesEvent, error)
+}
+
+// summaryCall is a summary computation, shared by all the subscribers waiting for it.
+type summaryCall[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+func newEpochSummaries(
+	computeRewards func(ctx context.Context, headEpoch uint64) (*attestationRewardsEvent, error),
+	computeMissedDuties func(ctx context.Context, headEpoch uint64) (*missedDutiesEvent, error),
+) *epochSummaries {
+	return &epochSummaries{
+		computeRewards:      computeRewards,
+		computeMissedDuties: computeMissedDuties,
+	}
+}
+
+// get returns the summaries for the head entering headEpoch, the ones that are not wanted are nil. Subscribers
+// lagging behind the latest computed epoch get nothing, as only the summaries of the latest epoch are kept.
+// The summaries are computed in the background, detached from ctx, which only bounds the wait for them.
+func (e *epochSummaries) get(ctx context.Context, headEpoch uint64, rewards, missedDuties bool) (*attestationRewardsEvent, *missedDutiesEvent, error) {
+	e.mu.Lock()
+	if headEpoch < e.epoch {
+		e.mu.Unlock()
+		return nil, nil, nil
+	}
+	if headEpoch > e.epoch {
+		e.epoch = headEpoch
+		e.rewards, e.missedDuties = nil, nil
+	}
+	var (
+		rewardsCall      *summaryCall[*attestationRewardsEvent]
+		missedDutiesCall *summaryCall[*missedDutiesEvent]
+	)
+	if rewards {
+		rewardsCall = startSummaryCall(ctx, &e.rewards, headEpoch, e.computeRewards)
+	}
+	if missedDuties {
+		missedDutiesCall = startSummaryCall(ctx, &e.missedDuties, headEpoch, e.computeMissedDuties)
+	}
+	e.mu.Unlock()
+
+	var (
+		rewardsEvent      *attestationRewardsEvent
+		missedDutiesEvent *missedDutiesEvent
+		err               error
+	)
+	if rewardsCall != nil {
+		if rewardsEvent, err = waitSummaryCall(ctx, &e.mu, &e.rewards, rewardsCall); err != nil {
+			return nil, nil, err
+		}
+	}
+	if missedDutiesCall != nil {
+		if missedDutiesEvent, err = waitSummaryCall(ctx, &e.mu, &e.missedDuties, missedDutiesCall); err != nil {
+			return nil, nil, err
+		}
+	}
+	return rewardsEvent, missedDutiesEvent, nil
+}
+
+// startSummaryCall returns the computation of the summary kept in call, starting it if there is none. It must be
+// called with the epochSummaries lock held.
+func startSummaryCall[T any](ctx context.Context, call **summaryCall[T], headEpoch uint64, compute func(context.Context, uint64) (T, error)) *summaryCall[T] {
+	if *call != nil {
+		return *call
+	}
+	c := &summaryCall[T]{done: make(chan struct{})}
+	*call = c
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer close(c.done)
+		c.value, c.err = compute(ctx, headEpoch)
+	}()
+	return c
+}
+
+// waitSummaryCall waits for the computation c of the summary kept in call and drops it if it failed.
+func waitSummaryCall[T any](ctx context.Context, mu *sync.Mutex, call **summaryCall[T], c *summaryCall[T]) (T, error) {
+	select {
+	case <-c.done:
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+	if c.err != nil {
+		mu.Lock()
+		if *call == c {
+			*call = nil
+		}
+		mu.Unlock()
+	}
+	return c.value, c.err
+}
+
+// filter returns the rewards of the given validators, all of them if filterIndicies is empty.
+func (r *attestationRewardsEvent) filter(filterIndicies []uint64) *attestationRewardsEvent {
+	if r == nil || len(filterIndicies) == 0 {
+		return r
+	}
+	filtered := &attestationRewardsEvent{
+		Epoch:        r.Epoch,
+		IdealRewards: r.IdealRewards,
+		TotalRewards: []TotalReward{},
+	}
+	for _, reward := range r.TotalRewards {
+		if slices.Contains(filterIndicies, uint64(reward.ValidatorIndex)) {
+			filtered.TotalRewards = append(filtered.TotalRewards, reward)
+		}
+	}
+	return filtered
+}
+
+// filter returns the duties missed by the given validators.
+func (m *missedDutiesEvent) filter(filterIndicies []uint64) *missedDutiesEvent {
+	if m == nil {
+		return nil
+	}
+	filtered := &missedDutiesEvent{
+		Epoch:            m.Epoch,
+		MissedProposals:  []missedProposal{},
+		MissedSyncDuties: []missedSyncDuty{},
+	}
+	for _, proposal := range m.MissedProposals {
+		if slices.Contains(filterIndicies, proposal.ValidatorIndex) {
+			filtered.MissedProposals = append(filtered.MissedProposals, proposal)
+		}
+	}
+	for _, duty := range m.MissedSyncDuties {
+		if slices.Contains(filterIndicies, duty.ValidatorIndex) {
+			filtered.MissedSyncDuties = append(filtered.MissedSyncDuties, duty)
+		}
+	}
+	return filtered
+}
+
+// epochSummaryEvents returns the per-epoch validator events requested by a subscriber once the head enters headEpoch:
+// the attestation rewards of headEpoch-2 (the last epoch whose attestations can no longer be included) and the
+// duties missed during headEpoch-1.
+func (a *ApiHandler) epochSummaryEvents(ctx context.Context, headEpoch uint64, rewards, missedDuties bool, filterIndicies []uint64) ([]*event.EventStream, error) {
+	rewardsSummary, missedDutiesSummary, err := a.epochSummaries.get(ctx, headEpoch, rewards, missedDuties)
+	if err != nil {
+		return nil, err
+	}
+	events := []*event.EventStream{}
+	if rewardsSummary != nil {
+		events = append(events, &event.EventStream{
+			Event: event.ValidatorAttestationRewards,
+			Data:  rewardsSummary.filter(filterIndicies),
+		})
+	}
+	if missed := missedDutiesSummary.filter(filterIndicies); missed != nil && (len(missed.MissedProposals) > 0 || len(missed.MissedSyncDuties) > 0) {
+		events = append(events, &event.EventStream{
+			Event: event.ValidatorMissedDuties,
+			Data:  missed,
+		})
+	}
+	return events, nil
+}
+
+// computeRewardsSummary computes the attestation rewards of all the validators for headEpoch-2.
+func (a *ApiHandler) computeRewardsSummary(ctx context.Context, headEpoch uint64) (*attestationRewardsEvent, error) {
+	if headEpoch < 2 {
+		return nil, nil
+	}
+	tx, err := a.indiciesDB.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	resp, err := a.computeAttestationsRewards(tx, headEpoch-1, nil)
+	if err != nil {
+		return nil, err
+	}
+	data, ok := resp.Data.(*attestationsRewardsResponse)
+	if !ok {
+		return nil, errors.New("unexpected attestation rewards response")
+	}
+	return &attestationRewardsEvent{
+		Epoch:        headEpoch - 2,
+		IdealRewards: data.IdealRewards,
+		TotalRewards: data.TotalRewards,
+	}, nil
+}
+
+// computeMissedDutiesSummary computes the duties missed by all the validators during headEpoch-1.
+func (a *ApiHandler) computeMissedDutiesSummary(ctx context.Context, headEpoch uint64) (*missedDutiesEvent, error) {
+	if headEpoch < 2 {
+		return nil, nil
+	}
+	tx, err := a.indiciesDB.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	return a.computeMissedDuties(ctx, tx, headEpoch-1)
+}
+
+// epochState returns a state of the given epoch, so that the proposers are computed with the effective balances and
+// the randao mixes of the epoch: the post-state of its last canonical block, or the post-state of the last canonical
+// block before the epoch advanced to the epoch start if no block was proposed during the epoch.
+func (a *ApiHandler) epochState(tx kv.Tx, epoch uint64) (*state.CachingBeaconState, error) {
+	startSlot := epoch * a.beaconChainCfg.SlotsPerEpoch
+	lowestSlot := a.forkchoiceStore.LowestAvailableSlot()
+	for slot := startSlot + a.beaconChainCfg.SlotsPerEpoch; slot > lowestSlot; slot-- {
+		blockRoot, err := beacon_indicies.ReadCanonicalBlockRoot(tx, slot-1)
+		if err != nil {
+			return nil, err
+		}
+		if blockRoot == (libcommon.Hash{}) {
+			continue
+		}
+		s, err := a.forkchoiceStore.GetStateAtBlockRoot(blockRoot, true)
+		if err != nil {
+			return nil, err
+		}
+		if s == nil {
+			return nil, fmt.Errorf("no state for block %x", blockRoot)
+		}
+		if s.Slot() < startSlot {
+			if err := transition.DefaultMachine.ProcessSlots(s, startSlot); err != nil {
+				return nil, err
+			}
+		}
+		return s, nil
+	}
+	return nil, fmt.Errorf("no state available for epoch %d", epoch)
+}
+
+// computeMissedDuties finds the canonical slots of epoch without a block, along with their proposers, and the sync
+// committee messages that did not make it into the sync aggregates of the epoch.
+func (a *ApiHandler) computeMissedDuties(ctx context.Context, tx kv.Tx, epoch uint64) (*missedDutiesEvent, error) {
+	resp := &missedDutiesEvent{
+		Epoch:            epoch,
+		MissedProposals:  []missedProposal{},
+		MissedSyncDuties: []missedSyncDuty{},
+	}
+	s, err := a.epochState(tx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	missedSyncSlots := make(map[uint64][]uint64)
+
+	// sync committee period => validator index => positions in the sync committee
+	syncPositions := make(map[uint64]map[uint64][]int)
+	positionsAtPeriod := func(period uint64) map[uint64][]int {
+		if positions, ok := syncPositions[period]; ok {
+			return positions
+		}
+		positions := make(map[uint64][]int)
+		if committee, _, ok := a.forkchoiceStore.GetSyncCommittees(period); ok {
+			for i, pk := range committee.GetCommittee() {
+				if idx, ok := s.ValidatorIndexByPubkey(pk); ok {
+					positions[idx] = append(positions[idx], i)
+				}
+			}
+		}
+		syncPositions[period] = positions
+		return positions
+	}
+
+	startSlot := epoch * a.beaconChainCfg.SlotsPerEpoch
+	for slot := startSlot; slot < startSlot+a.beaconChainCfg.SlotsPerEpoch; slot++ {
+		if slot == a.beaconChainCfg.GenesisSlot {
+			continue
+		}
+		blockRoot, err := beacon_indicies.ReadCanonicalBlockRoot(tx, slot)
+		if err != nil {
+			return nil, err
+		}
+		if blockRoot == (libcommon.Hash{}) {
+			proposerIndex, err := s.GetBeaconProposerIndexForSlot(slot)
+			if err != nil {
+				return nil, err
+			}
+			resp.MissedProposals = append(resp.MissedProposals, missedProposal{ValidatorIndex: proposerIndex, Slot: slot})
+			continue
+		}
+		if a.beaconChainCfg.GetCurrentStateVersion(epoch) < clparams.AltairVersion {
+			continue
+		}
+		// the sync aggregate of a block carries the sync committee messages of the previous slot.
+		positions := positionsAtPeriod(a.beaconChainCfg.SyncCommitteePeriod(slot - 1))
+		if len(positions) == 0 {
+			continue
+		}
+		block, err := a.blockReader.ReadBlockByRoot(ctx, tx, blockRoot)
+		if err != nil {
+			return nil, err
+		}
+		if block == nil || block.Version() < clparams.AltairVersion {
+			continue
+		}
+		bits := block.Block.Body.SyncAggregate.SyncCommiteeBits
+		for idx, validatorPositions := range positions {
+			for _, position := range validatorPositions {
+				if !utils.IsBitOn(bits[:], position) {
+					missedSyncSlots[idx] = append(missedSyncSlots[idx], slot-1)
+					break
+				}
+			}
+		}
+	}
+	for _, idx := range slices.Sorted(maps.Keys(missedSyncSlots)) {
+		resp.MissedSyncDuties = append(resp.MissedSyncDuties, missedSyncDuty{ValidatorIndex: idx, Slots: missedSyncSlots[idx]})
+	}
+	return resp, nil
+}
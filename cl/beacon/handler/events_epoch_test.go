@@ -0,0 +1,167 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEpochSummariesComputedOncePerEpoch(t *testing.T) {
+	var rewardsCalls, missedDutiesCalls atomic.Int32
+	summaries := newEpochSummaries(
+		func(_ context.Context, headEpoch uint64) (*attestationRewardsEvent, error) {
+			rewardsCalls.Add(1)
+			return &attestationRewardsEvent{
+				Epoch: headEpoch - 2,
+				TotalRewards: []TotalReward{
+					{ValidatorIndex: 1, Head: 10},
+					{ValidatorIndex: 2, Head: 20},
+					{ValidatorIndex: 3, Head: 30},
+				},
+			}, nil
+		},
+		func(_ context.Context, headEpoch uint64) (*missedDutiesEvent, error) {
+			missedDutiesCalls.Add(1)
+			return &missedDutiesEvent{
+				Epoch:            headEpoch - 1,
+				MissedProposals:  []missedProposal{{ValidatorIndex: 2, Slot: 100}},
+				MissedSyncDuties: []missedSyncDuty{{ValidatorIndex: 3, Slots: []uint64{99}}},
+			}, nil
+		},
+	)
+
+	// many subscribers entering the same epoch share the same computation
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rewards, missed, err := summaries.get(context.Background(), 5, true, true)
+			require.NoError(t, err)
+			require.NotNil(t, rewards)
+			require.NotNil(t, missed)
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int32(1), rewardsCalls.Load())
+	require.Equal(t, int32(1), missedDutiesCalls.Load())
+
+	// summaries nobody asked for are not computed
+	rewards, missed, err := summaries.get(context.Background(), 6, true, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), rewards.Epoch)
+	require.Nil(t, missed)
+	require.Equal(t, int32(2), rewardsCalls.Load())
+	require.Equal(t, int32(1), missedDutiesCalls.Load())
+
+	// a subscriber lagging behind gets nothing
+	rewards, missed, err = summaries.get(context.Background(), 5, true, true)
+	require.NoError(t, err)
+	require.Nil(t, rewards)
+	require.Nil(t, missed)
+}
+
+func TestEpochSummariesError(t *testing.T) {
+	var calls atomic.Int32
+	summaries := newEpochSummaries(
+		func(context.Context, uint64) (*attestationRewardsEvent, error) {
+			if calls.Add(1) == 1 {
+				return nil, errors.New("no validator set found for this epoch")
+			}
+			return &attestationRewardsEvent{Epoch: 3}, nil
+		},
+		func(context.Context, uint64) (*missedDutiesEvent, error) {
+			return &missedDutiesEvent{}, nil
+		},
+	)
+	_, _, err := summaries.get(context.Background(), 5, true, true)
+	require.Error(t, err)
+	_, missed, err := summaries.get(context.Background(), 5, false, true)
+	require.NoError(t, err)
+	require.NotNil(t, missed)
+
+	// failures are not kept, the next subscriber computes the summary again
+	rewards, _, err := summaries.get(context.Background(), 5, true, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), rewards.Epoch)
+	_, _, err = summaries.get(context.Background(), 5, true, false)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestEpochSummariesSubscriberGone(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	summaries := newEpochSummaries(
+		func(ctx context.Context, headEpoch uint64) (*attestationRewardsEvent, error) {
+			calls.Add(1)
+			<-release
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			return &attestationRewardsEvent{Epoch: headEpoch - 2}, nil
+		},
+		func(context.Context, uint64) (*missedDutiesEvent, error) {
+			return &missedDutiesEvent{}, nil
+		},
+	)
+
+	// the computation outlives the subscriber which started it
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _, err := summaries.get(ctx, 5, true, false)
+	require.ErrorIs(t, err, context.Canceled)
+
+	close(release)
+	rewards, _, err := summaries.get(context.Background(), 5, true, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), rewards.Epoch)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestEpochSummaryEventsFilter(t *testing.T) {
+	rewards := &attestationRewardsEvent{
+		Epoch:        3,
+		IdealRewards: []IdealReward{{EffectiveBalance: 32000000000, Head: 10}},
+		TotalRewards: []TotalReward{{ValidatorIndex: 1, Head: 10}, {ValidatorIndex: 2, Head: 20}},
+	}
+	require.Equal(t, rewards, rewards.filter(nil))
+	filtered := rewards.filter([]uint64{2})
+	require.Equal(t, rewards.IdealRewards, filtered.IdealRewards)
+	require.Equal(t, []TotalReward{{ValidatorIndex: 2, Head: 20}}, filtered.TotalRewards)
+
+	missed := &missedDutiesEvent{
+		Epoch:            4,
+		MissedProposals:  []missedProposal{{ValidatorIndex: 2, Slot: 130}, {ValidatorIndex: 7, Slot: 131}},
+		MissedSyncDuties: []missedSyncDuty{{ValidatorIndex: 3, Slots: []uint64{129}}},
+	}
+	require.Equal(t, &missedDutiesEvent{
+		Epoch:            4,
+		MissedProposals:  []missedProposal{{ValidatorIndex: 7, Slot: 131}},
+		MissedSyncDuties: []missedSyncDuty{{ValidatorIndex: 3, Slots: []uint64{129}}},
+	}, missed.filter([]uint64{3, 7}))
+	require.Empty(t, missed.filter([]uint64{5}).MissedProposals)
+
+	var none *missedDutiesEvent
+	require.Nil(t, none.filter([]uint64{1}))
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/erigontech/erigon/cl/clparams"
 	"github.com/erigontech/erigon/cl/cltypes"
 	"github.com/erigontech/erigon/cl/cltypes/solid"
+	"github.com/erigontech/erigon/cl/monitor"
 	"github.com/erigontech/erigon/cl/persistence/blob_storage"
 	"github.com/erigontech/erigon/cl/persistence/state/historical_states_reader"
 	"github.com/erigontech/erigon/cl/phase1/core/state/lru"
@@ -86,6 +87,7 @@ type ApiHandler struct {
 	// caches
 	lighthouseInclusionCache sync.Map
 	emitters                 *beaconevents.EventEmitter
+	epochSummaries           *epochSummaries
 
 	routerCfg *beacon_router_configuration.RouterConfiguration
 	logger    log.Logger
@@ -110,6 +112,7 @@ type ApiHandler struct {
 	proposerSlashingService          services.ProposerSlashingService
 	builderClient                    builder.BuilderClient
 	enableMemoizedHeadState          bool
+	validatorMonitor                 monitor.ValidatorMonitor
 }
 
 func NewApiHandler(
@@ -145,6 +148,7 @@ func NewApiHandler(
 	builderClient builder.BuilderClient,
 	caplinStateSnapshots *snapshotsync.CaplinStateSnapshots,
 	enableMemoizedHeadState bool,
+	validatorMonitor monitor.ValidatorMonitor,
 ) *ApiHandler {
 	blobBundles, err := lru.New[common.Bytes48, BlobBundle]("blobs", maxBlobBundleCacheSize)
 	if err != nil {
@@ -154,7 +158,7 @@ func NewApiHandler(
 	if err != nil {
 		panic(err)
 	}
-	return &ApiHandler{
+	a := &ApiHandler{
 		logger:                             logger,
 		validatorParams:                    validatorParams,
 		o:                                  sync.Once{},
@@ -193,7 +197,10 @@ func NewApiHandler(
 		proposerSlashingService:          proposerSlashingService,
 		builderClient:                    builderClient,
 		enableMemoizedHeadState:          enableMemoizedHeadState,
+		validatorMonitor:                 validatorMonitor,
 	}
+	a.epochSummaries = newEpochSummaries(a.computeRewardsSummary, a.computeMissedDutiesSummary)
+	return a
 }
 
 func (a *ApiHandler) Init() {
@@ -235,6 +242,7 @@ func (a *ApiHandler) init() {
 
 			if a.routerCfg.Debug {
 				r.Get("/debug/fork_choice", a.GetEthV1DebugBeaconForkChoice)
+				r.Get("/debug/validator_monitor", beaconhttp.HandleEndpointFunc(a.GetEthV1DebugValidatorMonitor))
 			}
 			if a.routerCfg.Config {
 				r.Route("/config", func(r chi.Router) {
@@ -264,6 +272,7 @@ func (a *ApiHandler) init() {
 						r.Get("/{block_id}/root", beaconhttp.HandleEndpointFunc(a.GetEthV1BeaconBlockRoot))
 					})
 					r.Get("/genesis", beaconhttp.HandleEndpointFunc(a.GetEthV1BeaconGenesis))
+					r.Get("/deposit_snapshot", beaconhttp.HandleEndpointFunc(a.GetEthV1BeaconDepositSnapshot))
 					r.Get("/blinded_blocks/{block_id}", beaconhttp.HandleEndpointFunc(a.GetEthV1BlindedBlock))
 					r.Route("/pool", func(r chi.Router) {
 						r.Get("/voluntary_exits", beaconhttp.HandleEndpointFunc(a.GetEthV1BeaconPoolVoluntaryExits))
@@ -316,6 +325,8 @@ func (a *ApiHandler) init() {
 					r.Post("/aggregate_and_proofs", a.PostEthV1ValidatorAggregatesAndProof)
 					r.Post("/beacon_committee_subscriptions", a.PostEthV1ValidatorBeaconCommitteeSubscription)
 					r.Post("/sync_committee_subscriptions", a.PostEthV1ValidatorSyncCommitteeSubscriptions)
+					r.Post("/beacon_committee_selections", beaconhttp.HandleEndpointFunc(a.PostEthV1ValidatorBeaconCommitteeSelections))
+					r.Post("/sync_committee_selections", beaconhttp.HandleEndpointFunc(a.PostEthV1ValidatorSyncCommitteeSelections))
 					r.Get("/sync_committee_contribution", beaconhttp.HandleEndpointFunc(a.GetEthV1ValidatorSyncCommitteeContribution))
 					r.Post("/contribution_and_proofs", a.PostEthV1ValidatorContributionsAndProofs)
 					r.Post("/prepare_beacon_proposer", a.PostEthV1ValidatorPrepareBeaconProposal)
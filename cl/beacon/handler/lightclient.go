@@ -24,6 +24,7 @@ import (
 	"github.com/erigontech/erigon/cl/beacon/beaconhttp"
 	"github.com/erigontech/erigon/cl/clparams"
 	"github.com/erigontech/erigon/cl/cltypes"
+	"github.com/erigontech/erigon/cl/persistence/beacon_indicies"
 )
 
 func (a *ApiHandler) GetEthV1BeaconLightClientBootstrap(w http.ResponseWriter, r *http.Request) (*beaconhttp.BeaconResponse, error) {
@@ -99,6 +100,13 @@ func (a *ApiHandler) GetEthV1BeaconLightClientUpdates(w http.ResponseWriter, r *
 		return
 	}
 
+	tx, err := a.indiciesDB.BeginRo(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
 	resp := []interface{}{}
 	endPeriod := *startPeriod + *count
 	currentSlot := a.ethClock.GetCurrentSlot()
@@ -110,8 +118,13 @@ func (a *ApiHandler) GetEthV1BeaconLightClientUpdates(w http.ResponseWriter, r *
 	// Fetch from [start_period, start_period + count]
 	for i := *startPeriod; i <= endPeriod; i++ {
 		respUpdate := map[string]interface{}{}
-		update, has := a.forkchoiceStore.GetLightClientUpdate(i)
-		if !has {
+		live, _ := a.forkchoiceStore.GetLightClientUpdate(i)
+		update, err := beacon_indicies.ReadBestLightClientUpdate(tx, a.beaconChainCfg, i, live)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if update == nil {
 			notFoundPrev = true
 			continue
 		}
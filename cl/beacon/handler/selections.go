@@ -0,0 +1,87 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/erigontech/erigon/cl/beacon/beaconhttp"
+	"github.com/erigontech/erigon/cl/cltypes"
+	"github.com/erigontech/erigon/cl/phase1/core/state"
+	"github.com/erigontech/erigon/cl/validator/committee_subscription"
+	"github.com/erigontech/erigon/cl/validator/sync_contribution_pool"
+)
+
+// PostEthV1ValidatorBeaconCommitteeSelections is used by distributed validator middlewares to hand over combined
+// slot signatures. Each valid proof is taken into account for the aggregation duties of the node and echoed back.
+func (a *ApiHandler) PostEthV1ValidatorBeaconCommitteeSelections(w http.ResponseWriter, r *http.Request) (*beaconhttp.BeaconResponse, error) {
+	req := []*cltypes.BeaconCommitteeSelection{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, err)
+	}
+	if len(req) == 0 {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, errors.New("empty request"))
+	}
+	if a.syncedData.Syncing() {
+		return nil, beaconhttp.NewEndpointError(http.StatusServiceUnavailable, errors.New("beacon node is syncing"))
+	}
+	for i, selection := range req {
+		if _, err := a.committeeSub.AddBeaconCommitteeSelection(r.Context(), selection); err != nil {
+			if errors.Is(err, committee_subscription.ErrInvalidSelectionProof) ||
+				errors.Is(err, committee_subscription.ErrValidatorNotInCommittee) ||
+				errors.Is(err, committee_subscription.ErrValidatorIndexOutOfRange) {
+				return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, fmt.Errorf("selection %d: %w", i, err))
+			}
+			return nil, beaconhttp.NewEndpointError(http.StatusInternalServerError, err)
+		}
+	}
+	return newBeaconResponse(req), nil
+}
+
+// PostEthV1ValidatorSyncCommitteeSelections is the sync committee counterpart of PostEthV1ValidatorBeaconCommitteeSelections.
+func (a *ApiHandler) PostEthV1ValidatorSyncCommitteeSelections(w http.ResponseWriter, r *http.Request) (*beaconhttp.BeaconResponse, error) {
+	req := []*cltypes.SyncCommitteeSelection{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, err)
+	}
+	if len(req) == 0 {
+		return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, errors.New("empty request"))
+	}
+	if a.syncedData.Syncing() {
+		return nil, beaconhttp.NewEndpointError(http.StatusServiceUnavailable, errors.New("beacon node is syncing"))
+	}
+	if err := a.syncedData.ViewHeadState(func(headState *state.CachingBeaconState) error {
+		for i, selection := range req {
+			if _, err := a.syncMessagePool.AddSyncCommitteeSelection(headState, selection); err != nil {
+				return fmt.Errorf("selection %d: %w", i, err)
+			}
+		}
+		return nil
+	}); err != nil {
+		if errors.Is(err, sync_contribution_pool.ErrInvalidSelectionProof) ||
+			errors.Is(err, sync_contribution_pool.ErrValidatorNotInSubcommittee) ||
+			errors.Is(err, sync_contribution_pool.ErrSubcommitteeIndexOutOfRange) ||
+			errors.Is(err, sync_contribution_pool.ErrValidatorIndexOutOfRange) {
+			return nil, beaconhttp.NewEndpointError(http.StatusBadRequest, err)
+		}
+		return nil, beaconhttp.NewEndpointError(http.StatusInternalServerError, err)
+	}
+	return newBeaconResponse(req), nil
+}
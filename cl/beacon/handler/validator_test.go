@@ -76,6 +76,7 @@ func (t *validatorTestSuite) SetupTest() {
 		nil,
 		nil,
 		false,
+		nil,
 	)
 	t.gomockCtrl = gomockCtrl
 }
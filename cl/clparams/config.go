@@ -59,6 +59,8 @@ type CaplinConfig struct {
 	MevRelayUrl string
 	// EnableValidatorMonitor is used to enable the validator monitor metrics and corresponding logs
 	EnableValidatorMonitor bool
+	// ValidatorMonitorIds is the list of validator indicies or public keys tracked by the validator monitor
+	ValidatorMonitorIds []string
 
 	// Devnets config
 	CustomConfigPath       string
@@ -16,6 +16,10 @@
 
 package cltypes
 
+import (
+	libcommon "github.com/erigontech/erigon-lib/common"
+)
+
 type BeaconCommitteeSubscription struct {
 	ValidatorIndex   uint64 `json:"validator_index,string"`
 	CommitteeIndex   uint64 `json:"committee_index,string"`
@@ -23,3 +27,18 @@ type BeaconCommitteeSubscription struct {
 	Slot             uint64 `json:"slot,string"`
 	IsAggregator     bool   `json:"is_aggregator"`
 }
+
+// BeaconCommitteeSelection is a (possibly threshold-combined) slot signature of a validator, as exchanged with distributed validator middlewares.
+type BeaconCommitteeSelection struct {
+	ValidatorIndex uint64            `json:"validator_index,string"`
+	Slot           uint64            `json:"slot,string"`
+	SelectionProof libcommon.Bytes96 `json:"selection_proof"`
+}
+
+// SyncCommitteeSelection is a (possibly threshold-combined) sync committee selection proof of a validator, as exchanged with distributed validator middlewares.
+type SyncCommitteeSelection struct {
+	ValidatorIndex    uint64            `json:"validator_index,string"`
+	Slot              uint64            `json:"slot,string"`
+	SubcommitteeIndex uint64            `json:"subcommittee_index,string"`
+	SelectionProof    libcommon.Bytes96 `json:"selection_proof"`
+}
@@ -0,0 +1,121 @@
+// Copyright 2024 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package cltypes
+
+import (
+	"encoding/json"
+	"errors"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/common/length"
+
+	"github.com/erigontech/erigon/cl/cltypes/solid"
+	"github.com/erigontech/erigon/cl/merkle_tree"
+	ssz2 "github.com/erigontech/erigon/cl/ssz"
+)
+
+const depositContractTreeDepth = 32
+
+var ErrDepositSnapshotRootMismatch = errors.New("deposit snapshot root does not match its finalized branches")
+
+// DepositSnapshot is the EIP-4881 snapshot of the finalized part of the deposit contract tree.
+type DepositSnapshot struct {
+	Finalized            solid.HashListSSZ `json:"finalized"`
+	DepositRoot          libcommon.Hash    `json:"deposit_root"`
+	DepositCount         uint64            `json:"deposit_count,string"`
+	ExecutionBlockHash   libcommon.Hash    `json:"execution_block_hash"`
+	ExecutionBlockHeight uint64            `json:"execution_block_height,string"`
+}
+
+func NewDepositSnapshot() *DepositSnapshot {
+	return &DepositSnapshot{
+		Finalized: solid.NewHashList(depositContractTreeDepth),
+	}
+}
+
+// NewDepositSnapshotFromFinalized creates a snapshot out of the finalized branches of the deposit tree, computing its deposit root.
+func NewDepositSnapshotFromFinalized(finalized []libcommon.Hash, depositCount uint64, executionBlockHash libcommon.Hash, executionBlockHeight uint64) (*DepositSnapshot, error) {
+	root, err := merkle_tree.DepositTreeRoot(finalized, depositCount, depositContractTreeDepth)
+	if err != nil {
+		return nil, err
+	}
+	d := NewDepositSnapshot()
+	for _, branch := range finalized {
+		d.Finalized.Append(branch)
+	}
+	d.DepositRoot = root
+	d.DepositCount = depositCount
+	d.ExecutionBlockHash = executionBlockHash
+	d.ExecutionBlockHeight = executionBlockHeight
+	return d, nil
+}
+
+func (d *DepositSnapshot) UnmarshalJSON(buf []byte) error {
+	type depositSnapshot DepositSnapshot
+	d.Finalized = solid.NewHashList(depositContractTreeDepth)
+	return json.Unmarshal(buf, (*depositSnapshot)(d))
+}
+
+// FinalizedBranches returns the finalized branches of the snapshot as a slice.
+func (d *DepositSnapshot) FinalizedBranches() []libcommon.Hash {
+	out := make([]libcommon.Hash, 0, d.Finalized.Length())
+	d.Finalized.Range(func(_ int, branch libcommon.Hash, _ int) bool {
+		out = append(out, branch)
+		return true
+	})
+	return out
+}
+
+// Verify checks that the deposit root of the snapshot is the one implied by its finalized branches.
+func (d *DepositSnapshot) Verify() error {
+	root, err := merkle_tree.DepositTreeRoot(d.FinalizedBranches(), d.DepositCount, depositContractTreeDepth)
+	if err != nil {
+		return err
+	}
+	if root != d.DepositRoot {
+		return ErrDepositSnapshotRootMismatch
+	}
+	return nil
+}
+
+func (d *DepositSnapshot) Copy() *DepositSnapshot {
+	cpy := *d
+	cpy.Finalized = solid.NewHashList(depositContractTreeDepth)
+	d.Finalized.CopyTo(cpy.Finalized)
+	return &cpy
+}
+
+func (d *DepositSnapshot) EncodeSSZ(buf []byte) ([]byte, error) {
+	return ssz2.MarshalSSZ(buf, d.Finalized, d.DepositRoot[:], d.DepositCount, d.ExecutionBlockHash[:], d.ExecutionBlockHeight)
+}
+
+func (d *DepositSnapshot) DecodeSSZ(buf []byte, version int) error {
+	d.Finalized = solid.NewHashList(depositContractTreeDepth)
+	return ssz2.UnmarshalSSZ(buf, version, d.Finalized, d.DepositRoot[:], &d.DepositCount, d.ExecutionBlockHash[:], &d.ExecutionBlockHeight)
+}
+
+func (d *DepositSnapshot) EncodingSizeSSZ() int {
+	return 4 + length.Hash*2 + 8*2 + d.Finalized.EncodingSizeSSZ()
+}
+
+func (d *DepositSnapshot) HashSSZ() ([32]byte, error) {
+	return merkle_tree.HashTreeRoot(d.Finalized, d.DepositRoot[:], d.DepositCount, d.ExecutionBlockHash[:], d.ExecutionBlockHeight)
+}
+
+func (*DepositSnapshot) Static() bool {
+	return false
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package cltypes_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/erigontech/erigon/cl/cltypes"
+	"github.com/erigontech/erigon/cl/merkle_tree"
+)
+
+func testDepositSnapshot(t *testing.T) *cltypes.DepositSnapshot {
+	var finalized []libcommon.Hash
+	for i := uint64(0); i < 5; i++ {
+		finalized = merkle_tree.DepositTreePushLeaf(finalized, i, libcommon.Hash{byte(i + 1)})
+	}
+	snapshot, err := cltypes.NewDepositSnapshotFromFinalized(finalized, 5, libcommon.HexToHash("0x3"), 100)
+	require.NoError(t, err)
+	return snapshot
+}
+
+func TestDepositSnapshotSSZ(t *testing.T) {
+	snapshot := testDepositSnapshot(t)
+	require.NoError(t, snapshot.Verify())
+
+	encoded, err := snapshot.EncodeSSZ(nil)
+	require.NoError(t, err)
+	require.Len(t, encoded, snapshot.EncodingSizeSSZ())
+
+	decoded := cltypes.NewDepositSnapshot()
+	require.NoError(t, decoded.DecodeSSZ(encoded, 0))
+	require.Equal(t, snapshot.FinalizedBranches(), decoded.FinalizedBranches())
+	require.Equal(t, snapshot.DepositRoot, decoded.DepositRoot)
+	require.Equal(t, snapshot.DepositCount, decoded.DepositCount)
+	require.Equal(t, snapshot.ExecutionBlockHash, decoded.ExecutionBlockHash)
+	require.Equal(t, snapshot.ExecutionBlockHeight, decoded.ExecutionBlockHeight)
+	require.NoError(t, decoded.Verify())
+}
+
+func TestDepositSnapshotJSON(t *testing.T) {
+	snapshot := testDepositSnapshot(t)
+	encoded, err := json.Marshal(snapshot)
+	require.NoError(t, err)
+
+	decoded := &cltypes.DepositSnapshot{}
+	require.NoError(t, json.Unmarshal(encoded, decoded))
+	require.Equal(t, snapshot.FinalizedBranches(), decoded.FinalizedBranches())
+	require.Equal(t, snapshot.DepositRoot, decoded.DepositRoot)
+	require.NoError(t, decoded.Verify())
+
+	decoded.DepositCount++
+	require.Error(t, decoded.Verify())
+}
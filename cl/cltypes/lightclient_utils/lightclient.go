@@ -199,3 +199,108 @@ func CreateLightClientBootstrap(state *state.CachingBeaconState, block *cltypes.
 		CurrentSyncCommitteeBranch: hashVector,
 	}, nil
 }
+
+func isNonZeroBranch(branch solid.HashVectorSSZ) bool {
+	if branch == nil {
+		return false
+	}
+	nonZero := false
+	branch.Range(func(_ int, h libcommon.Hash, _ int) bool {
+		nonZero = h != (libcommon.Hash{})
+		return !nonZero
+	})
+	return nonZero
+}
+
+// def is_better_update(new_update: LightClientUpdate, old_update: LightClientUpdate) -> bool:
+//
+//	# Compare supermajority (> 2/3) sync committee participation
+//	max_active_participants = len(new_update.sync_aggregate.sync_committee_bits)
+//	new_num_active_participants = sum(new_update.sync_aggregate.sync_committee_bits)
+//	old_num_active_participants = sum(old_update.sync_aggregate.sync_committee_bits)
+//	new_has_supermajority = new_num_active_participants * 3 >= max_active_participants * 2
+//	old_has_supermajority = old_num_active_participants * 3 >= max_active_participants * 2
+//	if new_has_supermajority != old_has_supermajority:
+//	    return new_has_supermajority
+//	if not new_has_supermajority and new_num_active_participants != old_num_active_participants:
+//	    return new_num_active_participants > old_num_active_participants
+//
+//	# Compare presence of relevant sync committee
+//	new_has_relevant_sync_committee = is_sync_committee_update(new_update) and (
+//	    compute_sync_committee_period_at_slot(new_update.attested_header.beacon.slot)
+//	    == compute_sync_committee_period_at_slot(new_update.signature_slot)
+//	)
+//	old_has_relevant_sync_committee = ...
+//	if new_has_relevant_sync_committee != old_has_relevant_sync_committee:
+//	    return new_has_relevant_sync_committee
+//
+//	# Compare indication of any finality
+//	new_has_finality = is_finality_update(new_update)
+//	old_has_finality = is_finality_update(old_update)
+//	if new_has_finality != old_has_finality:
+//	    return new_has_finality
+//
+//	# Compare sync committee finality
+//	if new_has_finality:
+//	    new_has_sync_committee_finality = (
+//	        compute_sync_committee_period_at_slot(new_update.finalized_header.beacon.slot)
+//	        == compute_sync_committee_period_at_slot(new_update.attested_header.beacon.slot)
+//	    )
+//	    old_has_sync_committee_finality = ...
+//	    if new_has_sync_committee_finality != old_has_sync_committee_finality:
+//	        return new_has_sync_committee_finality
+//
+//	# Tiebreaker 1: Sync committee participation beyond supermajority
+//	if new_num_active_participants != old_num_active_participants:
+//	    return new_num_active_participants > old_num_active_participants
+//
+//	# Tiebreaker 2: Prefer older data (fewer changes to best)
+//	if new_update.attested_header.beacon.slot != old_update.attested_header.beacon.slot:
+//	    return new_update.attested_header.beacon.slot < old_update.attested_header.beacon.slot
+//	return new_update.signature_slot < old_update.signature_slot
+//
+// IsBetterLightClientUpdate implements the specs to rank light client updates of the same period.
+func IsBetterLightClientUpdate(cfg *clparams.BeaconChainConfig, newUpdate, oldUpdate *cltypes.LightClientUpdate) bool {
+	maxActiveParticipants := int(cfg.SyncCommitteeSize)
+	newNumActiveParticipants := newUpdate.SyncAggregate.Sum()
+	oldNumActiveParticipants := oldUpdate.SyncAggregate.Sum()
+	newHasSupermajority := newNumActiveParticipants*3 >= maxActiveParticipants*2
+	oldHasSupermajority := oldNumActiveParticipants*3 >= maxActiveParticipants*2
+	if newHasSupermajority != oldHasSupermajority {
+		return newHasSupermajority
+	}
+	if !newHasSupermajority && newNumActiveParticipants != oldNumActiveParticipants {
+		return newNumActiveParticipants > oldNumActiveParticipants
+	}
+
+	hasRelevantSyncCommittee := func(u *cltypes.LightClientUpdate) bool {
+		return isNonZeroBranch(u.NextSyncCommitteeBranch) &&
+			cfg.SyncCommitteePeriod(u.AttestedHeader.Beacon.Slot) == cfg.SyncCommitteePeriod(u.SignatureSlot)
+	}
+	newHasRelevantSyncCommittee := hasRelevantSyncCommittee(newUpdate)
+	if newHasRelevantSyncCommittee != hasRelevantSyncCommittee(oldUpdate) {
+		return newHasRelevantSyncCommittee
+	}
+
+	newHasFinality := isNonZeroBranch(newUpdate.FinalityBranch)
+	if newHasFinality != isNonZeroBranch(oldUpdate.FinalityBranch) {
+		return newHasFinality
+	}
+	if newHasFinality {
+		hasSyncCommitteeFinality := func(u *cltypes.LightClientUpdate) bool {
+			return cfg.SyncCommitteePeriod(u.FinalizedHeader.Beacon.Slot) == cfg.SyncCommitteePeriod(u.AttestedHeader.Beacon.Slot)
+		}
+		newHasSyncCommitteeFinality := hasSyncCommitteeFinality(newUpdate)
+		if newHasSyncCommitteeFinality != hasSyncCommitteeFinality(oldUpdate) {
+			return newHasSyncCommitteeFinality
+		}
+	}
+
+	if newNumActiveParticipants != oldNumActiveParticipants {
+		return newNumActiveParticipants > oldNumActiveParticipants
+	}
+	if newUpdate.AttestedHeader.Beacon.Slot != oldUpdate.AttestedHeader.Beacon.Slot {
+		return newUpdate.AttestedHeader.Beacon.Slot < oldUpdate.AttestedHeader.Beacon.Slot
+	}
+	return newUpdate.SignatureSlot < oldUpdate.SignatureSlot
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package lightclient_utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon/cl/clparams"
+	"github.com/erigontech/erigon/cl/cltypes"
+	"github.com/erigontech/erigon/cl/utils"
+)
+
+func testLightClientUpdate(participants int, signatureSlot uint64, finality bool) *cltypes.LightClientUpdate {
+	update := cltypes.NewLightClientUpdate(clparams.AltairVersion)
+	for i := 0; i < participants; i++ {
+		utils.FlipBitOn(update.SyncAggregate.SyncCommiteeBits[:], i)
+	}
+	update.AttestedHeader.Beacon.Slot = signatureSlot - 1
+	update.SignatureSlot = signatureSlot
+	if finality {
+		update.FinalityBranch.Set(0, libcommon.Hash{1})
+		update.FinalizedHeader.Beacon.Slot = signatureSlot - 64
+	}
+	return update
+}
+
+func TestIsBetterLightClientUpdate(t *testing.T) {
+	cfg := &clparams.MainnetBeaconConfig
+	supermajority := int(cfg.SyncCommitteeSize) * 2 / 3
+
+	// supermajority wins over anything else.
+	require.True(t, IsBetterLightClientUpdate(cfg, testLightClientUpdate(supermajority+1, 100, false), testLightClientUpdate(supermajority-1, 100, true)))
+	// below supermajority, more participants win.
+	require.True(t, IsBetterLightClientUpdate(cfg, testLightClientUpdate(100, 100, false), testLightClientUpdate(99, 100, true)))
+	// with supermajority, finality wins over participation.
+	require.True(t, IsBetterLightClientUpdate(cfg, testLightClientUpdate(supermajority+1, 100, true), testLightClientUpdate(supermajority+10, 100, false)))
+	// all else equal, older updates win.
+	require.True(t, IsBetterLightClientUpdate(cfg, testLightClientUpdate(supermajority+1, 100, true), testLightClientUpdate(supermajority+1, 200, true)))
+	require.False(t, IsBetterLightClientUpdate(cfg, testLightClientUpdate(supermajority+1, 100, true), testLightClientUpdate(supermajority+1, 100, true)))
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package merkle_tree
+
+import (
+	"errors"
+	"math/bits"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+
+	"github.com/erigontech/erigon/cl/utils"
+)
+
+// The helpers below implement the EIP-4881 representation of the deposit contract tree: the first
+// count leaves are summarised by the roots of their maximal complete subtrees (the "finalized" branches),
+// ordered from the largest subtree to the smallest. Hence len(finalized) == popcount(count).
+
+var ErrInvalidDepositTree = errors.New("finalized branches do not match the deposit count")
+
+// DepositTreeRoot computes the deposit root (including the length mix-in) of a deposit tree of the given depth
+// whose leaves are all covered by the finalized branches.
+func DepositTreeRoot(finalized []libcommon.Hash, count uint64, depth uint64) (libcommon.Hash, error) {
+	if bits.OnesCount64(count) != len(finalized) || depth >= uint64(len(ZeroHashes)) || (depth < 64 && count>>depth != 0) {
+		return libcommon.Hash{}, ErrInvalidDepositTree
+	}
+	node := libcommon.Hash(ZeroHashes[0])
+	next := len(finalized) - 1
+	for h := uint64(0); h < depth; h++ {
+		if (count>>h)&1 == 1 {
+			node = utils.Sha256(finalized[next][:], node[:])
+			next--
+		} else {
+			node = utils.Sha256(node[:], ZeroHashes[h][:])
+		}
+	}
+	countRoot := Uint64Root(count)
+	return utils.Sha256(node[:], countRoot[:]), nil
+}
+
+// DepositTreePushLeaf returns the finalized branches of the deposit tree after appending leaf to a tree
+// of count leaves summarised by finalized. The input slice is not modified.
+func DepositTreePushLeaf(finalized []libcommon.Hash, count uint64, leaf libcommon.Hash) []libcommon.Hash {
+	out := make([]libcommon.Hash, len(finalized), len(finalized)+1)
+	copy(out, finalized)
+	node := leaf
+	// Every trailing one bit of count is a complete subtree that merges with the new leaf.
+	for h := 0; (count>>h)&1 == 1 && len(out) > 0; h++ {
+		node = utils.Sha256(out[len(out)-1][:], node[:])
+		out = out[:len(out)-1]
+	}
+	return append(out, node)
+}
+
+// DepositTreeFromBranch reconstructs the finalized branches of the deposit tree right after the leaf at index
+// was appended, using the merkle branch of that leaf (as found in a beacon block deposit proof).
+// Left siblings in the branch are exactly the complete subtrees preceding the leaf.
+func DepositTreeFromBranch(leaf libcommon.Hash, branch []libcommon.Hash, index uint64, depth uint64) ([]libcommon.Hash, error) {
+	if uint64(len(branch)) < depth {
+		return nil, ErrInvalidDepositTree
+	}
+	finalized := make([]libcommon.Hash, 0, bits.OnesCount64(index)+1)
+	for h := int(depth) - 1; h >= 0; h-- {
+		if (index>>h)&1 == 1 {
+			finalized = append(finalized, branch[h])
+		}
+	}
+	return DepositTreePushLeaf(finalized, index, leaf), nil
+}
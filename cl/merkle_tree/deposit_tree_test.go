@@ -0,0 +1,94 @@
+// Copyright 2024 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package merkle_tree_test
+
+import (
+	"testing"
+
+	"github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon/cl/merkle_tree"
+	"github.com/erigontech/erigon/cl/utils"
+	"github.com/stretchr/testify/require"
+)
+
+const depositTreeDepth = 32
+
+func depositLeaves(n int) []common.Hash {
+	leaves := make([]common.Hash, n)
+	for i := range leaves {
+		leaves[i] = utils.Sha256([]byte{byte(i), byte(i >> 8)})
+	}
+	return leaves
+}
+
+// depositBranch computes the merkle branch of the leaf at index in a full depth-32 tree.
+func depositBranch(leaves []common.Hash, index int) []common.Hash {
+	branch := make([]common.Hash, depositTreeDepth)
+	layer := append([]common.Hash{}, leaves...)
+	for h := 0; h < depositTreeDepth; h++ {
+		if len(layer)%2 == 1 {
+			layer = append(layer, merkle_tree.ZeroHashes[h])
+		}
+		branch[h] = layer[index^1]
+		next := make([]common.Hash, len(layer)/2)
+		for i := range next {
+			next[i] = utils.Sha256(layer[2*i][:], layer[2*i+1][:])
+		}
+		layer = next
+		index /= 2
+	}
+	return branch
+}
+
+func TestDepositTreeRoot(t *testing.T) {
+	leaves := depositLeaves(37)
+	var finalized []common.Hash
+	for count := 0; count <= len(leaves); count++ {
+		elements := make([][32]byte, count)
+		for i := range elements {
+			elements[i] = leaves[i]
+		}
+		root, err := merkle_tree.MerkleizeVector(elements, 1<<depositTreeDepth)
+		require.NoError(t, err)
+		countRoot := merkle_tree.Uint64Root(uint64(count))
+		expected := common.Hash(utils.Sha256(root[:], countRoot[:]))
+
+		have, err := merkle_tree.DepositTreeRoot(finalized, uint64(count), depositTreeDepth)
+		require.NoError(t, err)
+		require.Equal(t, expected, have, "count %d", count)
+
+		if count < len(leaves) {
+			finalized = merkle_tree.DepositTreePushLeaf(finalized, uint64(count), leaves[count])
+		}
+	}
+}
+
+func TestDepositTreeRootInvalid(t *testing.T) {
+	_, err := merkle_tree.DepositTreeRoot(depositLeaves(2), 4, depositTreeDepth)
+	require.ErrorIs(t, err, merkle_tree.ErrInvalidDepositTree)
+}
+
+func TestDepositTreeFromBranch(t *testing.T) {
+	leaves := depositLeaves(21)
+	var finalized []common.Hash
+	for i, leaf := range leaves {
+		finalized = merkle_tree.DepositTreePushLeaf(finalized, uint64(i), leaf)
+		have, err := merkle_tree.DepositTreeFromBranch(leaf, depositBranch(leaves, i), uint64(i), depositTreeDepth)
+		require.NoError(t, err)
+		require.Equal(t, finalized, have, "index %d", i)
+	}
+}
@@ -0,0 +1,474 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package monitor
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/common/length"
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon-lib/metrics"
+	"github.com/erigontech/erigon/cl/clparams"
+	"github.com/erigontech/erigon/cl/cltypes"
+	"github.com/erigontech/erigon/cl/cltypes/solid"
+	"github.com/erigontech/erigon/cl/phase1/core/state"
+)
+
+// summariesEpochsToKeep is how many finalized epoch summaries are kept in memory for the debug endpoint.
+const summariesEpochsToKeep = 8
+
+// ValidatorMonitor tracks the on-chain performance of a configured set of validators.
+type ValidatorMonitor interface {
+	// ObserveValidator adds a validator index to the set of monitored validators.
+	ObserveValidator(vid uint64)
+	// ObserveValidatorPubkey adds a validator public key to the set of monitored validators, it is resolved to an index lazily.
+	ObserveValidatorPubkey(pubkey libcommon.Bytes48)
+	// OnNewBlock must be called with the post-state of every imported block.
+	OnNewBlock(s *state.CachingBeaconState, block *cltypes.BeaconBlock, blockRoot libcommon.Hash) error
+	// OnNewHead must be called with every new head of the chain, only the blocks on the canonical chain are accounted.
+	OnNewHead(headRoot libcommon.Hash)
+	// Summaries returns the per-epoch summaries of the monitored validators, most recent epoch first.
+	Summaries() []*ValidatorEpochSummary
+}
+
+// ValidatorEpochSummary is the performance of a single monitored validator during an epoch.
+type ValidatorEpochSummary struct {
+	ValidatorIndex uint64 `json:"validator_index,string"`
+	Epoch          uint64 `json:"epoch,string"`
+	// Attestation related
+	AttestationIncluded bool   `json:"attestation_included"`
+	InclusionDelay      uint64 `json:"inclusion_delay,string"`
+	SourceCorrect       bool   `json:"source_correct"`
+	TargetCorrect       bool   `json:"target_correct"`
+	HeadCorrect         bool   `json:"head_correct"`
+	// Proposal related
+	ProposedBlocks uint64 `json:"proposed_blocks,string"`
+	MissedBlocks   uint64 `json:"missed_blocks,string"`
+	// Sync committee related
+	SyncCommitteeHits   uint64 `json:"sync_committee_hits,string"`
+	SyncCommitteeMisses uint64 `json:"sync_committee_misses,string"`
+	// Balance at the start of the epoch and the change compared to the start of the previous one.
+	Balance      uint64 `json:"balance,string"`
+	BalanceDelta int64  `json:"balance_delta,string"`
+}
+
+// ParseValidatorMonitorIds parses a list of validator indicies or 0x-prefixed public keys.
+func ParseValidatorMonitorIds(ids []string) (indicies []uint64, pubkeys []libcommon.Bytes48, err error) {
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		if strings.HasPrefix(id, "0x") {
+			raw, err := hex.DecodeString(id[2:])
+			if err != nil || len(raw) != length.Bytes48 {
+				return nil, nil, fmt.Errorf("invalid validator public key %q", id)
+			}
+			pubkeys = append(pubkeys, libcommon.Bytes48(raw))
+			continue
+		}
+		idx, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid validator index %q", id)
+		}
+		indicies = append(indicies, idx)
+	}
+	return
+}
+
+type validatorMonitorImpl struct {
+	beaconCfg *clparams.BeaconChainConfig
+
+	mu                 sync.Mutex
+	validators         map[uint64]struct{}
+	unresolvedPubkeys  map[libcommon.Bytes48]struct{}
+	summaries          map[uint64]map[uint64]*ValidatorEpochSummary // epoch => validator index => summary
+	finalizedSummaries []*ValidatorEpochSummary
+	pending            map[libcommon.Hash]*blockObservation // block root => observation not yet on the canonical chain
+	lastBlockSlot      uint64
+	lastEpoch          uint64
+}
+
+// blockObservation is what an imported block tells about the monitored validators, it is only accounted
+// once the block becomes part of the canonical chain.
+type blockObservation struct {
+	slot          uint64
+	epoch         uint64
+	parentRoot    libcommon.Hash
+	proposer      uint64
+	missedSlots   []missedProposal
+	attestations  []includedAttestation
+	participation map[uint64][2]cltypes.ParticipationFlags // validator index => [current, previous] epoch flags
+	syncHits      []uint64
+	syncMisses    []uint64
+	balances      map[uint64]uint64
+}
+
+type missedProposal struct {
+	slot     uint64
+	proposer uint64
+}
+
+type includedAttestation struct {
+	vid   uint64
+	epoch uint64
+	delay uint64
+}
+
+// NewValidatorMonitor creates a validator monitor, if it is not enabled a no-op monitor is returned.
+func NewValidatorMonitor(enabled bool, beaconCfg *clparams.BeaconChainConfig) ValidatorMonitor {
+	if !enabled {
+		return &dummyValidatorMonitor{}
+	}
+	return &validatorMonitorImpl{
+		beaconCfg:         beaconCfg,
+		validators:        make(map[uint64]struct{}),
+		unresolvedPubkeys: make(map[libcommon.Bytes48]struct{}),
+		summaries:         make(map[uint64]map[uint64]*ValidatorEpochSummary),
+		pending:           make(map[libcommon.Hash]*blockObservation),
+	}
+}
+
+func (m *validatorMonitorImpl) ObserveValidator(vid uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.validators[vid] = struct{}{}
+}
+
+func (m *validatorMonitorImpl) ObserveValidatorPubkey(pubkey libcommon.Bytes48) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.unresolvedPubkeys[pubkey] = struct{}{}
+}
+
+func (m *validatorMonitorImpl) Summaries() []*ValidatorEpochSummary {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make([]*ValidatorEpochSummary, 0, len(m.finalizedSummaries))
+	for _, s := range m.finalizedSummaries {
+		cpy := *s
+		out = append(out, &cpy)
+	}
+	return out
+}
+
+// summary returns the summary of a validator at a given epoch, creating it if needed.
+func (m *validatorMonitorImpl) summary(epoch, vid uint64) *ValidatorEpochSummary {
+	epochSummaries, ok := m.summaries[epoch]
+	if !ok {
+		epochSummaries = make(map[uint64]*ValidatorEpochSummary)
+		m.summaries[epoch] = epochSummaries
+	}
+	s, ok := epochSummaries[vid]
+	if !ok {
+		s = &ValidatorEpochSummary{ValidatorIndex: vid, Epoch: epoch}
+		epochSummaries[vid] = s
+	}
+	return s
+}
+
+func (m *validatorMonitorImpl) OnNewBlock(s *state.CachingBeaconState, block *cltypes.BeaconBlock, blockRoot libcommon.Hash) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for pubkey := range m.unresolvedPubkeys {
+		if idx, ok := s.ValidatorIndexByPubkey(pubkey); ok {
+			m.validators[idx] = struct{}{}
+			delete(m.unresolvedPubkeys, pubkey)
+		}
+	}
+	if len(m.validators) == 0 || block.Slot <= m.lastBlockSlot {
+		return nil
+	}
+
+	obs := &blockObservation{
+		slot:       block.Slot,
+		epoch:      state.Epoch(s),
+		parentRoot: block.ParentRoot,
+		proposer:   block.ProposerIndex,
+		balances:   make(map[uint64]uint64),
+	}
+	for vid := range m.validators {
+		if int(vid) >= s.ValidatorLength() {
+			continue
+		}
+		balance, err := s.ValidatorBalance(int(vid))
+		if err != nil {
+			return err
+		}
+		obs.balances[vid] = balance
+	}
+	if err := m.observeMissedProposals(s, block, obs); err != nil {
+		return err
+	}
+	if err := m.observeAttestations(s, block, obs); err != nil {
+		return err
+	}
+	if s.Version() >= clparams.AltairVersion {
+		m.observeParticipation(s, obs)
+		m.observeSyncAggregate(s, block, obs)
+	}
+	m.pending[blockRoot] = obs
+	return nil
+}
+
+// OnNewHead accounts the observations of the blocks between the last accounted block and the new head.
+// Blocks which are not an ancestor of a head are never accounted, blocks already accounted and
+// orphaned by a later reorg stay accounted.
+func (m *validatorMonitorImpl) OnNewHead(headRoot libcommon.Hash) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var canonical []*blockObservation
+	for root := headRoot; ; {
+		obs, ok := m.pending[root]
+		if !ok || obs.slot <= m.lastBlockSlot {
+			break
+		}
+		canonical = append(canonical, obs)
+		root = obs.parentRoot
+	}
+	for i := len(canonical) - 1; i >= 0; i-- {
+		m.applyObservation(canonical[i])
+	}
+	for root, obs := range m.pending {
+		if obs.slot <= m.lastBlockSlot {
+			delete(m.pending, root)
+		}
+	}
+}
+
+func (m *validatorMonitorImpl) applyObservation(obs *blockObservation) {
+	if obs.epoch > m.lastEpoch {
+		m.onEpochTransition(obs)
+	}
+	blockEpoch := obs.slot / m.beaconCfg.SlotsPerEpoch
+	for _, missed := range obs.missedSlots {
+		m.summary(missed.slot/m.beaconCfg.SlotsPerEpoch, missed.proposer).MissedBlocks++
+		metricProposerMiss.Inc()
+		log.Warn("[Validator Monitor] Missed block proposal", "validator", missed.proposer, "slot", missed.slot)
+	}
+	if _, ok := m.validators[obs.proposer]; ok {
+		m.summary(blockEpoch, obs.proposer).ProposedBlocks++
+		metricProposerHit.Inc()
+		log.Info("[Validator Monitor] Proposed block", "validator", obs.proposer, "slot", obs.slot)
+	}
+	for _, att := range obs.attestations {
+		summary := m.summary(att.epoch, att.vid)
+		if !summary.AttestationIncluded || att.delay < summary.InclusionDelay {
+			summary.InclusionDelay = att.delay
+		}
+		summary.AttestationIncluded = true
+	}
+	for vid, flags := range obs.participation {
+		m.setParticipation(m.summary(obs.epoch, vid), flags[0])
+		if obs.epoch > 0 {
+			m.setParticipation(m.summary(obs.epoch-1, vid), flags[1])
+		}
+	}
+	for _, vid := range obs.syncHits {
+		m.summary(blockEpoch, vid).SyncCommitteeHits++
+	}
+	for _, vid := range obs.syncMisses {
+		m.summary(blockEpoch, vid).SyncCommitteeMisses++
+	}
+	m.lastBlockSlot = obs.slot
+}
+
+// onEpochTransition records balances at the start of the new epoch and finalizes the summaries of epoch-2,
+// since attestations for epoch-1 can still be included.
+func (m *validatorMonitorImpl) onEpochTransition(obs *blockObservation) {
+	epoch := obs.epoch
+	for vid, balance := range obs.balances {
+		current := m.summary(epoch, vid)
+		current.Balance = balance
+		if previous, ok := m.summaries[epoch-1][vid]; ok && previous.Balance > 0 {
+			current.BalanceDelta = int64(balance) - int64(previous.Balance)
+		}
+		validatorBalanceGauge(vid).SetUint64(balance)
+	}
+	m.lastEpoch = epoch
+	if epoch < 2 {
+		return
+	}
+	for summaryEpoch, epochSummaries := range m.summaries {
+		if summaryEpoch > epoch-2 {
+			continue
+		}
+		for _, summary := range epochSummaries {
+			m.exportSummary(summary)
+			m.finalizedSummaries = append(m.finalizedSummaries, summary)
+		}
+		delete(m.summaries, summaryEpoch)
+	}
+	sort.Slice(m.finalizedSummaries, func(i, j int) bool {
+		if m.finalizedSummaries[i].Epoch != m.finalizedSummaries[j].Epoch {
+			return m.finalizedSummaries[i].Epoch > m.finalizedSummaries[j].Epoch
+		}
+		return m.finalizedSummaries[i].ValidatorIndex < m.finalizedSummaries[j].ValidatorIndex
+	})
+	for len(m.finalizedSummaries) > 0 && m.finalizedSummaries[len(m.finalizedSummaries)-1].Epoch+summariesEpochsToKeep <= epoch {
+		m.finalizedSummaries = m.finalizedSummaries[:len(m.finalizedSummaries)-1]
+	}
+}
+
+// observeMissedProposals collects the skipped slots between the parent and the block. The block root of a skipped
+// slot is the root of the last block before it, so the parent is at the first slot still having the parent root.
+func (m *validatorMonitorImpl) observeMissedProposals(s *state.CachingBeaconState, block *cltypes.BeaconBlock, obs *blockObservation) error {
+	if block.Slot == 0 {
+		return nil
+	}
+	parentSlot := block.Slot - 1
+	for parentSlot > 0 && block.Slot-parentSlot < m.beaconCfg.SlotsPerHistoricalRoot {
+		root, err := s.GetBlockRootAtSlot(parentSlot - 1)
+		if err != nil {
+			return err
+		}
+		if root != block.ParentRoot {
+			break
+		}
+		parentSlot--
+	}
+	for slot := parentSlot + 1; slot < block.Slot; slot++ {
+		proposer, err := s.GetBeaconProposerIndexForSlot(slot)
+		if err != nil {
+			return err
+		}
+		if _, ok := m.validators[proposer]; ok {
+			obs.missedSlots = append(obs.missedSlots, missedProposal{slot: slot, proposer: proposer})
+		}
+	}
+	return nil
+}
+
+func (m *validatorMonitorImpl) observeAttestations(s *state.CachingBeaconState, block *cltypes.BeaconBlock, obs *blockObservation) error {
+	var err error
+	block.Body.Attestations.Range(func(_ int, att *solid.Attestation, _ int) bool {
+		var attesters []uint64
+		attesters, err = s.GetAttestingIndicies(att, false)
+		if err != nil {
+			return false
+		}
+		delay := block.Slot - att.Data.Slot
+		attEpoch := att.Data.Slot / m.beaconCfg.SlotsPerEpoch
+		for _, vid := range attesters {
+			if _, ok := m.validators[vid]; !ok {
+				continue
+			}
+			obs.attestations = append(obs.attestations, includedAttestation{vid: vid, epoch: attEpoch, delay: delay})
+		}
+		return true
+	})
+	return err
+}
+
+// observeParticipation copies the participation flags of the current and previous epoch from the state.
+func (m *validatorMonitorImpl) observeParticipation(s *state.CachingBeaconState, obs *blockObservation) {
+	obs.participation = make(map[uint64][2]cltypes.ParticipationFlags)
+	for vid := range m.validators {
+		if int(vid) >= s.ValidatorLength() {
+			continue
+		}
+		obs.participation[vid] = [2]cltypes.ParticipationFlags{
+			s.EpochParticipationForValidatorIndex(true, int(vid)),
+			s.EpochParticipationForValidatorIndex(false, int(vid)),
+		}
+	}
+}
+
+func (m *validatorMonitorImpl) setParticipation(summary *ValidatorEpochSummary, flags cltypes.ParticipationFlags) {
+	summary.SourceCorrect = flags.HasFlag(int(m.beaconCfg.TimelySourceFlagIndex))
+	summary.TargetCorrect = flags.HasFlag(int(m.beaconCfg.TimelyTargetFlagIndex))
+	summary.HeadCorrect = flags.HasFlag(int(m.beaconCfg.TimelyHeadFlagIndex))
+}
+
+func (m *validatorMonitorImpl) observeSyncAggregate(s *state.CachingBeaconState, block *cltypes.BeaconBlock, obs *blockObservation) {
+	syncAggregate := block.Body.SyncAggregate
+	if syncAggregate == nil {
+		return
+	}
+	// The aggregate signs the previous slot, at the period boundary it belongs to the previous committee which is no longer in the state.
+	if block.Slot%(m.beaconCfg.SlotsPerEpoch*m.beaconCfg.EpochsPerSyncCommitteePeriod) == 0 {
+		return
+	}
+	for i, pubkey := range s.CurrentSyncCommittee().GetCommittee() {
+		vid, ok := s.ValidatorIndexByPubkey(pubkey)
+		if !ok {
+			continue
+		}
+		if _, ok := m.validators[vid]; !ok {
+			continue
+		}
+		if syncAggregate.IsSet(uint64(i)) {
+			obs.syncHits = append(obs.syncHits, vid)
+		} else {
+			obs.syncMisses = append(obs.syncMisses, vid)
+		}
+	}
+}
+
+func (m *validatorMonitorImpl) exportSummary(summary *ValidatorEpochSummary) {
+	vid := summary.ValidatorIndex
+	if summary.AttestationIncluded {
+		metricAttestHit.Inc()
+		validatorGauge("validator_monitor_inclusion_delay", vid).SetUint64(summary.InclusionDelay)
+	} else {
+		metricAttestMiss.Inc()
+		log.Warn("[Validator Monitor] Missed attestation", "validator", vid, "epoch", summary.Epoch)
+	}
+	validatorGauge("validator_monitor_source_correct", vid).Set(boolToFloat(summary.SourceCorrect))
+	validatorGauge("validator_monitor_target_correct", vid).Set(boolToFloat(summary.TargetCorrect))
+	validatorGauge("validator_monitor_head_correct", vid).Set(boolToFloat(summary.HeadCorrect))
+	validatorGauge("validator_monitor_proposed_blocks", vid).SetUint64(summary.ProposedBlocks)
+	validatorGauge("validator_monitor_missed_blocks", vid).SetUint64(summary.MissedBlocks)
+	validatorGauge("validator_monitor_sync_committee_hits", vid).SetUint64(summary.SyncCommitteeHits)
+	validatorGauge("validator_monitor_sync_committee_misses", vid).SetUint64(summary.SyncCommitteeMisses)
+	validatorGauge("validator_monitor_balance_delta", vid).Set(float64(summary.BalanceDelta))
+}
+
+func validatorGauge(name string, vid uint64) metrics.Gauge {
+	return metrics.GetOrCreateGauge(fmt.Sprintf(`%s{validator="%d"}`, name, vid))
+}
+
+func validatorBalanceGauge(vid uint64) metrics.Gauge {
+	return validatorGauge("validator_monitor_balance", vid)
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+type dummyValidatorMonitor struct{}
+
+func (d *dummyValidatorMonitor) ObserveValidator(vid uint64) {}
+
+func (d *dummyValidatorMonitor) ObserveValidatorPubkey(pubkey libcommon.Bytes48) {}
+
+func (d *dummyValidatorMonitor) OnNewBlock(s *state.CachingBeaconState, block *cltypes.BeaconBlock, blockRoot libcommon.Hash) error {
+	return nil
+}
+
+func (d *dummyValidatorMonitor) OnNewHead(headRoot libcommon.Hash) {}
+
+func (d *dummyValidatorMonitor) Summaries() []*ValidatorEpochSummary { return nil }
@@ -0,0 +1,146 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package monitor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon/cl/antiquary/tests"
+	"github.com/erigontech/erigon/cl/clparams"
+	"github.com/erigontech/erigon/cl/cltypes"
+)
+
+func TestParseValidatorMonitorIds(t *testing.T) {
+	pubkey := libcommon.Bytes48{1, 2, 3}
+	indicies, pubkeys, err := ParseValidatorMonitorIds([]string{"1", " 42", "", pubkey.String()})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 42}, indicies)
+	require.Equal(t, []libcommon.Bytes48{pubkey}, pubkeys)
+
+	_, _, err = ParseValidatorMonitorIds([]string{"0x1234"})
+	require.Error(t, err)
+	_, _, err = ParseValidatorMonitorIds([]string{"abc"})
+	require.Error(t, err)
+}
+
+func newTestValidatorMonitor(vids ...uint64) *validatorMonitorImpl {
+	m := NewValidatorMonitor(true, &clparams.MainnetBeaconConfig).(*validatorMonitorImpl)
+	for _, vid := range vids {
+		m.ObserveValidator(vid)
+	}
+	return m
+}
+
+func TestValidatorMonitorCountsOnlyCanonicalBlocks(t *testing.T) {
+	m := newTestValidatorMonitor(1, 2)
+	genesis, a, fork, b := libcommon.Hash{0}, libcommon.Hash{1}, libcommon.Hash{2}, libcommon.Hash{3}
+	m.pending[a] = &blockObservation{slot: 1, parentRoot: genesis, proposer: 1}
+	m.pending[fork] = &blockObservation{slot: 1, parentRoot: genesis, proposer: 2}
+	m.pending[b] = &blockObservation{slot: 2, parentRoot: a, proposer: 1,
+		attestations: []includedAttestation{{vid: 2, epoch: 0, delay: 1}}}
+
+	m.OnNewHead(b)
+	require.Equal(t, uint64(2), m.summaries[0][1].ProposedBlocks)
+	require.Zero(t, m.summaries[0][2].ProposedBlocks)
+	require.True(t, m.summaries[0][2].AttestationIncluded)
+	require.Equal(t, uint64(2), m.lastBlockSlot)
+	require.Empty(t, m.pending)
+
+	// The same head again, or a late fork block, must not be accounted twice.
+	m.OnNewHead(b)
+	m.pending[fork] = &blockObservation{slot: 1, parentRoot: genesis, proposer: 2}
+	m.OnNewHead(fork)
+	require.Equal(t, uint64(2), m.summaries[0][1].ProposedBlocks)
+	require.Zero(t, m.summaries[0][2].ProposedBlocks)
+}
+
+func TestValidatorMonitorReorg(t *testing.T) {
+	m := newTestValidatorMonitor(1, 2)
+	genesis, a, b, c := libcommon.Hash{0}, libcommon.Hash{1}, libcommon.Hash{2}, libcommon.Hash{3}
+	m.pending[a] = &blockObservation{slot: 1, parentRoot: genesis, proposer: 1}
+	m.pending[b] = &blockObservation{slot: 2, parentRoot: a, proposer: 2}
+	m.OnNewHead(a)
+	require.Equal(t, uint64(1), m.summaries[0][1].ProposedBlocks)
+	require.Nil(t, m.summaries[0][2])
+
+	// c reorgs b out before it was ever the head, b is never accounted.
+	m.pending[c] = &blockObservation{slot: 3, parentRoot: a, proposer: 1,
+		missedSlots: []missedProposal{{slot: 2, proposer: 2}}}
+	m.OnNewHead(c)
+	require.Equal(t, uint64(2), m.summaries[0][1].ProposedBlocks)
+	require.Zero(t, m.summaries[0][2].ProposedBlocks)
+	require.Equal(t, uint64(1), m.summaries[0][2].MissedBlocks)
+}
+
+func TestValidatorMonitorFinalizesSummaries(t *testing.T) {
+	m := newTestValidatorMonitor(1)
+	slotsPerEpoch := clparams.MainnetBeaconConfig.SlotsPerEpoch
+	parent := libcommon.Hash{}
+	for epoch := uint64(0); epoch <= 2; epoch++ {
+		root := libcommon.Hash{byte(epoch + 1)}
+		m.pending[root] = &blockObservation{
+			slot:       epoch*slotsPerEpoch + 1,
+			epoch:      epoch,
+			parentRoot: parent,
+			proposer:   1,
+			balances:   map[uint64]uint64{1: 32_000_000_000 + epoch},
+		}
+		m.OnNewHead(root)
+		parent = root
+	}
+
+	summaries := m.Summaries()
+	require.Len(t, summaries, 1)
+	require.Equal(t, uint64(0), summaries[0].Epoch)
+	require.Equal(t, uint64(1), summaries[0].ProposedBlocks)
+	require.Equal(t, uint64(1), m.summaries[2][1].ProposedBlocks)
+	require.Equal(t, int64(1), m.summaries[2][1].BalanceDelta)
+}
+
+func TestValidatorMonitorMissedProposals(t *testing.T) {
+	_, _, s := tests.GetPhase0Random()
+	slotsPerEpoch := clparams.MainnetBeaconConfig.SlotsPerEpoch
+	blockSlot := s.Slot()
+	// the parent is in the previous epoch, the slots after it are skipped
+	parentSlot := (blockSlot/slotsPerEpoch)*slotsPerEpoch - 2
+	parentRoot := libcommon.Hash{1}
+	for slot := parentSlot - 1; slot < blockSlot; slot++ {
+		root := parentRoot
+		if slot < parentSlot {
+			root = libcommon.Hash{2}
+		}
+		s.SetBlockRootAt(int(slot%clparams.MainnetBeaconConfig.SlotsPerHistoricalRoot), root)
+	}
+
+	m := newTestValidatorMonitor()
+	var expected []missedProposal
+	for slot := parentSlot + 1; slot < blockSlot; slot++ {
+		proposer, err := s.GetBeaconProposerIndexForSlot(slot)
+		require.NoError(t, err)
+		m.ObserveValidator(proposer)
+		expected = append(expected, missedProposal{slot: slot, proposer: proposer})
+	}
+	block := cltypes.NewBeaconBlock(&clparams.MainnetBeaconConfig, clparams.Phase0Version)
+	block.Slot = blockSlot
+	block.ParentRoot = parentRoot
+	obs := &blockObservation{}
+	require.NoError(t, m.observeMissedProposals(s, block, obs))
+	require.Equal(t, expected, obs.missedSlots)
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/erigontech/erigon-lib/kv/dbutils"
 	"github.com/erigontech/erigon/cl/clparams"
 	"github.com/erigontech/erigon/cl/cltypes"
+	"github.com/erigontech/erigon/cl/cltypes/lightclient_utils"
 	"github.com/erigontech/erigon/cl/persistence/base_encoding"
 	"github.com/erigontech/erigon/cl/persistence/format/snapshot_format"
 
@@ -68,6 +69,73 @@ func ReadHighestFinalized(tx kv.Tx) (uint64, error) {
 	return base_encoding.Decode64FromBytes4(val), nil
 }
 
+// WriteDepositSnapshot persists the EIP-4881 finalized deposit tree snapshot.
+func WriteDepositSnapshot(tx kv.RwTx, snapshot *cltypes.DepositSnapshot) error {
+	encoded, err := snapshot.EncodeSSZ(nil)
+	if err != nil {
+		return err
+	}
+	return tx.Put(kv.DepositSnapshot, kv.DepositSnapshotKey, encoded)
+}
+
+// ReadDepositSnapshot reads the EIP-4881 finalized deposit tree snapshot, it returns nil if none was persisted yet.
+func ReadDepositSnapshot(tx kv.Tx) (*cltypes.DepositSnapshot, error) {
+	val, err := tx.GetOne(kv.DepositSnapshot, kv.DepositSnapshotKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(val) == 0 {
+		return nil, nil
+	}
+	snapshot := cltypes.NewDepositSnapshot()
+	if err := snapshot.DecodeSSZ(val, 0); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// WriteLightClientUpdate persists the light client update of a sync committee period.
+func WriteLightClientUpdate(tx kv.RwTx, period uint64, update *cltypes.LightClientUpdate) error {
+	encoded, err := update.EncodeSSZ([]byte{byte(update.AttestedHeader.Version())})
+	if err != nil {
+		return err
+	}
+	return tx.Put(kv.LightClientUpdates, base_encoding.Encode64ToBytes4(period), encoded)
+}
+
+// ReadLightClientUpdate reads the light client update of a sync committee period, it returns nil if none was persisted.
+func ReadLightClientUpdate(tx kv.Tx, period uint64) (*cltypes.LightClientUpdate, error) {
+	val, err := tx.GetOne(kv.LightClientUpdates, base_encoding.Encode64ToBytes4(period))
+	if err != nil {
+		return nil, err
+	}
+	if len(val) == 0 {
+		return nil, nil
+	}
+	version := clparams.StateVersion(val[0])
+	update := cltypes.NewLightClientUpdate(version)
+	if err := update.DecodeSSZ(val[1:], int(version)); err != nil {
+		return nil, err
+	}
+	return update, nil
+}
+
+// ReadBestLightClientUpdate returns the best known light client update of a period, looking both at the update
+// reconstructed by the antiquary and at the one seen live by the fork choice, if any. It returns nil if the period is unknown.
+func ReadBestLightClientUpdate(tx kv.Tx, beaconCfg *clparams.BeaconChainConfig, period uint64, live *cltypes.LightClientUpdate) (*cltypes.LightClientUpdate, error) {
+	persisted, err := ReadLightClientUpdate(tx, period)
+	if err != nil {
+		return nil, err
+	}
+	if live == nil {
+		return persisted, nil
+	}
+	if persisted == nil || lightclient_utils.IsBetterLightClientUpdate(beaconCfg, live, persisted) {
+		return live, nil
+	}
+	return persisted, nil
+}
+
 // WriteHeaderSlot writes the slot associated with a block root.
 func WriteHeaderSlot(tx kv.RwTx, blockRoot libcommon.Hash, slot uint64) error {
 	return tx.Put(kv.BlockRootToSlot, blockRoot[:], base_encoding.Encode64ToBytes4(slot))
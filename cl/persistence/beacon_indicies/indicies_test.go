@@ -188,3 +188,47 @@ func TestWriteExecutionBlockHash(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, tHash2, tHash3)
 }
+
+func TestWriteDepositSnapshot(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	tx, _ := db.BeginRw(context.Background())
+	defer tx.Rollback()
+
+	snapshot, err := ReadDepositSnapshot(tx)
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+
+	finalized := []libcommon.Hash{libcommon.HexToHash("0x1")}
+	snapshot, err = cltypes.NewDepositSnapshotFromFinalized(finalized, 1, libcommon.HexToHash("0x2"), 3)
+	require.NoError(t, err)
+	require.NoError(t, WriteDepositSnapshot(tx, snapshot))
+
+	readSnapshot, err := ReadDepositSnapshot(tx)
+	require.NoError(t, err)
+	require.Equal(t, snapshot.FinalizedBranches(), readSnapshot.FinalizedBranches())
+	require.Equal(t, snapshot.DepositRoot, readSnapshot.DepositRoot)
+	require.Equal(t, snapshot.ExecutionBlockHeight, readSnapshot.ExecutionBlockHeight)
+}
+
+func TestWriteLightClientUpdate(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	tx, _ := db.BeginRw(context.Background())
+	defer tx.Rollback()
+
+	update, err := ReadLightClientUpdate(tx, 7)
+	require.NoError(t, err)
+	require.Nil(t, update)
+
+	update = cltypes.NewLightClientUpdate(clparams.CapellaVersion)
+	update.AttestedHeader.Beacon.Slot = 100
+	update.SignatureSlot = 101
+	require.NoError(t, WriteLightClientUpdate(tx, 7, update))
+
+	readUpdate, err := ReadLightClientUpdate(tx, 7)
+	require.NoError(t, err)
+	require.Equal(t, clparams.CapellaVersion, readUpdate.AttestedHeader.Version())
+	require.Equal(t, update.AttestedHeader.Beacon.Slot, readUpdate.AttestedHeader.Beacon.Slot)
+	require.Equal(t, update.SignatureSlot, readUpdate.SignatureSlot)
+}
@@ -2,14 +2,17 @@ package checkpoint_sync
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	libcommon "github.com/erigontech/erigon-lib/common"
 	"github.com/erigontech/erigon/cl/antiquary/tests"
 	"github.com/erigontech/erigon/cl/clparams"
 	"github.com/erigontech/erigon/cl/cltypes"
+	"github.com/erigontech/erigon/cl/merkle_tree"
 	"github.com/erigontech/erigon/cl/utils"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
@@ -87,3 +90,35 @@ func TestLocalCheckpointSyncFromGenesis(t *testing.T) {
 
 	assert.Equal(t, haveRoot, wantRoot)
 }
+
+func TestRemoteDepositSnapshotSync(t *testing.T) {
+	var finalized []libcommon.Hash
+	for i := uint64(0); i < 3; i++ {
+		finalized = merkle_tree.DepositTreePushLeaf(finalized, i, libcommon.Hash{byte(i + 1)})
+	}
+	snapshot, err := cltypes.NewDepositSnapshotFromFinalized(finalized, 3, libcommon.HexToHash("0x2"), 10)
+	require.NoError(t, err)
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/eth/v1/beacon/deposit_snapshot" {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{"data": snapshot})
+	}))
+	defer mockServer.Close()
+
+	clparams.ConfigurableCheckpointsURLs = []string{mockServer.URL + "/eth/v2/debug/beacon/states/finalized"}
+	syncer := NewRemoteDepositSnapshotSync(&clparams.MainnetBeaconConfig, clparams.MainnetNetwork)
+
+	eth1Data := &cltypes.Eth1Data{Root: snapshot.DepositRoot, DepositCount: 3, BlockHash: snapshot.ExecutionBlockHash}
+	fetched, err := syncer.GetDepositSnapshot(context.Background(), eth1Data)
+	require.NoError(t, err)
+	require.Equal(t, snapshot.DepositRoot, fetched.DepositRoot)
+	require.Equal(t, snapshot.FinalizedBranches(), fetched.FinalizedBranches())
+
+	// The snapshot must agree with the eth1 data of the anchor state.
+	eth1Data.Root = libcommon.HexToHash("0x1")
+	_, err = syncer.GetDepositSnapshot(context.Background(), eth1Data)
+	require.Error(t, err)
+}
@@ -3,9 +3,15 @@ package checkpoint_sync
 import (
 	"context"
 
+	"github.com/erigontech/erigon/cl/cltypes"
 	"github.com/erigontech/erigon/cl/phase1/core/state"
 )
 
 type CheckpointSyncer interface {
 	GetLatestBeaconState(ctx context.Context) (*state.CachingBeaconState, error)
 }
+
+// DepositSnapshotSyncer fetches the EIP-4881 finalized deposit tree from a trusted peer, so that the deposit logs do not need to be replayed.
+type DepositSnapshotSyncer interface {
+	GetDepositSnapshot(ctx context.Context, eth1Data *cltypes.Eth1Data) (*cltypes.DepositSnapshot, error)
+}
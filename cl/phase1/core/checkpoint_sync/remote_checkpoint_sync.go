@@ -2,13 +2,17 @@ package checkpoint_sync
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/erigontech/erigon-lib/log/v3"
 	"github.com/erigontech/erigon/cl/clparams"
+	"github.com/erigontech/erigon/cl/cltypes"
 	"github.com/erigontech/erigon/cl/phase1/core/state"
 	"github.com/erigontech/erigon/cl/utils"
 )
@@ -85,3 +89,93 @@ func (r *RemoteCheckpointSync) GetLatestBeaconState(ctx context.Context) (*state
 	return nil, err
 
 }
+
+// NewRemoteDepositSnapshotSync creates a DepositSnapshotSyncer which queries the same trusted endpoints used for checkpoint sync.
+func NewRemoteDepositSnapshotSync(beaconConfig *clparams.BeaconChainConfig, net clparams.NetworkType) DepositSnapshotSyncer {
+	return &RemoteCheckpointSync{
+		beaconConfig: beaconConfig,
+		net:          net,
+	}
+}
+
+// depositSnapshotURI turns a checkpoint sync endpoint into the deposit snapshot endpoint of the same beacon API.
+func depositSnapshotURI(checkpointURI string) (string, error) {
+	u, err := url.Parse(checkpointURI)
+	if err != nil {
+		return "", err
+	}
+	if idx := strings.Index(u.Path, "/eth/"); idx >= 0 {
+		u.Path = u.Path[:idx]
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/eth/v1/beacon/deposit_snapshot"
+	u.RawQuery = ""
+	return u.String(), nil
+}
+
+// GetDepositSnapshot fetches the EIP-4881 deposit snapshot from the checkpoint sync endpoints and verifies it against the given eth1 data.
+func (r *RemoteCheckpointSync) GetDepositSnapshot(ctx context.Context, eth1Data *cltypes.Eth1Data) (*cltypes.DepositSnapshot, error) {
+	uris := clparams.GetAllCheckpointSyncEndpoints(r.net)
+	if len(uris) == 0 {
+		return nil, errors.New("no uris for deposit snapshot sync")
+	}
+
+	fetchDepositSnapshot := func(checkpointURI string) (*cltypes.DepositSnapshot, error) {
+		uri, err := depositSnapshotURI(checkpointURI)
+		if err != nil {
+			return nil, err
+		}
+		log.Info("[Checkpoint Sync] Requesting deposit snapshot", "uri", uri)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("deposit snapshot sync failed, bad status code %d", resp.StatusCode)
+		}
+		var body struct {
+			Data *cltypes.DepositSnapshot `json:"data"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return nil, fmt.Errorf("deposit snapshot decode failed %s", err)
+		}
+		if body.Data == nil {
+			return nil, errors.New("deposit snapshot response has no data")
+		}
+		if err := verifyDepositSnapshot(body.Data, eth1Data); err != nil {
+			return nil, err
+		}
+		return body.Data, nil
+	}
+
+	// Try all uris until one succeeds
+	var err error
+	var snapshot *cltypes.DepositSnapshot
+	for _, uri := range uris {
+		snapshot, err = fetchDepositSnapshot(uri)
+		if err == nil {
+			return snapshot, nil
+		}
+		log.Warn("[Checkpoint Sync] Failed to fetch deposit snapshot", "uri", uri, "err", err)
+	}
+	return nil, err
+}
+
+// verifyDepositSnapshot checks that the snapshot is self-consistent and does not contradict the eth1 data of the anchor state.
+func verifyDepositSnapshot(snapshot *cltypes.DepositSnapshot, eth1Data *cltypes.Eth1Data) error {
+	if err := snapshot.Verify(); err != nil {
+		return fmt.Errorf("invalid deposit snapshot: %w", err)
+	}
+	if snapshot.DepositCount > eth1Data.DepositCount {
+		return fmt.Errorf("deposit snapshot is ahead of the anchor state, have %d deposits, expected at most %d", snapshot.DepositCount, eth1Data.DepositCount)
+	}
+	if snapshot.DepositCount == eth1Data.DepositCount && snapshot.DepositRoot != eth1Data.Root {
+		return fmt.Errorf("deposit snapshot root mismatch, have %x, expected %x", snapshot.DepositRoot, eth1Data.Root)
+	}
+	return nil
+}
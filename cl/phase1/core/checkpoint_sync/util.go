@@ -5,7 +5,11 @@ import (
 	"fmt"
 
 	"github.com/erigontech/erigon-lib/common/datadir"
+	"github.com/erigontech/erigon-lib/kv"
+	"github.com/erigontech/erigon-lib/log/v3"
 	"github.com/erigontech/erigon/cl/clparams"
+	"github.com/erigontech/erigon/cl/cltypes"
+	"github.com/erigontech/erigon/cl/persistence/beacon_indicies"
 	"github.com/erigontech/erigon/cl/persistence/genesisdb"
 	"github.com/erigontech/erigon/cl/phase1/core/state"
 	"github.com/spf13/afero"
@@ -29,3 +33,24 @@ func ReadOrFetchLatestBeaconState(ctx context.Context, dirs datadir.Dirs, beacon
 	}
 	return syncer.GetLatestBeaconState(ctx)
 }
+
+// ReadOrFetchDepositSnapshot reads the EIP-4881 deposit snapshot from the database or, when checkpoint syncing, fetches it from the trusted endpoints.
+// A nil snapshot is returned if none is available, in which case it is built from the deposits of finalized blocks.
+func ReadOrFetchDepositSnapshot(ctx context.Context, db kv.RoDB, beaconCfg *clparams.BeaconChainConfig, caplinConfig clparams.CaplinConfig, anchorState *state.CachingBeaconState) (*cltypes.DepositSnapshot, error) {
+	var snapshot *cltypes.DepositSnapshot
+	if err := db.View(ctx, func(tx kv.Tx) (err error) {
+		snapshot, err = beacon_indicies.ReadDepositSnapshot(tx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	if snapshot != nil || caplinConfig.DisabledCheckpointSync || caplinConfig.IsDevnet() {
+		return snapshot, nil
+	}
+	snapshot, err := NewRemoteDepositSnapshotSync(beaconCfg, caplinConfig.NetworkId).GetDepositSnapshot(ctx, anchorState.Eth1Data())
+	if err != nil {
+		log.Warn("[Checkpoint Sync] Could not fetch deposit snapshot, it will be built from finalized deposits", "err", err)
+		return nil, nil
+	}
+	return snapshot, nil
+}
@@ -150,6 +150,10 @@ func (cc *ExecutionClientDirect) IsCanonicalHash(ctx context.Context, hash libco
 	return cc.chainRW.IsCanonicalHash(ctx, hash)
 }
 
+func (cc *ExecutionClientDirect) HeaderNumber(ctx context.Context, hash libcommon.Hash) (*uint64, error) {
+	return cc.chainRW.HeaderNumber(ctx, hash)
+}
+
 func (cc *ExecutionClientDirect) Ready(ctx context.Context) (bool, error) {
 	return cc.chainRW.Ready(ctx)
 }
@@ -218,6 +218,21 @@ func (cc *ExecutionClientRpc) IsCanonicalHash(ctx context.Context, hash libcommo
 	panic("unimplemented")
 }
 
+// HeaderNumber returns the number of the block with given hash, nil if the block is unknown
+func (cc *ExecutionClientRpc) HeaderNumber(ctx context.Context, hash libcommon.Hash) (*uint64, error) {
+	var header *struct {
+		Number hexutil.Uint64 `json:"number"`
+	}
+	if err := cc.client.CallContext(ctx, &header, rpc_helper.GetBlockByHash, hash, false); err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, nil
+	}
+	number := uint64(header.Number)
+	return &number, nil
+}
+
 func (cc *ExecutionClientRpc) Ready(ctx context.Context) (bool, error) {
 	return true, nil // Engine API is always ready
 }
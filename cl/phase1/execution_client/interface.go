@@ -42,6 +42,7 @@ type ExecutionEngine interface {
 	InsertBlock(ctx context.Context, block *types.Block) error
 	CurrentHeader(ctx context.Context) (*types.Header, error)
 	IsCanonicalHash(ctx context.Context, hash libcommon.Hash) (bool, error)
+	HeaderNumber(ctx context.Context, hash libcommon.Hash) (*uint64, error)
 	Ready(ctx context.Context) (bool, error)
 	// Range methods
 	GetBodiesByRange(ctx context.Context, start, count uint64) ([]*types.RawBody, error)
@@ -0,0 +1,228 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package forkchoice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon/cl/clparams"
+	"github.com/erigontech/erigon/cl/cltypes"
+	"github.com/erigontech/erigon/cl/merkle_tree"
+	"github.com/erigontech/erigon/cl/phase1/core/state"
+)
+
+// depositCheckpoint is the deposit-related data of a post-block state.
+type depositCheckpoint struct {
+	eth1Data         *cltypes.Eth1Data
+	eth1DepositIndex uint64
+	// index of the first deposit processed from the execution requests (EIP-6110), no more deposits are
+	// included from the deposit contract past it.
+	depositRequestsStartIndex uint64
+}
+
+func newDepositCheckpoint(s *state.CachingBeaconState) depositCheckpoint {
+	depositRequestsStartIndex := uint64(math.MaxUint64)
+	if s.Version() >= clparams.ElectraVersion {
+		depositRequestsStartIndex = s.DepositRequestsStartIndex()
+	}
+	return depositCheckpoint{
+		eth1Data:                  s.Eth1Data().Copy(),
+		eth1DepositIndex:          s.Eth1DepositIndex(),
+		depositRequestsStartIndex: depositRequestsStartIndex,
+	}
+}
+
+// depositTracker maintains the EIP-4881 finalized deposit tree. Deposits carry the merkle branch of their leaf,
+// which is enough to rebuild the finalized branches of the tree up to that deposit, so we only need to remember
+// the deposit tree after the deposits of each eth1 block until the beacon block including them gets finalized.
+// The tree stops once the transition to the deposit requests of EIP-6110 is finalized.
+type depositTracker struct {
+	mu       sync.RWMutex
+	snapshot *cltypes.DepositSnapshot
+	// deposit count => deposit tree with that many leaves.
+	pending map[uint64]*pendingDeposits
+	stopped bool
+}
+
+// pendingDeposits is the deposit tree after all the deposits of an eth1 block, not finalized yet.
+type pendingDeposits struct {
+	finalized []libcommon.Hash // finalized branches of the deposit tree
+	eth1Data  *cltypes.Eth1Data
+}
+
+func newDepositTracker() *depositTracker {
+	return &depositTracker{
+		pending: make(map[uint64]*pendingDeposits),
+	}
+}
+
+// onBlock records the deposit tree after the last deposit included in the block. postState is the state after the block.
+func (d *depositTracker) onBlock(block *cltypes.BeaconBlock, postState *state.CachingBeaconState) error {
+	depositsLen := block.Body.Deposits.Len()
+	if depositsLen == 0 {
+		return nil
+	}
+	depositCount := postState.Eth1DepositIndex()
+	eth1Data := postState.Eth1Data()
+	// The snapshot must be anchored to an eth1 block, so skip the blocks which include only a part of its deposits.
+	if depositCount != eth1Data.DepositCount {
+		return nil
+	}
+	deposit := block.Body.Deposits.Get(depositsLen - 1)
+	leaf, err := deposit.Data.HashSSZ()
+	if err != nil {
+		return err
+	}
+	branch := make([]libcommon.Hash, 0, deposit.Proof.Length())
+	deposit.Proof.Range(func(_ int, h libcommon.Hash, _ int) bool {
+		branch = append(branch, h)
+		return true
+	})
+	finalized, err := merkle_tree.DepositTreeFromBranch(leaf, branch, depositCount-1, postState.BeaconConfig().DepositContractTreeDepth)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stopped || (d.snapshot != nil && d.snapshot.DepositCount >= depositCount) {
+		return nil
+	}
+	d.pending[depositCount] = &pendingDeposits{finalized: finalized, eth1Data: eth1Data.Copy()}
+	return nil
+}
+
+// finalizedDeposits returns the latest pending deposits covered by the finalized checkpoint, if the snapshot can
+// advance to them. Blocks without deposits add no pending deposits, so it's not necessarily the finalized deposit count.
+func (d *depositTracker) finalizedDeposits(checkpoint depositCheckpoint) (uint64, *pendingDeposits, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.stopped {
+		return 0, nil, false
+	}
+	limit := min(checkpoint.eth1DepositIndex, checkpoint.eth1Data.DepositCount)
+	var depositCount uint64
+	var latest *pendingDeposits
+	for count, pending := range d.pending {
+		if count <= limit && (latest == nil || count > depositCount) {
+			depositCount, latest = count, pending
+		}
+	}
+	if latest == nil || (d.snapshot != nil && d.snapshot.DepositCount >= depositCount) {
+		return 0, nil, false
+	}
+	return depositCount, latest, true
+}
+
+// onFinalized advances the snapshot to the given finalized deposits. executionBlockHeight is the height of the eth1
+// block the deposits are anchored to.
+func (d *depositTracker) onFinalized(depositCount uint64, pending *pendingDeposits, executionBlockHeight uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.snapshot != nil && d.snapshot.DepositCount >= depositCount {
+		return
+	}
+	snapshot, err := cltypes.NewDepositSnapshotFromFinalized(pending.finalized, depositCount, pending.eth1Data.BlockHash, executionBlockHeight)
+	if err != nil {
+		log.Warn("[Deposit Snapshot] Could not build deposit snapshot", "depositCount", depositCount, "err", err)
+		return
+	}
+	if snapshot.DepositRoot != pending.eth1Data.Root {
+		log.Warn("[Deposit Snapshot] Deposit root mismatch", "depositCount", depositCount, "expected", pending.eth1Data.Root, "got", snapshot.DepositRoot)
+		return
+	}
+	d.snapshot = snapshot
+	for count := range d.pending {
+		if count <= depositCount {
+			delete(d.pending, count)
+		}
+	}
+}
+
+// stop freezes the snapshot, the deposit contract is not followed past the deposit requests transition.
+func (d *depositTracker) stop() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.stopped = true
+	clear(d.pending)
+}
+
+func (d *depositTracker) set(snapshot *cltypes.DepositSnapshot) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.snapshot = snapshot
+}
+
+func (d *depositTracker) get() *cltypes.DepositSnapshot {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.snapshot == nil {
+		return nil
+	}
+	return d.snapshot.Copy()
+}
+
+// finalizeDeposits advances the deposit snapshot to the deposits of a newly finalized checkpoint. It runs along with
+// the fork choice update, so the snapshot is up to date when the finalized checkpoint is persisted.
+func (f *ForkChoiceStore) finalizeDeposits(checkpoint depositCheckpoint) {
+	if depositCount, pending, ok := f.depositTracker.finalizedDeposits(checkpoint); ok {
+		// Keep the pending deposits on failure, the next finalized checkpoint will retry.
+		executionBlockHeight, err := f.eth1BlockHeight(pending.eth1Data.BlockHash)
+		if err != nil {
+			log.Warn("[Deposit Snapshot] Could not resolve eth1 block height", "depositCount", depositCount, "blockHash", pending.eth1Data.BlockHash, "err", err)
+			return
+		}
+		f.depositTracker.onFinalized(depositCount, pending, executionBlockHeight)
+	}
+	if checkpoint.eth1DepositIndex >= checkpoint.depositRequestsStartIndex {
+		f.depositTracker.stop()
+	}
+}
+
+// eth1BlockHeight returns the height of the eth1 block with the given hash, as known by the execution engine.
+func (f *ForkChoiceStore) eth1BlockHeight(hash libcommon.Hash) (uint64, error) {
+	if f.engine == nil {
+		return 0, errors.New("no execution engine")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	number, err := f.engine.HeaderNumber(ctx, hash)
+	if err != nil {
+		return 0, err
+	}
+	if number == nil {
+		return 0, fmt.Errorf("unknown eth1 block %x", hash)
+	}
+	return *number, nil
+}
+
+// GetDepositSnapshot returns the EIP-4881 snapshot of the finalized deposit tree, or nil if it is not known yet.
+func (f *ForkChoiceStore) GetDepositSnapshot() *cltypes.DepositSnapshot {
+	return f.depositTracker.get()
+}
+
+// SetDepositSnapshot bootstraps the finalized deposit tree, either from disk or from a trusted checkpoint sync endpoint.
+func (f *ForkChoiceStore) SetDepositSnapshot(snapshot *cltypes.DepositSnapshot) {
+	f.depositTracker.set(snapshot)
+}
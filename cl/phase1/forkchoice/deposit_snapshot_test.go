@@ -0,0 +1,90 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package forkchoice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon/cl/cltypes"
+	"github.com/erigontech/erigon/cl/merkle_tree"
+)
+
+func TestDepositTrackerOnFinalized(t *testing.T) {
+	var finalized []libcommon.Hash
+	for i := uint64(0); i < 3; i++ {
+		finalized = merkle_tree.DepositTreePushLeaf(finalized, i, libcommon.Hash{byte(i + 1)})
+	}
+	blockHash := libcommon.HexToHash("0x3")
+	expected, err := cltypes.NewDepositSnapshotFromFinalized(finalized, 3, blockHash, 42)
+	require.NoError(t, err)
+
+	tracker := newDepositTracker()
+	tracker.pending[3] = &pendingDeposits{
+		finalized: finalized,
+		eth1Data:  &cltypes.Eth1Data{Root: expected.DepositRoot, DepositCount: 3, BlockHash: blockHash},
+	}
+
+	// the deposits are not finalized yet
+	_, _, ok := tracker.finalizedDeposits(depositCheckpoint{
+		eth1Data:         &cltypes.Eth1Data{DepositCount: 3},
+		eth1DepositIndex: 2,
+	})
+	require.False(t, ok)
+
+	// the finalized state is past them, with no block including deposits since then
+	depositCount, pending, ok := tracker.finalizedDeposits(depositCheckpoint{
+		eth1Data:         &cltypes.Eth1Data{DepositCount: 5},
+		eth1DepositIndex: 5,
+	})
+	require.True(t, ok)
+	require.Equal(t, uint64(3), depositCount)
+
+	tracker.onFinalized(depositCount, pending, 42)
+	snapshot := tracker.get()
+	require.NotNil(t, snapshot)
+	require.Equal(t, uint64(42), snapshot.ExecutionBlockHeight)
+	require.Equal(t, blockHash, snapshot.ExecutionBlockHash)
+	require.Equal(t, expected.DepositRoot, snapshot.DepositRoot)
+	require.Empty(t, tracker.pending)
+
+	_, _, ok = tracker.finalizedDeposits(depositCheckpoint{
+		eth1Data:         &cltypes.Eth1Data{DepositCount: 5},
+		eth1DepositIndex: 5,
+	})
+	require.False(t, ok)
+}
+
+func TestDepositTrackerStop(t *testing.T) {
+	var finalized []libcommon.Hash
+	for i := uint64(0); i < 3; i++ {
+		finalized = merkle_tree.DepositTreePushLeaf(finalized, i, libcommon.Hash{byte(i + 1)})
+	}
+	tracker := newDepositTracker()
+	tracker.pending[3] = &pendingDeposits{finalized: finalized, eth1Data: &cltypes.Eth1Data{DepositCount: 3}}
+
+	// past the deposit requests transition, the tree is not followed anymore
+	tracker.stop()
+	require.Empty(t, tracker.pending)
+	_, _, ok := tracker.finalizedDeposits(depositCheckpoint{
+		eth1Data:         &cltypes.Eth1Data{DepositCount: 5},
+		eth1DepositIndex: 3,
+	})
+	require.False(t, ok)
+}
@@ -42,6 +42,7 @@ import (
 
 	"github.com/erigontech/erigon/cl/clparams"
 	"github.com/erigontech/erigon/cl/cltypes"
+	"github.com/erigontech/erigon/cl/monitor"
 	"github.com/erigontech/erigon/cl/utils"
 )
 
@@ -83,7 +84,7 @@ func TestForkChoiceBasic(t *testing.T) {
 	require.NoError(t, utils.DecodeSSZSnappy(anchorState, anchorStateEncoded, int(clparams.AltairVersion)))
 	pool := pool.NewOperationsPool(&clparams.MainnetBeaconConfig)
 	emitters := beaconevents.NewEventEmitter()
-	store, err := forkchoice.NewForkChoiceStore(nil, anchorState, nil, pool, fork_graph.NewForkGraphDisk(anchorState, nil, afero.NewMemMapFs(), beacon_router_configuration.RouterConfiguration{}, emitters), emitters, sd, nil, public_keys_registry.NewInMemoryPublicKeysRegistry(), false)
+	store, err := forkchoice.NewForkChoiceStore(nil, anchorState, nil, pool, fork_graph.NewForkGraphDisk(anchorState, nil, afero.NewMemMapFs(), beacon_router_configuration.RouterConfiguration{}, emitters), emitters, sd, nil, public_keys_registry.NewInMemoryPublicKeysRegistry(), false, monitor.NewValidatorMonitor(false, &clparams.MainnetBeaconConfig))
 	require.NoError(t, err)
 	// first steps
 	store.OnTick(0)
@@ -149,7 +150,7 @@ func TestForkChoiceChainBellatrix(t *testing.T) {
 	sd := synced_data.NewSyncedDataManager(&clparams.MainnetBeaconConfig, true)
 	store, err := forkchoice.NewForkChoiceStore(nil, anchorState, nil, pool, fork_graph.NewForkGraphDisk(anchorState, nil, afero.NewMemMapFs(), beacon_router_configuration.RouterConfiguration{
 		Beacon: true,
-	}, emitters), emitters, sd, nil, public_keys_registry.NewInMemoryPublicKeysRegistry(), false)
+	}, emitters), emitters, sd, nil, public_keys_registry.NewInMemoryPublicKeysRegistry(), false, monitor.NewValidatorMonitor(false, &clparams.MainnetBeaconConfig))
 	store.OnTick(2000)
 	require.NoError(t, err)
 	for _, block := range blocks {
@@ -27,6 +27,7 @@ import (
 	"github.com/erigontech/erigon/cl/clparams"
 	"github.com/erigontech/erigon/cl/cltypes"
 	"github.com/erigontech/erigon/cl/cltypes/solid"
+	"github.com/erigontech/erigon/cl/monitor"
 	"github.com/erigontech/erigon/cl/persistence/blob_storage"
 	"github.com/erigontech/erigon/cl/phase1/core/state"
 	state2 "github.com/erigontech/erigon/cl/phase1/core/state"
@@ -120,6 +121,11 @@ type ForkChoiceStore struct {
 	randaoDeltas     *lru.Cache[libcommon.Hash, randaoDelta]       // small entry can be lots of elements.
 	// participation tracking
 	participation *lru.Cache[uint64, *solid.ParticipationBitList] // epoch -> [participation]
+	// EIP-4881 deposit tree tracking
+	depositCheckpoints *lru.Cache[libcommon.Hash, depositCheckpoint]
+	depositTracker     *depositTracker
+	// validator monitor
+	validatorMonitor monitor.ValidatorMonitor
 
 	mu sync.RWMutex
 
@@ -160,6 +166,7 @@ func NewForkChoiceStore(
 	blobStorage blob_storage.BlobStorage,
 	publicKeysRegistry public_keys_registry.PublicKeyRegistry,
 	probabilisticHeadGetter bool,
+	validatorMonitor monitor.ValidatorMonitor,
 ) (*ForkChoiceStore, error) {
 	anchorRoot, err := anchorState.BlockRoot()
 	if err != nil {
@@ -220,6 +227,12 @@ func NewForkChoiceStore(
 	if err != nil {
 		return nil, err
 	}
+
+	depositCheckpoints, err := lru.New[libcommon.Hash, depositCheckpoint](checkpointsPerCache)
+	if err != nil {
+		return nil, err
+	}
+	depositCheckpoints.Add(anchorRoot, newDepositCheckpoint(anchorState))
 	publicKeysRegistry.ResetAnchor(anchorState)
 	participation.Add(state.Epoch(anchorState.BeaconState), anchorState.CurrentEpochParticipation().Copy())
 
@@ -257,6 +270,9 @@ func NewForkChoiceStore(
 		probabilisticHeadGetter:  probabilisticHeadGetter,
 		publicKeysRegistry:       publicKeysRegistry,
 		verifiedExecutionPayload: verifiedExecutionPayload,
+		depositCheckpoints:       depositCheckpoints,
+		depositTracker:           newDepositTracker(),
+		validatorMonitor:         validatorMonitor,
 	}
 	f.justifiedCheckpoint.Store(anchorCheckpoint)
 	f.finalizedCheckpoint.Store(anchorCheckpoint)
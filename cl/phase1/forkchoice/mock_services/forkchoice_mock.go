@@ -63,6 +63,7 @@ type ForkChoiceStorageMock struct {
 	LCUpdates                 map[uint64]*cltypes.LightClientUpdate
 	SyncContributionPool      sync_contribution_pool.SyncContributionPool
 	Headers                   map[common.Hash]*cltypes.BeaconBlockHeader
+	DepositSnapshotVal        *cltypes.DepositSnapshot
 	GetBeaconCommitteeMock    func(slot, committeeIndex uint64) ([]uint64, error)
 
 	Pool pool.OperationsPool
@@ -307,6 +308,10 @@ func (f *ForkChoiceStorageMock) GetHeader(
 	return f.Headers[blockRoot], f.Headers[blockRoot] != nil
 }
 
+func (f *ForkChoiceStorageMock) GetDepositSnapshot() *cltypes.DepositSnapshot {
+	return f.DepositSnapshotVal
+}
+
 func (f *ForkChoiceStorageMock) GetBalances(blockRoot libcommon.Hash) (solid.Uint64ListSSZ, error) {
 	panic("implement me")
 }
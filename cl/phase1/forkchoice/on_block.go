@@ -226,6 +226,15 @@ func (f *ForkChoiceStore) OnBlock(ctx context.Context, block *cltypes.SignedBeac
 		previousJustifiedCheckpoint: lastProcessedState.PreviousJustifiedCheckpoint(),
 	})
 
+	f.depositCheckpoints.Add(blockRoot, newDepositCheckpoint(lastProcessedState))
+	if err := f.depositTracker.onBlock(block.Block, lastProcessedState); err != nil {
+		log.Warn("OnBlock: failed to track deposits", "block", libcommon.Hash(blockRoot), "err", err)
+	}
+
+	if err := f.validatorMonitor.OnNewBlock(lastProcessedState, block.Block, libcommon.Hash(blockRoot)); err != nil {
+		log.Warn("OnBlock: failed to update validator monitor", "block", libcommon.Hash(blockRoot), "err", err)
+	}
+
 	f.totalActiveBalances.Add(blockRoot, lastProcessedState.GetTotalActiveBalance())
 	// Update checkpoints
 	f.updateCheckpoints(lastProcessedState.CurrentJustifiedCheckpoint(), lastProcessedState.FinalizedCheckpoint())
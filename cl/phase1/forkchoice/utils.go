@@ -76,6 +76,9 @@ func (f *ForkChoiceStore) onNewFinalized(newFinalized solid.Checkpoint) {
 		}
 		return true
 	})
+	if checkpoint, ok := f.depositCheckpoints.Get(newFinalized.Root); ok {
+		f.finalizeDeposits(checkpoint)
+	}
 	slotToPrune := ((newFinalized.Epoch - 3) * f.beaconCfg.SlotsPerEpoch) - 1
 	f.forkGraph.Prune(slotToPrune)
 }
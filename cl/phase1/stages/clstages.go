@@ -64,6 +64,8 @@ type Cfg struct {
 	attestationDataProducer attestation_producer.AttestationDataProducer
 	caplinConfig            clparams.CaplinConfig
 	hasDownloaded           bool
+	// finalized epoch at which the deposit snapshot was last persisted
+	depositSnapshotEpoch uint64
 }
 
 type Args struct {
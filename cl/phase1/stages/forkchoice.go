@@ -310,6 +310,15 @@ func postForkchoiceOperations(ctx context.Context, tx kv.RwTx, logger log.Logger
 	if err := beacon_indicies.WriteHighestFinalized(tx, cfg.forkChoice.FinalizedSlot()); err != nil {
 		return err
 	}
+	// The deposit snapshot only advances with the finalized checkpoint
+	if finalizedEpoch := cfg.forkChoice.FinalizedCheckpoint().Epoch; finalizedEpoch > cfg.depositSnapshotEpoch {
+		if depositSnapshot := cfg.forkChoice.GetDepositSnapshot(); depositSnapshot != nil {
+			if err := beacon_indicies.WriteDepositSnapshot(tx, depositSnapshot); err != nil {
+				return err
+			}
+		}
+		cfg.depositSnapshotEpoch = finalizedEpoch
+	}
 	start := time.Now()
 	cfg.forkChoice.SetSynced(true) // Now we are synced
 	// Update the head state with the new head state
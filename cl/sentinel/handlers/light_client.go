@@ -19,6 +19,7 @@ package handlers
 import (
 	"github.com/erigontech/erigon/cl/clparams"
 	"github.com/erigontech/erigon/cl/cltypes"
+	"github.com/erigontech/erigon/cl/persistence/beacon_indicies"
 	"github.com/erigontech/erigon/cl/sentinel/communication/ssz_snappy"
 	"github.com/erigontech/erigon/cl/utils"
 	"github.com/libp2p/go-libp2p/core/network"
@@ -92,6 +93,12 @@ func (c *ConsensusHandlers) lightClientUpdatesByRangeHandler(s network.Stream) e
 		return err
 	}
 
+	tx, err := c.indiciesDB.BeginRo(c.ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
 	lightClientUpdates := make([]*cltypes.LightClientUpdate, 0, maxLightClientsPerRequest)
 
 	endPeriod := req.StartPeriod + req.Count
@@ -103,8 +110,12 @@ func (c *ConsensusHandlers) lightClientUpdatesByRangeHandler(s network.Stream) e
 	notFoundPrev := false
 	// Fetch from [start_period, start_period + count]
 	for i := req.StartPeriod; i < endPeriod; i++ {
-		update, has := c.forkChoiceReader.GetLightClientUpdate(i)
-		if !has {
+		live, _ := c.forkChoiceReader.GetLightClientUpdate(i)
+		update, err := beacon_indicies.ReadBestLightClientUpdate(tx, c.beaconConfig, i, live)
+		if err != nil {
+			return err
+		}
+		if update == nil {
 			notFoundPrev = true
 			continue
 		}
@@ -35,11 +35,13 @@ import (
 	"github.com/erigontech/erigon/cl/clparams"
 	"github.com/erigontech/erigon/cl/cltypes"
 	"github.com/erigontech/erigon/cl/cltypes/solid"
+	"github.com/erigontech/erigon/cl/persistence/beacon_indicies"
 	"github.com/erigontech/erigon/cl/phase1/forkchoice/mock_services"
 	"github.com/erigontech/erigon/cl/sentinel/communication"
 	"github.com/erigontech/erigon/cl/sentinel/communication/ssz_snappy"
 	"github.com/erigontech/erigon/cl/sentinel/peers"
 	"github.com/erigontech/erigon/cl/utils"
+	"github.com/erigontech/erigon/cl/utils/eth_clock"
 )
 
 func TestLightClientOptimistic(t *testing.T) {
@@ -395,3 +397,85 @@ func TestLightClientUpdates(t *testing.T) {
 	}
 
 }
+
+func TestLightClientUpdatesFromDB(t *testing.T) {
+	ctx := context.Background()
+
+	listenAddrHost := "/ip4/127.0.0.1/tcp/6015"
+	host, err := libp2p.New(libp2p.ListenAddrStrings(listenAddrHost))
+	require.NoError(t, err)
+
+	listenAddrHost1 := "/ip4/127.0.0.1/tcp/6043"
+	host1, err := libp2p.New(libp2p.ListenAddrStrings(listenAddrHost1))
+	require.NoError(t, err)
+
+	err = host.Connect(ctx, peer.AddrInfo{
+		ID:    host1.ID(),
+		Addrs: host1.Addrs(),
+	})
+	require.NoError(t, err)
+
+	peersPool := peers.NewPool()
+	beaconDB, indiciesDB := setupStore(t)
+
+	f := mock_services.NewForkChoiceStorageMock(t)
+	// mainnet genesis, hardcoded so that the test does not need the embedded genesis state
+	ethClock := eth_clock.NewEthereumClock(1606824023, libcommon.HexToHash("0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"), &clparams.MainnetBeaconConfig)
+
+	// the update of period 0 is only known from the antiquary.
+	persisted := cltypes.NewLightClientUpdate(clparams.AltairVersion)
+	persisted.SignatureSlot = 4321
+	tx, err := indiciesDB.BeginRw(ctx)
+	require.NoError(t, err)
+	require.NoError(t, beacon_indicies.WriteLightClientUpdate(tx, 0, persisted))
+	require.NoError(t, tx.Commit())
+
+	_, beaconCfg := clparams.GetConfigsByNetwork(1)
+	c := NewConsensusHandlers(
+		ctx,
+		beaconDB,
+		indiciesDB,
+		host,
+		peersPool,
+		&clparams.NetworkConfig{},
+		nil,
+		beaconCfg,
+		ethClock,
+		nil, f, nil, true,
+	)
+	c.Start()
+
+	stream, err := host1.NewStream(ctx, host.ID(), protocol.ID(communication.LightClientUpdatesByRangeProtocolV1))
+	require.NoError(t, err)
+
+	var reqBuf bytes.Buffer
+	require.NoError(t, ssz_snappy.EncodeAndWrite(&reqBuf, &cltypes.LightClientUpdatesByRangeRequest{StartPeriod: 0, Count: 1}))
+	_, err = stream.Write(libcommon.CopyBytes(reqBuf.Bytes()))
+	require.NoError(t, err)
+
+	firstByte := make([]byte, 1)
+	_, err = stream.Read(firstByte)
+	require.NoError(t, err)
+	require.Equal(t, byte(0), firstByte[0])
+
+	forkDigest := make([]byte, 4)
+	_, err = stream.Read(forkDigest)
+	require.NoError(t, err)
+	version, err := ethClock.StateVersionByForkDigest(utils.Uint32ToBytes4(binary.BigEndian.Uint32(forkDigest)))
+	require.NoError(t, err)
+	require.Equal(t, clparams.AltairVersion, version)
+
+	encodedLn, _, err := ssz_snappy.ReadUvarint(stream)
+	require.NoError(t, err)
+	raw := make([]byte, encodedLn)
+	sr := snappy.NewReader(stream)
+	bytesRead := 0
+	for bytesRead < int(encodedLn) {
+		n, err := sr.Read(raw[bytesRead:])
+		require.NoError(t, err)
+		bytesRead += n
+	}
+	update := cltypes.NewLightClientUpdate(version)
+	require.NoError(t, update.DecodeSSZ(raw, int(version)))
+	require.Equal(t, persisted.SignatureSlot, update.SignatureSlot)
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -32,9 +33,11 @@ import (
 	"github.com/erigontech/erigon/cl/cltypes"
 	"github.com/erigontech/erigon/cl/cltypes/solid"
 	"github.com/erigontech/erigon/cl/gossip"
+	"github.com/erigontech/erigon/cl/merkle_tree"
 	"github.com/erigontech/erigon/cl/phase1/core/state"
 	"github.com/erigontech/erigon/cl/phase1/network/subnets"
 	"github.com/erigontech/erigon/cl/utils"
+	"github.com/erigontech/erigon/cl/utils/bls"
 	"github.com/erigontech/erigon/cl/utils/eth_clock"
 )
 
@@ -46,6 +49,9 @@ var (
 	ErrEpochMismatch            = errors.New("epoch mismatch")
 	ErrExactlyOneBitSet         = errors.New("exactly one aggregation bit should be set")
 	ErrAggregationBitsMismatch  = errors.New("aggregation bits mismatch committee size")
+	ErrInvalidSelectionProof    = errors.New("invalid selection proof")
+	ErrValidatorNotInCommittee  = errors.New("validator is not in any beacon committee at slot")
+	ErrValidatorIndexOutOfRange = errors.New("validator index out of range")
 )
 
 type CommitteeSubscribeMgmt struct {
@@ -103,18 +109,23 @@ func (c *CommitteeSubscribeMgmt) AddAttestationSubscription(ctx context.Context,
 
 	log.Trace("Add attestation subscription", "slot", slot, "committeeIndex", cIndex, "isAggregator", p.IsAggregator, "validatorIndex", p.ValidatorIndex)
 	commiteePerSlot := c.syncedData.CommitteeCount(p.Slot / c.beaconConfig.SlotsPerEpoch)
+	return c.subscribe(ctx, slot, cIndex, commiteePerSlot, p.IsAggregator)
+}
+
+// subscribe adds a subscription to the committee cIndex at slot and joins the committee's attestation subnet.
+func (c *CommitteeSubscribeMgmt) subscribe(ctx context.Context, slot, cIndex, commiteePerSlot uint64, isAggregator bool) error {
 	subnetId := subnets.ComputeSubnetForAttestation(commiteePerSlot, slot, cIndex, c.beaconConfig.SlotsPerEpoch, c.netConfig.AttestationSubnetCount)
 	// add validator to subscription
 	c.validatorSubsMutex.Lock()
 
 	if _, ok := c.validatorSubs[cIndex]; !ok {
 		c.validatorSubs[cIndex] = &validatorSub{
-			aggregate:         p.IsAggregator,
+			aggregate:         isAggregator,
 			largestTargetSlot: slot,
 		}
 	} else {
 		// set aggregator to true if any validator in the committee is an aggregator
-		c.validatorSubs[cIndex].aggregate = (c.validatorSubs[cIndex].aggregate || p.IsAggregator)
+		c.validatorSubs[cIndex].aggregate = (c.validatorSubs[cIndex].aggregate || isAggregator)
 		// update latest target slot
 		if c.validatorSubs[cIndex].largestTargetSlot < slot {
 			c.validatorSubs[cIndex].largestTargetSlot = slot
@@ -134,6 +145,74 @@ func (c *CommitteeSubscribeMgmt) AddAttestationSubscription(ctx context.Context,
 	return nil
 }
 
+// AddBeaconCommitteeSelection verifies a selection proof and, if it selects the validator as an aggregator,
+// enables aggregation for the validator's committee. Distributed validators only know whether they aggregate once
+// the partial slot signatures are combined, so the is_aggregator flag of their subscriptions cannot be relied upon.
+func (c *CommitteeSubscribeMgmt) AddBeaconCommitteeSelection(ctx context.Context, selection *cltypes.BeaconCommitteeSelection) (bool, error) {
+	if c.syncedData.Syncing() {
+		return false, errors.New("head state not available")
+	}
+
+	var (
+		cIndex         uint64
+		committeeCount uint64
+		isAggregator   bool
+	)
+	if err := c.syncedData.ViewHeadState(func(s *state.CachingBeaconState) error {
+		if err := verifyBeaconCommitteeSelectionProof(s, selection); err != nil {
+			return err
+		}
+		committeeCount = s.CommitteeCount(selection.Slot / c.beaconConfig.SlotsPerEpoch)
+		for i := uint64(0); i < committeeCount; i++ {
+			committee, err := s.GetBeaconCommitee(selection.Slot, i)
+			if err != nil {
+				return err
+			}
+			if slices.Contains(committee, selection.ValidatorIndex) {
+				cIndex = i
+				isAggregator = state.IsAggregator(c.beaconConfig, uint64(len(committee)), i, selection.SelectionProof)
+				return nil
+			}
+		}
+		return ErrValidatorNotInCommittee
+	}); err != nil {
+		return false, err
+	}
+
+	log.Trace("Add beacon committee selection", "slot", selection.Slot, "committeeIndex", cIndex, "isAggregator", isAggregator, "validatorIndex", selection.ValidatorIndex)
+	if !isAggregator {
+		return false, nil
+	}
+	if err := c.subscribe(ctx, selection.Slot, cIndex, committeeCount, true); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// verifyBeaconCommitteeSelectionProof checks that the selection proof is the validator's signature of the slot.
+func verifyBeaconCommitteeSelectionProof(s *state.CachingBeaconState, selection *cltypes.BeaconCommitteeSelection) error {
+	if selection.ValidatorIndex >= uint64(s.ValidatorLength()) {
+		return ErrValidatorIndexOutOfRange
+	}
+	publicKey, err := s.ValidatorPublicKey(int(selection.ValidatorIndex))
+	if err != nil {
+		return err
+	}
+	domain, err := s.GetDomain(s.BeaconConfig().DomainSelectionProof, state.GetEpochAtSlot(s.BeaconConfig(), selection.Slot))
+	if err != nil {
+		return err
+	}
+	signingRoot := utils.Sha256(merkle_tree.Uint64Root(selection.Slot).Bytes(), domain)
+	valid, err := bls.Verify(selection.SelectionProof[:], signingRoot[:], publicKey[:])
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return ErrInvalidSelectionProof
+	}
+	return nil
+}
+
 func (c *CommitteeSubscribeMgmt) AggregateAttestation(att *solid.Attestation) error {
 	var (
 		committeeIndex = att.Data.CommitteeIndex
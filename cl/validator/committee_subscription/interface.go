@@ -26,6 +26,7 @@ import (
 //go:generate mockgen -typed=true -destination=./mock_services/committee_subscribe_mock.go -package=mock_services . CommitteeSubscribe
 type CommitteeSubscribe interface {
 	AddAttestationSubscription(ctx context.Context, p *cltypes.BeaconCommitteeSubscription) error
+	AddBeaconCommitteeSelection(ctx context.Context, selection *cltypes.BeaconCommitteeSelection) (bool, error)
 	AggregateAttestation(att *solid.Attestation) error
 	NeedToAggregate(att *solid.Attestation) bool
 }
@@ -80,6 +80,45 @@ func (c *MockCommitteeSubscribeAddAttestationSubscriptionCall) DoAndReturn(f fun
 	return c
 }
 
+// AddBeaconCommitteeSelection mocks base method.
+func (m *MockCommitteeSubscribe) AddBeaconCommitteeSelection(ctx context.Context, selection *cltypes.BeaconCommitteeSelection) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBeaconCommitteeSelection", ctx, selection)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBeaconCommitteeSelection indicates an expected call of AddBeaconCommitteeSelection.
+func (mr *MockCommitteeSubscribeMockRecorder) AddBeaconCommitteeSelection(ctx, selection any) *MockCommitteeSubscribeAddBeaconCommitteeSelectionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBeaconCommitteeSelection", reflect.TypeOf((*MockCommitteeSubscribe)(nil).AddBeaconCommitteeSelection), ctx, selection)
+	return &MockCommitteeSubscribeAddBeaconCommitteeSelectionCall{Call: call}
+}
+
+// MockCommitteeSubscribeAddBeaconCommitteeSelectionCall wrap *gomock.Call
+type MockCommitteeSubscribeAddBeaconCommitteeSelectionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCommitteeSubscribeAddBeaconCommitteeSelectionCall) Return(arg0 bool, arg1 error) *MockCommitteeSubscribeAddBeaconCommitteeSelectionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCommitteeSubscribeAddBeaconCommitteeSelectionCall) Do(f func(context.Context, *cltypes.BeaconCommitteeSelection) (bool, error)) *MockCommitteeSubscribeAddBeaconCommitteeSelectionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCommitteeSubscribeAddBeaconCommitteeSelectionCall) DoAndReturn(f func(context.Context, *cltypes.BeaconCommitteeSelection) (bool, error)) *MockCommitteeSubscribeAddBeaconCommitteeSelectionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // AggregateAttestation mocks base method.
 func (m *MockCommitteeSubscribe) AggregateAttestation(att *solid.Attestation) error {
 	m.ctrl.T.Helper()
@@ -31,6 +31,8 @@ type SyncContributionPool interface {
 	AddSyncContribution(headState *state.CachingBeaconState, contribution *cltypes.Contribution) error
 	// AddSyncCommitteeMessage aggretates a sync committee message to a contribution to the pool.
 	AddSyncCommitteeMessage(headState *state.CachingBeaconState, subCommitee uint64, message *cltypes.SyncCommitteeMessage) error
+	// AddSyncCommitteeSelection verifies a sync committee selection proof and returns whether it selects the validator as aggregator.
+	AddSyncCommitteeSelection(headState *state.CachingBeaconState, selection *cltypes.SyncCommitteeSelection) (bool, error)
 
 	// GetSyncContribution retrieves a sync contribution from the pool.
 	GetSyncContribution(slot, subcommitteeIndex uint64, beaconBlockRoot common.Hash) *cltypes.Contribution
@@ -80,6 +80,45 @@ func (c *MockSyncContributionPoolAddSyncCommitteeMessageCall) DoAndReturn(f func
 	return c
 }
 
+// AddSyncCommitteeSelection mocks base method.
+func (m *MockSyncContributionPool) AddSyncCommitteeSelection(headState *state.CachingBeaconState, selection *cltypes.SyncCommitteeSelection) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSyncCommitteeSelection", headState, selection)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSyncCommitteeSelection indicates an expected call of AddSyncCommitteeSelection.
+func (mr *MockSyncContributionPoolMockRecorder) AddSyncCommitteeSelection(headState, selection any) *MockSyncContributionPoolAddSyncCommitteeSelectionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSyncCommitteeSelection", reflect.TypeOf((*MockSyncContributionPool)(nil).AddSyncCommitteeSelection), headState, selection)
+	return &MockSyncContributionPoolAddSyncCommitteeSelectionCall{Call: call}
+}
+
+// MockSyncContributionPoolAddSyncCommitteeSelectionCall wrap *gomock.Call
+type MockSyncContributionPoolAddSyncCommitteeSelectionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSyncContributionPoolAddSyncCommitteeSelectionCall) Return(arg0 bool, arg1 error) *MockSyncContributionPoolAddSyncCommitteeSelectionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSyncContributionPoolAddSyncCommitteeSelectionCall) Do(f func(*state.CachingBeaconState, *cltypes.SyncCommitteeSelection) (bool, error)) *MockSyncContributionPoolAddSyncCommitteeSelectionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSyncContributionPoolAddSyncCommitteeSelectionCall) DoAndReturn(f func(*state.CachingBeaconState, *cltypes.SyncCommitteeSelection) (bool, error)) *MockSyncContributionPoolAddSyncCommitteeSelectionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // AddSyncContribution mocks base method.
 func (m *MockSyncContributionPool) AddSyncContribution(headState *state.CachingBeaconState, contribution *cltypes.Contribution) error {
 	m.ctrl.T.Helper()
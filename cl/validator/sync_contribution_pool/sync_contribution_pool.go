@@ -18,13 +18,16 @@ package sync_contribution_pool
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
+	"slices"
 	"sync"
 
 	"github.com/erigontech/erigon-lib/common"
 	"github.com/erigontech/erigon/cl/clparams"
 	"github.com/erigontech/erigon/cl/cltypes"
 	"github.com/erigontech/erigon/cl/cltypes/solid"
+	"github.com/erigontech/erigon/cl/fork"
 	"github.com/erigontech/erigon/cl/phase1/core/state"
 	"github.com/erigontech/erigon/cl/utils"
 	"github.com/erigontech/erigon/cl/utils/bls"
@@ -36,21 +39,35 @@ type syncContributionKey struct {
 	beaconBlockRoot   common.Hash
 }
 
+type syncSelectionKey struct {
+	slot              uint64
+	subcommitteeIndex uint64
+}
+
 type syncContributionPoolImpl struct {
 	// syncContributionPoolForBlocks is a map of sync contributions, indexed by slot, subcommittee index and block root.
 	syncContributionPoolForBlocks     map[syncContributionKey]*cltypes.Contribution // Used for block publishing.
 	syncContributionPoolForAggregates map[syncContributionKey]*cltypes.Contribution // Used for sync committee messages aggregation.
-	beaconCfg                         *clparams.BeaconChainConfig
+	// aggregatorSelections tracks the subcommittees for which a (possibly distributed) validator was selected as aggregator.
+	aggregatorSelections map[syncSelectionKey]struct{}
+	beaconCfg            *clparams.BeaconChainConfig
 
 	mu sync.Mutex
 }
 
-var ErrIsSuperset = errors.New("sync contribution is a superset of existing attestation")
+var (
+	ErrIsSuperset                  = errors.New("sync contribution is a superset of existing attestation")
+	ErrInvalidSelectionProof       = errors.New("invalid sync committee selection proof")
+	ErrValidatorNotInSubcommittee  = errors.New("validator is not in the sync subcommittee")
+	ErrSubcommitteeIndexOutOfRange = errors.New("subcommittee index out of range")
+	ErrValidatorIndexOutOfRange    = errors.New("validator index out of range")
+)
 
 func NewSyncContributionPool(beaconCfg *clparams.BeaconChainConfig) SyncContributionPool {
 	return &syncContributionPoolImpl{
 		syncContributionPoolForBlocks:     make(map[syncContributionKey]*cltypes.Contribution),
 		syncContributionPoolForAggregates: make(map[syncContributionKey]*cltypes.Contribution),
+		aggregatorSelections:              make(map[syncSelectionKey]struct{}),
 		beaconCfg:                         beaconCfg,
 	}
 }
@@ -113,8 +130,18 @@ func (s *syncContributionPoolImpl) GetSyncContribution(slot, subcommitteeIndex u
 
 func (s *syncContributionPoolImpl) cleanupOldContributions(headState *state.CachingBeaconState) {
 	for key := range s.syncContributionPoolForAggregates {
-		if headState.Slot() != key.slot {
-			delete(s.syncContributionPoolForAggregates, key)
+		if headState.Slot() == key.slot {
+			continue
+		}
+		// Distributed aggregators need extra rounds to combine their proofs, so keep their contributions for the whole selection window.
+		if _, selected := s.aggregatorSelections[syncSelectionKey{slot: key.slot, subcommitteeIndex: key.subcommitteeIndex}]; selected && s.inSelectionWindow(key.slot, headState.Slot()) {
+			continue
+		}
+		delete(s.syncContributionPoolForAggregates, key)
+	}
+	for key := range s.aggregatorSelections {
+		if !s.inSelectionWindow(key.slot, headState.Slot()) {
+			delete(s.aggregatorSelections, key)
 		}
 	}
 	for key := range s.syncContributionPoolForBlocks {
@@ -124,6 +151,64 @@ func (s *syncContributionPoolImpl) cleanupOldContributions(headState *state.Cach
 	}
 }
 
+// inSelectionWindow reports whether an aggregation duty for slot can still be carried out at headSlot. Distributed
+// validators request their selections for a whole epoch ahead, so the window lasts until the end of the slot's epoch.
+func (s *syncContributionPoolImpl) inSelectionWindow(slot, headSlot uint64) bool {
+	return headSlot/s.beaconCfg.SlotsPerEpoch <= slot/s.beaconCfg.SlotsPerEpoch
+}
+
+// AddSyncCommitteeSelection verifies a sync committee selection proof and records the aggregation duty if the
+// proof selects the validator as an aggregator for the subcommittee.
+func (s *syncContributionPoolImpl) AddSyncCommitteeSelection(headState *state.CachingBeaconState, selection *cltypes.SyncCommitteeSelection) (bool, error) {
+	cfg := headState.BeaconConfig()
+	if selection.SubcommitteeIndex >= cfg.SyncCommitteeSubnetCount {
+		return false, ErrSubcommitteeIndexOutOfRange
+	}
+	if selection.ValidatorIndex >= uint64(headState.ValidatorLength()) {
+		return false, ErrValidatorIndexOutOfRange
+	}
+	publicKey, err := headState.ValidatorPublicKey(int(selection.ValidatorIndex))
+	if err != nil {
+		return false, err
+	}
+	committee := getSyncCommitteeFromState(headState).GetCommittee()
+	subCommitteeSize := cfg.SyncCommitteeSize / cfg.SyncCommitteeSubnetCount
+	startSubCommittee := selection.SubcommitteeIndex * subCommitteeSize
+	if !slices.Contains(committee[startSubCommittee:startSubCommittee+subCommitteeSize], publicKey) {
+		return false, ErrValidatorNotInSubcommittee
+	}
+
+	domain, err := headState.GetDomain(cfg.DomainSyncCommitteeSelectionProof, state.GetEpochAtSlot(cfg, selection.Slot))
+	if err != nil {
+		return false, err
+	}
+	signingRoot, err := fork.ComputeSigningRoot(&cltypes.SyncAggregatorSelectionData{
+		Slot:              selection.Slot,
+		SubcommitteeIndex: selection.SubcommitteeIndex,
+	}, domain)
+	if err != nil {
+		return false, err
+	}
+	valid, err := bls.Verify(selection.SelectionProof[:], signingRoot[:], publicKey[:])
+	if err != nil {
+		return false, err
+	}
+	if !valid {
+		return false, ErrInvalidSelectionProof
+	}
+
+	modulo := max(1, subCommitteeSize/cfg.TargetAggregatorsPerSyncSubcommittee)
+	hashSignature := utils.Sha256(selection.SelectionProof[:])
+	if binary.LittleEndian.Uint64(hashSignature[:8])%modulo != 0 {
+		return false, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.aggregatorSelections[syncSelectionKey{slot: selection.Slot, subcommitteeIndex: selection.SubcommitteeIndex}] = struct{}{}
+	return true, nil
+}
+
 // AddSyncCommitteeMessage aggregates a sync committee message to a contribution to the pool.
 func (s *syncContributionPoolImpl) AddSyncCommitteeMessage(headState *state.CachingBeaconState, subCommittee uint64, message *cltypes.SyncCommitteeMessage) error {
 	s.mu.Lock()
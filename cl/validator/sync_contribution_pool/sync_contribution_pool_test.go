@@ -17,12 +17,14 @@
 package sync_contribution_pool
 
 import (
+	"math"
 	"testing"
 
 	"github.com/erigontech/erigon-lib/common"
 	"github.com/erigontech/erigon/cl/clparams"
 	"github.com/erigontech/erigon/cl/cltypes"
 	"github.com/erigontech/erigon/cl/cltypes/solid"
+	"github.com/erigontech/erigon/cl/fork"
 	"github.com/erigontech/erigon/cl/phase1/core/state"
 	"github.com/erigontech/erigon/cl/utils"
 	"github.com/erigontech/erigon/cl/utils/bls"
@@ -76,3 +78,69 @@ func TestSyncContributionPool(t *testing.T) {
 	contribution.SubcommitteeIndex = 1
 	require.NoError(t, pool.AddSyncContribution(s, contribution))
 }
+
+func TestSyncCommitteeSelection(t *testing.T) {
+	privateKeys, msgs, s := getTestCommitteesMessages(16)
+	pool := NewSyncContributionPool(&clparams.MainnetBeaconConfig)
+
+	domain, err := s.GetDomain(s.BeaconConfig().DomainSyncCommitteeSelectionProof, 0)
+	require.NoError(t, err)
+	signingRoot, err := fork.ComputeSigningRoot(&cltypes.SyncAggregatorSelectionData{}, domain)
+	require.NoError(t, err)
+
+	// find a validator selected as aggregator and one that is not.
+	var aggregator, nonAggregator *cltypes.SyncCommitteeSelection
+	for i, privateKeyBytes := range privateKeys {
+		privateKey, err := bls.NewPrivateKeyFromBytes(privateKeyBytes)
+		require.NoError(t, err)
+		selection := &cltypes.SyncCommitteeSelection{
+			ValidatorIndex: uint64(i),
+			SelectionProof: common.Bytes96(privateKey.Sign(signingRoot[:]).Bytes()),
+		}
+		selected, err := pool.AddSyncCommitteeSelection(s, selection)
+		require.NoError(t, err)
+		if selected && aggregator == nil {
+			aggregator = selection
+		} else if !selected && nonAggregator == nil {
+			nonAggregator = selection
+		}
+	}
+	require.NotNil(t, aggregator)
+	require.NotNil(t, nonAggregator)
+
+	// a proof from another validator is rejected.
+	_, err = pool.AddSyncCommitteeSelection(s, &cltypes.SyncCommitteeSelection{
+		ValidatorIndex: nonAggregator.ValidatorIndex,
+		SelectionProof: aggregator.SelectionProof,
+	})
+	require.ErrorIs(t, err, ErrInvalidSelectionProof)
+	_, err = pool.AddSyncCommitteeSelection(s, &cltypes.SyncCommitteeSelection{
+		ValidatorIndex:    aggregator.ValidatorIndex,
+		SubcommitteeIndex: 1,
+		SelectionProof:    aggregator.SelectionProof,
+	})
+	require.ErrorIs(t, err, ErrValidatorNotInSubcommittee)
+	_, err = pool.AddSyncCommitteeSelection(s, &cltypes.SyncCommitteeSelection{
+		ValidatorIndex: math.MaxUint64,
+		SelectionProof: aggregator.SelectionProof,
+	})
+	require.ErrorIs(t, err, ErrValidatorIndexOutOfRange)
+
+	// the contribution of a selected subcommittee survives until the end of the selection window.
+	require.NoError(t, pool.AddSyncCommitteeMessage(s, 0, &msgs[0]))
+	nextSlotMsg := msgs[1]
+	lastSlot := s.BeaconConfig().SlotsPerEpoch - 1
+	for _, slot := range []uint64{1, 2, lastSlot} {
+		s.SetSlot(slot)
+		nextSlotMsg.Slot = slot
+		require.NoError(t, pool.AddSyncCommitteeMessage(s, 0, &nextSlotMsg))
+		contribution := pool.GetSyncContribution(0, 0, testHash)
+		require.True(t, utils.IsBitOn(contribution.AggregationBits, 0))
+	}
+
+	s.SetSlot(lastSlot + 1)
+	nextSlotMsg.Slot = lastSlot + 1
+	require.NoError(t, pool.AddSyncCommitteeMessage(s, 0, &nextSlotMsg))
+	contribution := pool.GetSyncContribution(0, 0, testHash)
+	require.False(t, utils.IsBitOn(contribution.AggregationBits, 0))
+}
@@ -55,6 +55,7 @@ import (
 
 	"github.com/spf13/afero"
 
+	"github.com/erigontech/erigon/cl/monitor"
 	"github.com/erigontech/erigon/cl/persistence/beacon_indicies"
 	"github.com/erigontech/erigon/cl/persistence/blob_storage"
 	"github.com/erigontech/erigon/cl/persistence/format/snapshot_format"
@@ -216,6 +217,11 @@ func RunCaplinService(ctx context.Context, engine execution_client.ExecutionEngi
 		return err
 	}
 
+	depositSnapshot, err := checkpoint_sync.ReadOrFetchDepositSnapshot(ctx, indexDB, beaconConfig, config, state)
+	if err != nil {
+		return err
+	}
+
 	caplinOptions := []CaplinOption{}
 	if config.BeaconAPIRouter.Builder {
 		if config.RelayUrlExist() {
@@ -267,13 +273,29 @@ func RunCaplinService(ctx context.Context, engine execution_client.ExecutionEngi
 	// create the public keys registry
 	pksRegistry := public_keys_registry.NewHeadViewPublicKeysRegistry(syncedDataManager)
 
+	// create the validator monitor
+	validatorMonitor := monitor.NewValidatorMonitor(config.EnableValidatorMonitor, beaconConfig)
+	monitoredIndicies, monitoredPubkeys, err := monitor.ParseValidatorMonitorIds(config.ValidatorMonitorIds)
+	if err != nil {
+		return err
+	}
+	for _, idx := range monitoredIndicies {
+		validatorMonitor.ObserveValidator(idx)
+	}
+	for _, pubkey := range monitoredPubkeys {
+		validatorMonitor.ObserveValidatorPubkey(pubkey)
+	}
+
 	forkChoice, err := forkchoice.NewForkChoiceStore(
 		ethClock, state, engine, pool, fork_graph.NewForkGraphDisk(state, syncedDataManager, fcuFs, config.BeaconAPIRouter, emitters),
-		emitters, syncedDataManager, blobStorage, pksRegistry, doLMDSampling)
+		emitters, syncedDataManager, blobStorage, pksRegistry, doLMDSampling, validatorMonitor)
 	if err != nil {
 		logger.Error("Could not create forkchoice", "err", err)
 		return err
 	}
+	if depositSnapshot != nil {
+		forkChoice.SetDepositSnapshot(depositSnapshot)
+	}
 	bls.SetEnabledCaching(true)
 
 	forkDigest, err := ethClock.CurrentForkDigest()
@@ -437,6 +459,7 @@ func RunCaplinService(ctx context.Context, engine execution_client.ExecutionEngi
 			option.builderClient,
 			stateSnapshots,
 			true,
+			validatorMonitor,
 		)
 		go beacon.ListenAndServe(&beacon.LayeredBeaconHandler{
 			ArchiveApi: apiHandler,
@@ -42,6 +42,7 @@ func init() {
 		scenarios.StepHandler(SendFunds),
 		scenarios.StepHandler(GetBalance),
 		scenarios.StepHandler(GetNonce),
+		scenarios.StepHandler(AssertBalance),
 	)
 }
 
@@ -213,6 +214,39 @@ func GetBalance(ctx context.Context, accountName string, blockNum rpc.BlockNumbe
 	return bal.Uint64(), nil
 }
 
+// AssertBalance checks the latest balance of the account, which is either the name of a devnet account or an address
+func AssertBalance(ctx context.Context, account string, expected *big.Int) error {
+	node := devnet.CurrentNode(ctx)
+
+	if node == nil {
+		node = devnet.SelectBlockProducer(ctx)
+	}
+
+	var address libcommon.Address
+
+	if acc := accounts.GetAccount(account); acc != nil {
+		address = acc.Address
+	} else if libcommon.IsHexAddress(account) {
+		address = libcommon.HexToAddress(account)
+	} else {
+		return fmt.Errorf("Unknown account: %s", account)
+	}
+
+	balance, err := node.GetBalance(address, rpc.LatestBlock)
+
+	if err != nil {
+		return fmt.Errorf("failed to get balance for address 0x%x: %w", address, err)
+	}
+
+	if balance.Cmp(expected) != 0 {
+		return fmt.Errorf("unexpected balance for address 0x%x got: %s, expected: %s", address, balance, expected)
+	}
+
+	devnet.Logger(ctx).Info("SUCCESS", "address", address, "balance", balance)
+
+	return nil
+}
+
 func GetNonce(ctx context.Context, address libcommon.Address) (uint64, error) {
 	node := devnet.CurrentNode(ctx)
 
@@ -67,6 +67,7 @@ type NodeArgs struct {
 	WithoutHeimdall           bool   `arg:"--bor.withoutheimdall" flag:"" default:"false" json:"bor.withoutheimdall,omitempty"`
 	HeimdallURL               string `arg:"--bor.heimdall" json:"bor.heimdall,omitempty"`
 	WithHeimdallMilestones    bool   `arg:"--bor.milestone" json:"bor.milestone"`
+	PolygonSync               bool   `arg:"--polygon.sync" flag:"" default:"false" json:"polygon.sync,omitempty"`
 	VMDebug                   bool   `arg:"--vmdebug" flag:"" default:"false" json:"dmdebug"`
 
 	NodeKey    *ecdsa.PrivateKey `arg:"-"`
@@ -117,6 +118,7 @@ func (node *NodeArgs) Configure(base NodeArgs, nodeNumber int) error {
 	node.Port = base.Port + nodeNumber
 
 	node.WithHeimdallMilestones = base.WithHeimdallMilestones
+	node.PolygonSync = base.PolygonSync
 
 	return nil
 }
@@ -171,7 +173,9 @@ func (m *BlockProducer) Configure(baseNode NodeArgs, nodeNumber int) error {
 		if m.DevPeriod == 0 {
 			m.DevPeriod = 30
 		}
-		m.account = accounts.NewAccount(m.GetName() + "-etherbase")
+		// the dev genesis has the etherbase as its only signer and dev blocks are signed with the
+		// devnet key, so the producers share one account to agree on the genesis and the signer
+		m.account = accounts.NewAccount(m.Chain + "-etherbase")
 		core.DevnetEtherbase = m.account.Address
 		core.DevnetSignPrivateKey = m.account.SigKey()
 
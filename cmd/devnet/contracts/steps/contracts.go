@@ -0,0 +1,152 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package contracts_steps
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/erigontech/erigon"
+	"github.com/erigontech/erigon-lib/common"
+
+	"github.com/erigontech/erigon/accounts/abi"
+	"github.com/erigontech/erigon/accounts/abi/bind"
+	"github.com/erigontech/erigon/cmd/devnet/accounts"
+	"github.com/erigontech/erigon/cmd/devnet/blocks"
+	"github.com/erigontech/erigon/cmd/devnet/contracts"
+	"github.com/erigontech/erigon/cmd/devnet/devnet"
+	"github.com/erigontech/erigon/cmd/devnet/devnetutils"
+	"github.com/erigontech/erigon/cmd/devnet/scenarios"
+	"github.com/erigontech/erigon/core/types"
+)
+
+func init() {
+	scenarios.MustRegisterStepHandlers(
+		scenarios.StepHandler(DeployContract),
+		scenarios.StepHandler(AssertLogs),
+	)
+}
+
+type deployableContract struct {
+	abi string
+	bin string
+}
+
+// deployableContracts are the contracts of the contracts package which can be deployed by name
+var deployableContracts = map[string]deployableContract{
+	"ChildReceiver":   {contracts.ChildReceiverABI, contracts.ChildReceiverBin},
+	"ChildSender":     {contracts.ChildSenderABI, contracts.ChildSenderBin},
+	"Faucet":          {contracts.FaucetABI, contracts.FaucetBin},
+	"RootReceiver":    {contracts.RootReceiverABI, contracts.RootReceiverBin},
+	"RootSender":      {contracts.RootSenderABI, contracts.RootSenderBin},
+	"Subscription":    {contracts.SubscriptionABI, contracts.SubscriptionBin},
+	"TestRootChain":   {contracts.TestRootChainABI, contracts.TestRootChainBin},
+	"TestStateSender": {contracts.TestStateSenderABI, contracts.TestStateSenderBin},
+}
+
+// DeployContract deploys a contract of the contracts package by its name, e.g. Subscription, on the
+// current network and returns its address. The args are the arguments of the contract constructor.
+func DeployContract(ctx context.Context, deployerName string, contractName string, args ...interface{}) (common.Address, error) {
+	deployer := accounts.GetAccount(deployerName)
+
+	if deployer == nil {
+		return common.Address{}, fmt.Errorf("Unknown account: %s", deployerName)
+	}
+
+	contract, ok := deployableContracts[contractName]
+
+	if !ok {
+		return common.Address{}, fmt.Errorf("unknown contract: %s", contractName)
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(contract.abi))
+
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	if len(args) != len(parsed.Constructor.Inputs) {
+		return common.Address{}, fmt.Errorf("%s constructor expects %d arguments, got %d", contractName, len(parsed.Constructor.Inputs), len(args))
+	}
+
+	params := make([]interface{}, len(args))
+
+	for i, input := range parsed.Constructor.Inputs {
+		param, err := scenarios.ConvertArg(args[i], input.Type.GetType())
+
+		if err != nil {
+			return common.Address{}, fmt.Errorf("%s constructor argument %s: %w", contractName, input.Name, err)
+		}
+
+		params[i] = param.Interface()
+	}
+
+	waiter, cancel := blocks.BlockWaiter(ctx, contracts.DeploymentChecker)
+	defer cancel()
+
+	address, transaction, _, err := contracts.Deploy(ctx, deployer.Address,
+		func(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, types.Transaction, *bind.BoundContract, error) {
+			return bind.DeployContract(auth, parsed, common.FromHex(contract.bin), backend, params...)
+		})
+
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to deploy %s: %w", contractName, err)
+	}
+
+	block, err := waiter.Await(transaction.Hash())
+
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to await %s deployment: %w", contractName, err)
+	}
+
+	devnet.Logger(ctx).Info("Contract deployed", "contract", contractName, "chain", devnet.CurrentChainName(ctx), "block", block.Number, "addr", address)
+
+	return address, nil
+}
+
+// AssertLogs checks the number of logs of the event, given by its signature e.g. Transfer(address,address,uint256),
+// emitted by the contract at the address up to the latest block.
+func AssertLogs(ctx context.Context, address common.Address, eventSignature string, expectedCount int) error {
+	node := devnet.SelectNode(ctx)
+
+	blockNum, err := node.BlockNumber()
+
+	if err != nil {
+		return err
+	}
+
+	logs, err := node.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: big.NewInt(0),
+		ToBlock:   new(big.Int).SetUint64(blockNum),
+		Addresses: []common.Address{address},
+		Topics:    [][]common.Hash{devnetutils.GenerateTopic(eventSignature)},
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to get logs: %w", err)
+	}
+
+	if len(logs) != expectedCount {
+		return fmt.Errorf("unexpected number of %s logs of 0x%x got: %d, expected: %d", eventSignature, address, len(logs), expectedCount)
+	}
+
+	devnet.Logger(ctx).Info("SUCCESS => Logs checked", "event", eventSignature, "address", address, "count", len(logs))
+
+	return nil
+}
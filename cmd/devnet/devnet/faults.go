@@ -0,0 +1,489 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package devnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/erigontech/erigon-lib/kv"
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon/consensus/clique"
+	"github.com/erigontech/erigon/p2p/enode"
+)
+
+var (
+	ErrFaultInjectionDisabled = errors.New("fault injection is not enabled on the network")
+	ErrUnknownNode            = errors.New("unknown node")
+)
+
+// Partition cuts the p2p connections between the groups of nodes, given by their names, until the
+// network is healed. The nodes which are not in any group keep their connections to all the nodes.
+func (nw *Network) Partition(groups ...[]string) error {
+	if nw.faults == nil {
+		return ErrFaultInjectionDisabled
+	}
+
+	indexes := make([][]int, len(groups))
+
+	for i, group := range groups {
+		for _, name := range group {
+			node, err := nw.nodeIndex(name)
+			if err != nil {
+				return err
+			}
+
+			indexes[i] = append(indexes[i], node)
+		}
+	}
+
+	nw.Logger.Info("Partitioning network", "chain", nw.Chain, "groups", groups)
+	nw.faults.partition(indexes)
+
+	return nil
+}
+
+// Heal removes the partitions and the delays and drops of the network
+func (nw *Network) Heal() error {
+	if nw.faults == nil {
+		return ErrFaultInjectionDisabled
+	}
+
+	nw.Logger.Info("Healing network", "chain", nw.Chain)
+	nw.faults.heal()
+
+	return nil
+}
+
+// DelayPropagation delays the data, and so the blocks, the node sends to its peers, a zero delay removes the delay
+func (nw *Network) DelayPropagation(name string, delay time.Duration) error {
+	if nw.faults == nil {
+		return ErrFaultInjectionDisabled
+	}
+
+	node, err := nw.nodeIndex(name)
+	if err != nil {
+		return err
+	}
+
+	nw.Logger.Info("Delaying propagation", "node", name, "delay", delay)
+	nw.faults.delay(node, delay)
+
+	return nil
+}
+
+// DropPropagation drops the data, and so the blocks, the node sends to its peers until the network is healed
+func (nw *Network) DropPropagation(name string, drop bool) error {
+	if nw.faults == nil {
+		return ErrFaultInjectionDisabled
+	}
+
+	node, err := nw.nodeIndex(name)
+	if err != nil {
+		return err
+	}
+
+	nw.Logger.Info("Dropping propagation", "node", name, "drop", drop)
+	nw.faults.drop(node, drop)
+
+	return nil
+}
+
+// StopNode stops a running node, its data dir is kept so that it can be restarted
+func (nw *Network) StopNode(name string) error {
+	index, err := nw.nodeIndex(name)
+	if err != nil {
+		return err
+	}
+
+	node, ok := nw.Nodes[index].(*devnetNode)
+	if !ok || !node.running() {
+		return fmt.Errorf("node %s is not running", name)
+	}
+
+	// the clique engine doesn't own its snapshot db, which has to be released
+	// once the node exits so that the restarted node can open it again
+	var cliqueDB kv.RwDB
+
+	node.Lock()
+	if node.ethNode != nil {
+		if engine, ok := node.ethNode.Backend().Engine().(*clique.Clique); ok {
+			cliqueDB = engine.DB
+		}
+	}
+	node.Unlock()
+
+	nw.Logger.Info("Stopping node", "node", name)
+	node.Stop()
+
+	node.Lock()
+	exited := node.exited
+	node.Unlock()
+
+	if exited != nil {
+		<-exited
+	}
+
+	if cliqueDB != nil {
+		cliqueDB.Close()
+	}
+
+	return nil
+}
+
+// RestartNode starts a stopped node with its existing data dir
+func (nw *Network) RestartNode(ctx context.Context, name string) error {
+	index, err := nw.nodeIndex(name)
+	if err != nil {
+		return err
+	}
+
+	node, ok := nw.Nodes[index].(*devnetNode)
+	if !ok {
+		return fmt.Errorf("node %s can't be restarted", name)
+	}
+
+	node.Lock()
+	exited := node.exited
+	node.Unlock()
+
+	if exited != nil {
+		select {
+		case <-exited:
+		default:
+			return fmt.Errorf("node %s is running", name)
+		}
+	}
+
+	node.Lock()
+	node.wg = &nw.wg
+	node.startErr = make(chan error)
+	node.Unlock()
+
+	nw.Logger.Info("Restarting node", "node", name)
+
+	if err := nw.startNode(node); err != nil {
+		return err
+	}
+
+	for _, service := range nw.Services {
+		service.NodeStarted(ctx, node)
+	}
+
+	return nil
+}
+
+// RunningNodes returns the nodes of the network which have not been stopped
+func (nw *Network) RunningNodes() []Node {
+	var running []Node
+
+	for _, node := range nw.Nodes {
+		if node, ok := node.(*devnetNode); ok && !node.running() {
+			continue
+		}
+
+		running = append(running, node)
+	}
+
+	return running
+}
+
+func (nw *Network) nodeIndex(name string) (int, error) {
+	for i, node := range nw.Nodes {
+		if node.GetName() == name {
+			return i, nil
+		}
+	}
+
+	return -1, fmt.Errorf("%w: %s", ErrUnknownNode, name)
+}
+
+// faultInjector routes the p2p connections between the nodes of a network through proxies, one per
+// pair of nodes, so that the connections can be cut or degraded while the nodes are running.
+type faultInjector struct {
+	sync.Mutex
+	logger log.Logger
+	links  []*link
+	// groups holds the partition group of the partitioned nodes by node index,
+	// nodes in different groups can't reach each other
+	groups map[int]int
+	// delays holds the delay of the data sent by a node by node index
+	delays map[int]time.Duration
+	// drops holds the nodes, by node index, which data is dropped
+	drops map[int]bool
+}
+
+func newFaultInjector(logger log.Logger) *faultInjector {
+	return &faultInjector{
+		logger: logger,
+		groups: map[int]int{},
+		delays: map[int]time.Duration{},
+		drops:  map[int]bool{},
+	}
+}
+
+// addLink creates the proxy the dialer node connects to the target node through and
+// returns the enode url of the target which points at the proxy
+func (f *faultInjector) addLink(dialer int, target int, targetURL string) (string, error) {
+	targetNode, err := enode.ParseV4(targetURL)
+	if err != nil {
+		return "", err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+
+	l := &link{
+		injector: f,
+		dialer:   dialer,
+		target:   target,
+		addr:     fmt.Sprintf("127.0.0.1:%d", targetNode.TCP()),
+		listener: listener,
+		conns:    map[net.Conn]struct{}{},
+	}
+
+	f.Lock()
+	f.links = append(f.links, l)
+	f.Unlock()
+
+	go l.serve()
+
+	port := listener.Addr().(*net.TCPAddr).Port
+	return enode.NewV4(targetNode.Pubkey(), net.ParseIP("127.0.0.1"), port, port).URLv4(), nil
+}
+
+func (f *faultInjector) partitioned(from int, to int) bool {
+	f.Lock()
+	defer f.Unlock()
+	return f.partitionedLocked(from, to)
+}
+
+func (f *faultInjector) partitionedLocked(from int, to int) bool {
+	fromGroup, fromOk := f.groups[from]
+	toGroup, toOk := f.groups[to]
+	return fromOk && toOk && fromGroup != toGroup
+}
+
+// fault returns the delay and whether to drop the data sent by the node
+func (f *faultInjector) fault(from int) (time.Duration, bool) {
+	f.Lock()
+	defer f.Unlock()
+	return f.delays[from], f.drops[from]
+}
+
+func (f *faultInjector) partition(groups [][]int) {
+	f.Lock()
+	f.groups = map[int]int{}
+	for group, nodes := range groups {
+		for _, node := range nodes {
+			f.groups[node] = group
+		}
+	}
+
+	var cut []*link
+	for _, l := range f.links {
+		if f.partitionedLocked(l.dialer, l.target) {
+			cut = append(cut, l)
+		}
+	}
+	f.Unlock()
+
+	for _, l := range cut {
+		l.closeConns()
+	}
+}
+
+func (f *faultInjector) heal() {
+	f.Lock()
+	defer f.Unlock()
+	f.groups = map[int]int{}
+	f.delays = map[int]time.Duration{}
+	f.drops = map[int]bool{}
+}
+
+func (f *faultInjector) delay(node int, delay time.Duration) {
+	f.Lock()
+	defer f.Unlock()
+	if delay > 0 {
+		f.delays[node] = delay
+	} else {
+		delete(f.delays, node)
+	}
+}
+
+func (f *faultInjector) drop(node int, drop bool) {
+	f.Lock()
+	defer f.Unlock()
+	if drop {
+		f.drops[node] = true
+	} else {
+		delete(f.drops, node)
+	}
+}
+
+func (f *faultInjector) close() {
+	f.Lock()
+	links := f.links
+	f.links = nil
+	f.Unlock()
+
+	for _, l := range links {
+		l.listener.Close()
+		l.closeConns()
+	}
+}
+
+// link proxies the connections of a dialer node to a target node
+type link struct {
+	sync.Mutex
+	injector *faultInjector
+	dialer   int
+	target   int
+	addr     string
+	listener net.Listener
+	conns    map[net.Conn]struct{}
+}
+
+func (l *link) serve() {
+	for {
+		conn, err := l.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		if l.injector.partitioned(l.dialer, l.target) {
+			conn.Close()
+			continue
+		}
+
+		go l.proxy(conn)
+	}
+}
+
+func (l *link) proxy(dialerConn net.Conn) {
+	targetConn, err := net.Dial("tcp", l.addr)
+	if err != nil {
+		dialerConn.Close()
+		return
+	}
+
+	l.Lock()
+	l.conns[dialerConn] = struct{}{}
+	l.conns[targetConn] = struct{}{}
+	l.Unlock()
+
+	defer func() {
+		l.Lock()
+		delete(l.conns, dialerConn)
+		delete(l.conns, targetConn)
+		l.Unlock()
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		l.forward(l.dialer, l.target, dialerConn, targetConn)
+	}()
+
+	go func() {
+		defer wg.Done()
+		l.forward(l.target, l.dialer, targetConn, dialerConn)
+	}()
+
+	wg.Wait()
+}
+
+type chunk struct {
+	data    []byte
+	readAt  time.Time
+	dropped bool
+}
+
+// forward copies the data sent by the from node to the to node applying the faults of the from node,
+// the data is delayed by queueing it so that the order of the stream is preserved
+func (l *link) forward(from int, to int, src net.Conn, dst net.Conn) {
+	defer src.Close()
+	defer dst.Close()
+
+	queue := make(chan chunk, 1024)
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		defer close(queue)
+
+		for {
+			buf := make([]byte, 32*1024)
+			n, err := src.Read(buf)
+
+			if n > 0 {
+				_, dropped := l.injector.fault(from)
+
+				select {
+				case queue <- chunk{buf[:n], time.Now(), dropped}:
+				case <-done:
+					return
+				}
+			}
+
+			if err != nil {
+				if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+					l.injector.logger.Trace("[devnet] link read failed", "from", from, "to", to, "err", err)
+				}
+				return
+			}
+		}
+	}()
+
+	for c := range queue {
+		if l.injector.partitioned(from, to) {
+			return
+		}
+
+		// dropped data breaks the stream, so the peers stop receiving each others
+		// messages until they time out, which is what a lossy link looks like to them
+		if c.dropped {
+			continue
+		}
+
+		if delay, _ := l.injector.fault(from); delay > 0 {
+			time.Sleep(time.Until(c.readAt.Add(delay)))
+		}
+
+		if _, err := dst.Write(c.data); err != nil {
+			return
+		}
+	}
+}
+
+func (l *link) closeConns() {
+	l.Lock()
+	defer l.Unlock()
+
+	for conn := range l.conns {
+		conn.Close()
+	}
+}
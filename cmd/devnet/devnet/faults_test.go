@@ -0,0 +1,112 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package devnet
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/erigontech/erigon-lib/crypto"
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon/p2p/enode"
+)
+
+func TestFaultInjectorLinks(t *testing.T) {
+	target, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer target.Close()
+
+	// the target node echoes what it receives
+	go func() {
+		for {
+			conn, err := target.Accept()
+			if err != nil {
+				return
+			}
+			go io.Copy(conn, conn) //nolint:errcheck
+		}
+	}()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	port := target.Addr().(*net.TCPAddr).Port
+	targetURL := enode.NewV4(&key.PublicKey, net.ParseIP("127.0.0.1"), port, port).URLv4()
+
+	faults := newFaultInjector(log.New())
+	defer faults.close()
+
+	proxyURL, err := faults.addLink(1, 0, targetURL)
+	require.NoError(t, err)
+
+	proxy, err := enode.ParseV4(proxyURL)
+	require.NoError(t, err)
+	require.Equal(t, enode.PubkeyToIDV4(&key.PublicKey), proxy.ID())
+	require.NotEqual(t, port, proxy.TCP())
+
+	dial := func() net.Conn {
+		conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", proxy.TCP()))
+		require.NoError(t, err)
+		require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+		return conn
+	}
+
+	echo := func(conn net.Conn) error {
+		if _, err := conn.Write([]byte("block")); err != nil {
+			return err
+		}
+		buf := make([]byte, 5)
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			return err
+		}
+		require.Equal(t, "block", string(buf))
+		return nil
+	}
+
+	conn := dial()
+	defer conn.Close()
+	require.NoError(t, echo(conn))
+
+	// the delay applies to the data sent by the dialer
+	faults.delay(1, 200*time.Millisecond)
+	start := time.Now()
+	require.NoError(t, echo(conn))
+	require.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+
+	// partitions cut the existing connections and refuse new ones
+	faults.partition([][]int{{0}, {1}})
+	require.Error(t, echo(conn))
+
+	partitioned := dial()
+	defer partitioned.Close()
+	require.Error(t, echo(partitioned))
+
+	faults.heal()
+	healed := dial()
+	defer healed.Close()
+	require.NoError(t, echo(healed))
+
+	// dropped data never reaches the peer
+	faults.drop(1, true)
+	require.NoError(t, healed.SetReadDeadline(time.Now().Add(200*time.Millisecond)))
+	require.Error(t, echo(healed))
+}
@@ -56,9 +56,14 @@ type Network struct {
 	BorPeriod          time.Duration
 	BorMinBlockSize    int
 	BorWithMilestones  *bool
-	wg                 sync.WaitGroup
-	peers              []string
-	namedNodes         map[string]Node
+	BorPolygonSync     bool
+	// FaultInjection routes the p2p connections between the nodes through proxies
+	// which can partition the network or delay and drop the data sent by nodes
+	FaultInjection bool
+	wg             sync.WaitGroup
+	peers          []string
+	namedNodes     map[string]Node
+	faults         *faultInjector
 
 	// max number of blocks to look for a transaction in
 	MaxNumberOfEmptyBlockChecks int
@@ -88,6 +93,7 @@ func (nw *Network) Start(ctx context.Context) error {
 		HttpPort:       nw.BaseRPCPort,
 		PrivateApiAddr: nw.BasePrivateApiAddr,
 		Snapshots:      nw.Snapshots,
+		PolygonSync:    nw.BorPolygonSync,
 	}
 
 	if nw.BorWithMilestones != nil {
@@ -98,11 +104,21 @@ func (nw *Network) Start(ctx context.Context) error {
 
 	nw.namedNodes = map[string]Node{}
 
+	if nw.FaultInjection {
+		nw.faults = newFaultInjector(nw.Logger)
+	}
+
 	for i, nodeArgs := range nw.Nodes {
 		{
-			baseNode.StaticPeers = strings.Join(nw.peers, ",")
+			staticPeers, err := nw.staticPeers(i)
+			if err != nil {
+				nw.Stop()
+				return err
+			}
 
-			err := nodeArgs.Configure(baseNode, i)
+			baseNode.StaticPeers = strings.Join(staticPeers, ",")
+
+			err = nodeArgs.Configure(baseNode, i)
 			if err != nil {
 				nw.Stop()
 				return err
@@ -139,6 +155,26 @@ func (nw *Network) Start(ctx context.Context) error {
 	return nil
 }
 
+// staticPeers returns the enode urls the node connects to, which point at the
+// proxies of its links to the other nodes if fault injection is enabled
+func (nw *Network) staticPeers(node int) ([]string, error) {
+	if nw.faults == nil {
+		return nw.peers, nil
+	}
+
+	peers := make([]string, len(nw.peers))
+
+	for target, peer := range nw.peers {
+		var err error
+
+		if peers[target], err = nw.faults.addLink(node, target, peer); err != nil {
+			return nil, err
+		}
+	}
+
+	return peers, nil
+}
+
 var blockProducerFunds = (&big.Int{}).Mul(big.NewInt(1000), big.NewInt(params.Ether))
 
 func (nw *Network) createNode(nodeArgs Node) (Node, error) {
@@ -154,6 +190,7 @@ func (nw *Network) createNode(nodeArgs Node) (Node, error) {
 		nil,
 		nil,
 		nil,
+		nil,
 	}
 
 	if n.IsBlockProducer() {
@@ -203,7 +240,15 @@ func (nw *Network) startNode(n Node) error {
 		return err
 	}
 
+	exited := make(chan struct{})
+
+	node.Lock()
+	node.exited = exited
+	node.Unlock()
+
 	go func() {
+		defer close(exited)
+
 		nw.Logger.Info("Running node", "name", node.GetName(), "args", args)
 
 		// catch any errors and avoid panics if an error occurs
@@ -250,6 +295,10 @@ func (nw *Network) Stop() {
 	nw.Logger.Info("Waiting for nodes to stop")
 	nw.Wait()
 
+	if nw.faults != nil {
+		nw.faults.close()
+	}
+
 	nw.Logger.Info("Stopping services")
 	for _, service := range nw.Services {
 		service.Stop()
@@ -85,6 +85,7 @@ type devnetNode struct {
 	nodeCfg  *nodecfg.Config
 	ethCfg   *ethconfig.Config
 	ethNode  *enode.ErigonNode
+	exited   chan struct{}
 }
 
 func (n *devnetNode) Stop() {
@@ -0,0 +1,205 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package faults
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	ethereum "github.com/erigontech/erigon"
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon/cmd/devnet/devnet"
+	"github.com/erigontech/erigon/rpc"
+)
+
+type forkedBlock struct {
+	Number   uint64
+	Hash     libcommon.Hash
+	TxHashes []libcommon.Hash
+}
+
+type head struct {
+	Node   string
+	Number uint64
+	Hash   libcommon.Hash
+}
+
+// recordForkedBlocks returns the blocks above the fork base the running nodes have as their canonical blocks
+func recordForkedBlocks(ctx context.Context, network *devnet.Network, forkBase uint64) ([]forkedBlock, error) {
+	var blocks []forkedBlock
+
+	for _, node := range network.RunningNodes() {
+		blockNum, err := node.BlockNumber()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get block number of %s: %w", node.GetName(), err)
+		}
+
+		for number := forkBase + 1; number <= blockNum; number++ {
+			block, err := node.GetBlockByNumber(ctx, rpc.BlockNumber(number), true)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get block %d of %s: %w", number, node.GetName(), err)
+			}
+
+			forked := forkedBlock{Number: number, Hash: block.Hash}
+
+			for _, tx := range block.Transactions {
+				forked.TxHashes = append(forked.TxHashes, tx.Hash)
+			}
+
+			blocks = append(blocks, forked)
+		}
+	}
+
+	return blocks, nil
+}
+
+// awaitConvergence waits until all the running nodes have the same head block
+func awaitConvergence(ctx context.Context, network *devnet.Network, timeout time.Duration) (head, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		heads, err := nodeHeads(ctx, network)
+
+		if err == nil {
+			converged := true
+
+			for _, h := range heads[1:] {
+				if h.Number != heads[0].Number || h.Hash != heads[0].Hash {
+					converged = false
+					break
+				}
+			}
+
+			if converged {
+				return heads[0], nil
+			}
+		}
+
+		if time.Now().After(deadline) {
+			if err != nil {
+				return head{}, fmt.Errorf("timed out waiting for the nodes to converge: %w", err)
+			}
+
+			var details []string
+
+			for _, h := range heads {
+				details = append(details, fmt.Sprintf("%s: %d %s", h.Node, h.Number, h.Hash))
+			}
+
+			return head{}, fmt.Errorf("timed out waiting for the nodes to converge, heads: %s", strings.Join(details, ", "))
+		}
+
+		select {
+		case <-ctx.Done():
+			return head{}, ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func nodeHeads(ctx context.Context, network *devnet.Network) ([]head, error) {
+	nodes := network.RunningNodes()
+
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("no running nodes")
+	}
+
+	heads := make([]head, len(nodes))
+
+	for i, node := range nodes {
+		block, err := node.GetBlockByNumber(ctx, rpc.LatestBlockNumber, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get head of %s: %w", node.GetName(), err)
+		}
+
+		heads[i] = head{node.GetName(), block.Number.Uint64(), block.Hash}
+	}
+
+	return heads, nil
+}
+
+// checkOrphanedBlocks checks that no running node has receipts, logs or tx lookups which refer to
+// the forked blocks which are not canonical and returns the number of orphaned blocks
+func checkOrphanedBlocks(ctx context.Context, network *devnet.Network, canonicalHead head, forkedBlocks map[string]forkedBlock) (int, error) {
+	nodes := network.RunningNodes()
+
+	var orphaned int
+
+	for _, forked := range forkedBlocks {
+		if forked.Number <= canonicalHead.Number {
+			canonical, err := nodes[0].GetBlockByNumber(ctx, rpc.BlockNumber(forked.Number), false)
+			if err != nil {
+				return orphaned, fmt.Errorf("failed to get block %d: %w", forked.Number, err)
+			}
+
+			if canonical.Hash == forked.Hash {
+				continue
+			}
+		}
+
+		orphaned++
+
+		for _, node := range nodes {
+			if err := checkNoLeftovers(ctx, node, forked, canonicalHead); err != nil {
+				return orphaned, fmt.Errorf("%s: orphaned block %d %s: %w", node.GetName(), forked.Number, forked.Hash, err)
+			}
+		}
+	}
+
+	return orphaned, nil
+}
+
+func checkNoLeftovers(ctx context.Context, node devnet.Node, orphan forkedBlock, canonicalHead head) error {
+	for _, txHash := range orphan.TxHashes {
+		tx, err := node.GetTransactionByHash(txHash)
+		if err != nil {
+			return fmt.Errorf("failed to get transaction %s: %w", txHash, err)
+		}
+
+		if tx.BlockHash != nil && *tx.BlockHash == orphan.Hash {
+			return fmt.Errorf("tx lookup of %s refers to the orphaned block", txHash)
+		}
+
+		// the receipt request fails if there is no receipt for the transaction
+		if receipt, err := node.GetTransactionReceipt(ctx, txHash); err == nil && receipt.BlockHash == orphan.Hash {
+			return fmt.Errorf("receipt of %s refers to the orphaned block", txHash)
+		}
+	}
+
+	// there are no logs to check above the canonical chain
+	if orphan.Number > canonicalHead.Number {
+		return nil
+	}
+
+	number := new(big.Int).SetUint64(orphan.Number)
+
+	logs, err := node.FilterLogs(ctx, ethereum.FilterQuery{FromBlock: number, ToBlock: number})
+	if err != nil {
+		return fmt.Errorf("failed to get logs: %w", err)
+	}
+
+	for _, log := range logs {
+		if log.BlockHash == orphan.Hash {
+			return fmt.Errorf("log %d of %s refers to the orphaned block", log.Index, log.TxHash)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package faults
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/erigontech/erigon/cmd/devnet/devnet"
+	"github.com/erigontech/erigon/cmd/devnet/scenarios"
+)
+
+func init() {
+	scenarios.MustRegisterStepHandlers(
+		scenarios.StepHandler(PartitionNodes),
+		scenarios.StepHandler(HealPartition),
+		scenarios.StepHandler(DelayPropagation),
+		scenarios.StepHandler(DropPropagation),
+		scenarios.StepHandler(StopNode),
+		scenarios.StepHandler(RestartNode),
+		scenarios.StepHandler(AssertNodesConverged),
+	)
+}
+
+const (
+	// forkBaseParam holds the highest block all the nodes had when the first fault was injected
+	forkBaseParam = "forkBase"
+	// forkedBlocksParam holds the blocks the nodes produced or imported while the faults were injected
+	forkedBlocksParam = "forkedBlocks"
+)
+
+// PartitionNodes cuts the p2p connections between the groups of nodes, given by their names, e.g.
+// [["dev-0"], ["dev-1", "dev-2"]], so that the block producers of each group extend their own fork
+func PartitionNodes(ctx context.Context, groups ...[]string) (context.Context, error) {
+	ctx, err := withForkBase(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	return ctx, devnet.CurrentNetwork(ctx).Partition(groups...)
+}
+
+// HealPartition records the blocks the nodes have since the faults were injected, so that
+// AssertNodesConverged can check the orphaned ones, and removes all the faults of the network
+func HealPartition(ctx context.Context) (context.Context, error) {
+	network := devnet.CurrentNetwork(ctx)
+
+	if forkBase, ok := scenarios.Param[uint64](ctx, forkBaseParam); ok {
+		blocks, err := recordForkedBlocks(ctx, network, forkBase)
+		if err != nil {
+			return ctx, err
+		}
+
+		forkedBlocks, _ := scenarios.Param[map[string]forkedBlock](ctx, forkedBlocksParam)
+
+		if forkedBlocks == nil {
+			forkedBlocks = map[string]forkedBlock{}
+		}
+
+		for _, block := range blocks {
+			forkedBlocks[block.Hash.Hex()] = block
+		}
+
+		ctx = scenarios.WithParam(ctx, forkedBlocksParam, forkedBlocks)
+	}
+
+	return ctx, network.Heal()
+}
+
+// DelayPropagation delays the data, and so the blocks, the node sends to its peers
+func DelayPropagation(ctx context.Context, node string, delay time.Duration) (context.Context, error) {
+	ctx, err := withForkBase(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	return ctx, devnet.CurrentNetwork(ctx).DelayPropagation(node, delay)
+}
+
+// DropPropagation drops the data, and so the blocks, the node sends to its peers until the partition is healed
+func DropPropagation(ctx context.Context, node string) (context.Context, error) {
+	ctx, err := withForkBase(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	return ctx, devnet.CurrentNetwork(ctx).DropPropagation(node, true)
+}
+
+// StopNode stops a node, e.g. while it is syncing, keeping its data so that it can be restarted
+func StopNode(ctx context.Context, node string) error {
+	return devnet.CurrentNetwork(ctx).StopNode(node)
+}
+
+// RestartNode restarts a stopped node which then syncs from its peers
+func RestartNode(ctx context.Context, node string) error {
+	return devnet.CurrentNetwork(ctx).RestartNode(ctx, node)
+}
+
+// AssertNodesConverged waits for at most the timeout until all the running nodes have the same head and
+// then checks that the blocks orphaned by the faults left no receipts, logs or tx lookups behind on any node
+func AssertNodesConverged(ctx context.Context, timeout time.Duration) error {
+	network := devnet.CurrentNetwork(ctx)
+
+	head, err := awaitConvergence(ctx, network, timeout)
+	if err != nil {
+		return err
+	}
+
+	devnet.Logger(ctx).Info("Nodes converged", "chain", network.Chain, "block", head.Number, "hash", head.Hash)
+
+	forkedBlocks, _ := scenarios.Param[map[string]forkedBlock](ctx, forkedBlocksParam)
+
+	orphaned, err := checkOrphanedBlocks(ctx, network, head, forkedBlocks)
+	if err != nil {
+		return err
+	}
+
+	devnet.Logger(ctx).Info("SUCCESS => Orphaned blocks checked", "chain", network.Chain, "forked", len(forkedBlocks), "orphaned", orphaned)
+
+	return nil
+}
+
+func withForkBase(ctx context.Context) (context.Context, error) {
+	if _, ok := scenarios.Param[uint64](ctx, forkBaseParam); ok {
+		return ctx, nil
+	}
+
+	var forkBase uint64
+
+	for i, node := range devnet.CurrentNetwork(ctx).RunningNodes() {
+		blockNum, err := node.BlockNumber()
+		if err != nil {
+			return ctx, fmt.Errorf("failed to get block number of %s: %w", node.GetName(), err)
+		}
+
+		if i == 0 || blockNum < forkBase {
+			forkBase = blockNum
+		}
+	}
+
+	return scenarios.WithParam(ctx, forkBaseParam, forkBase), nil
+}
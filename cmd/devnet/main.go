@@ -37,6 +37,7 @@ import (
 	_ "github.com/erigontech/erigon/cmd/devnet/contracts/steps"
 	"github.com/erigontech/erigon/cmd/devnet/devnet"
 	"github.com/erigontech/erigon/cmd/devnet/devnetutils"
+	_ "github.com/erigontech/erigon/cmd/devnet/faults"
 	"github.com/erigontech/erigon/cmd/devnet/networks"
 	"github.com/erigontech/erigon/cmd/devnet/requests"
 	"github.com/erigontech/erigon/cmd/devnet/scenarios"
@@ -69,6 +70,21 @@ var (
 		Value: "dynamic-tx-node-0",
 	}
 
+	ScenarioFilesFlag = cli.StringSliceFlag{
+		Name:  "scenarios.files",
+		Usage: "YAML or JSON files with scenarios to be run on the devnet chain, all of their scenarios are run unless --scenarios is set",
+	}
+
+	ScenarioParamsFlag = cli.StringSliceFlag{
+		Name:  "scenarios.params",
+		Usage: "Params of the scenario files as name=value, overriding the params of the files",
+	}
+
+	JUnitReportFlag = cli.StringFlag{
+		Name:  "scenarios.junit",
+		Usage: "Path of the JUnit XML report of the scenario results",
+	}
+
 	BaseRpcHostFlag = cli.StringFlag{
 		Name:  "rpc.host",
 		Usage: "The host of the base RPC service",
@@ -102,6 +118,11 @@ var (
 		Usage: "The bor sprint size to run",
 	}
 
+	PolygonSyncFlag = cli.BoolFlag{
+		Name:  "polygon.sync",
+		Usage: "Run the bor nodes with the polygon sync service, which also produces the blocks",
+	}
+
 	MetricsEnabledFlag = cli.BoolFlag{
 		Name:  "metrics",
 		Usage: "Enable metrics collection and reporting",
@@ -146,6 +167,11 @@ var (
 		Value: 1,
 	}
 
+	FaultInjectionFlag = cli.BoolFlag{
+		Name:  "fault-injection",
+		Usage: "Route the p2p connections between the nodes through proxies so that scenarios can partition the network, delay or drop block propagation",
+	}
+
 	GasLimitFlag = cli.Uint64Flag{
 		Name:  "gaslimit",
 		Usage: "Target gas limit for mined blocks",
@@ -176,12 +202,16 @@ func main() {
 		&DataDirFlag,
 		&ChainFlag,
 		&ScenariosFlag,
+		&ScenarioFilesFlag,
+		&ScenarioParamsFlag,
+		&JUnitReportFlag,
 		&BaseRpcHostFlag,
 		&BaseRpcPortFlag,
 		&WithoutHeimdallFlag,
 		&LocalHeimdallFlag,
 		&HeimdallURLFlag,
 		&BorSprintSizeFlag,
+		&PolygonSyncFlag,
 		&MetricsEnabledFlag,
 		&MetricsNodeFlag,
 		&MetricsPortFlag,
@@ -191,6 +221,7 @@ func main() {
 		&WaitFlag,
 		&txCountFlag,
 		&BlockProducersFlag,
+		&FaultInjectionFlag,
 		&logging.LogVerbosityFlag,
 		&logging.LogConsoleVerbosityFlag,
 		&logging.LogDirVerbosityFlag,
@@ -258,11 +289,24 @@ func mainContext(ctx *cli.Context) error {
 		return err
 	}
 
+	scenarioFiles, err := loadScenarioFiles(ctx)
+	if err != nil {
+		return err
+	}
+
 	network, err := initDevnet(ctx, logger)
 	if err != nil {
 		return err
 	}
 
+	for _, nw := range network {
+		nw.FaultInjection = ctx.Bool(FaultInjectionFlag.Name)
+
+		if nw.Chain == networkname.BorDevnet {
+			nw.BorPolygonSync = ctx.Bool(PolygonSyncFlag.Name)
+		}
+	}
+
 	if err = initDevnetMetrics(ctx, network); err != nil {
 		return err
 	}
@@ -278,7 +322,26 @@ func mainContext(ctx *cli.Context) error {
 
 	enabledScenarios := strings.Split(ctx.String(ScenariosFlag.Name), ",")
 
-	if err = allScenarios(ctx, runCtx).Run(runCtx, enabledScenarios...); err != nil {
+	scenariosToRun := allScenarios(ctx, runCtx)
+
+	fileScenarioNames, err := addFileScenarios(ctx, runCtx, scenariosToRun, scenarioFiles)
+	if err != nil {
+		return err
+	}
+
+	if len(fileScenarioNames) > 0 && !ctx.IsSet(ScenariosFlag.Name) {
+		enabledScenarios = fileScenarioNames
+	}
+
+	results, err := scenariosToRun.RunWithResults(runCtx, enabledScenarios...)
+
+	if reportPath := ctx.String(JUnitReportFlag.Name); len(reportPath) > 0 {
+		if reportErr := writeJUnitReport(reportPath, results); reportErr != nil {
+			logger.Error("Failed to write JUnit report", "path", reportPath, "err", reportErr)
+		}
+	}
+
+	if err != nil {
 		return err
 	}
 
@@ -358,6 +421,70 @@ func allScenarios(cliCtx *cli.Context, runCtx devnet.Context) scenarios.Scenario
 	}
 }
 
+func loadScenarioFiles(ctx *cli.Context) ([]*scenarios.ScenarioFile, error) {
+	var files []*scenarios.ScenarioFile
+
+	for _, path := range ctx.StringSlice(ScenarioFilesFlag.Name) {
+		file, err := scenarios.LoadScenarioFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// addFileScenarios adds the scenarios of the files to the scenarios and returns their names in the order of the files
+func addFileScenarios(ctx *cli.Context, runCtx devnet.Context, all scenarios.Scenarios, files []*scenarios.ScenarioFile) ([]string, error) {
+	params := scenarios.Params{}
+
+	for _, param := range ctx.StringSlice(ScenarioParamsFlag.Name) {
+		name, value, ok := strings.Cut(param, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid scenario param, expected name=value: %s", param)
+		}
+
+		params[name] = value
+	}
+
+	var names []string
+
+	for _, file := range files {
+		fileScenarios, err := file.ScenariosFor(runCtx, ctx.String(ChainFlag.Name), params)
+		if err != nil {
+			return nil, err
+		}
+
+		for name, scenario := range fileScenarios {
+			if _, ok := all[name]; ok {
+				return nil, fmt.Errorf("duplicate scenario: %s", name)
+			}
+
+			all[name] = scenario
+		}
+
+		names = append(names, file.Names()...)
+	}
+
+	return names, nil
+}
+
+func writeJUnitReport(path string, results []*scenarios.ScenarioResult) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := scenarios.WriteJUnitReport(f, results); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
 func initDevnet(ctx *cli.Context, logger log.Logger) (devnet.Devnet, error) {
 	dataDir := ctx.String(DataDirFlag.Name)
 	chainName := ctx.String(ChainFlag.Name)
@@ -60,6 +60,7 @@ func NewDevDevnet(
 		DataDir:            dataDir,
 		Chain:              networkname.Dev,
 		Logger:             logger,
+		BasePort:           30303,
 		BasePrivateApiAddr: "localhost:10090",
 		BaseRPCHost:        baseRpcHost,
 		BaseRPCPort:        baseRpcPort,
@@ -0,0 +1,156 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package scenarios
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	typeOfDuration = reflect.TypeOf(time.Duration(0))
+	typeOfBigInt   = reflect.TypeOf((*big.Int)(nil))
+)
+
+// ConvertArg converts a step argument to the type of a step handler parameter. The arguments
+// of scenarios loaded from files are strings, json numbers, bools, slices and maps, which are
+// converted by their json encoding, so any type which can be unmarshalled from json is supported.
+// Durations and big integers can also be given as strings, e.g. "2s" or "1000000000000000000".
+func ConvertArg(arg interface{}, typ reflect.Type) (reflect.Value, error) {
+	switch typ.Kind() {
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return reflect.Value{}, fmt.Errorf("%w: %s", ErrUnsupportedArgumentType, typ)
+	}
+
+	if arg == nil {
+		return reflect.Zero(typ), nil
+	}
+
+	if value := reflect.ValueOf(arg); value.Type().AssignableTo(typ) {
+		return value, nil
+	}
+
+	if s, ok := arg.(string); ok {
+		switch {
+		case typ == typeOfDuration:
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("%w %q to %s: %v", ErrCannotConvert, s, typ, err)
+			}
+			return reflect.ValueOf(d), nil
+		case typ == typeOfBigInt:
+			i, ok := new(big.Int).SetString(s, 0)
+			if !ok {
+				return reflect.Value{}, fmt.Errorf("%w %q to %s", ErrCannotConvert, s, typ)
+			}
+			return reflect.ValueOf(i), nil
+		}
+	}
+
+	data, err := json.Marshal(arg)
+	if err != nil {
+		return reflect.Value{}, fmt.Errorf("%w %v to %s: %v", ErrCannotConvert, arg, typ, err)
+	}
+
+	// strings produced by the substitution of params into numbers or bools
+	if s, ok := arg.(string); ok {
+		switch typ.Kind() {
+		case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+			data = []byte(s)
+		}
+	}
+
+	value := reflect.New(typ)
+	if err := json.Unmarshal(data, value.Interface()); err != nil {
+		return reflect.Value{}, fmt.Errorf("%w %v to %s: %v", ErrCannotConvert, arg, typ, err)
+	}
+
+	return value.Elem(), nil
+}
+
+var paramExpr = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// ResolveArgs replaces the ${name} references in the string arguments with the values of the
+// scenario params. An argument which only consists of a reference keeps the type of the param.
+func ResolveArgs(ctx context.Context, args []interface{}) ([]interface{}, error) {
+	params, _ := ctx.Value(ckParams).(Params)
+	return resolveArgs(params, args)
+}
+
+func resolveArgs(params Params, args []interface{}) ([]interface{}, error) {
+	if len(args) == 0 {
+		return args, nil
+	}
+
+	resolved := make([]interface{}, len(args))
+
+	for i, arg := range args {
+		var err error
+
+		if resolved[i], err = resolveArg(params, arg); err != nil {
+			return nil, err
+		}
+	}
+
+	return resolved, nil
+}
+
+func resolveArg(params Params, arg interface{}) (interface{}, error) {
+	switch arg := arg.(type) {
+	case string:
+		if !strings.Contains(arg, "${") {
+			return arg, nil
+		}
+
+		if m := paramExpr.FindStringSubmatch(arg); m != nil && m[0] == arg {
+			value, ok := params[m[1]]
+			if !ok {
+				return nil, fmt.Errorf("undefined param: %s", m[1])
+			}
+			return value, nil
+		}
+
+		var err error
+
+		res := paramExpr.ReplaceAllStringFunc(arg, func(ref string) string {
+			name := paramExpr.FindStringSubmatch(ref)[1]
+
+			value, ok := params[name]
+			if !ok {
+				err = fmt.Errorf("undefined param: %s", name)
+				return ref
+			}
+
+			return fmt.Sprint(value)
+		})
+
+		return res, err
+
+	case []interface{}:
+		return resolveArgs(params, arg)
+
+	default:
+		return arg, nil
+	}
+}
@@ -24,6 +24,9 @@ import (
 // ErrUndefined is returned in case if step definition was not found
 var ErrUndefined = errors.New("step is undefined")
 
+// ErrUnsupportedNetwork is returned if the scenarios of a file don't support the chain of the devnet
+var ErrUnsupportedNetwork = errors.New("scenarios do not support the network")
+
 type ScenarioError struct {
 	error
 	Result ScenarioResult
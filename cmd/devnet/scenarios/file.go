@@ -0,0 +1,160 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package scenarios
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/erigontech/erigon/cmd/devnet/devnet"
+)
+
+// ChainParam is the name of the param holding the chain name of the devnet the scenarios run on
+const ChainParam = "chain"
+
+// ScenarioFile is a set of scenarios defined in a YAML or JSON file, so that scenarios can be written
+// without recompiling the devnet. The steps refer to the registered step handlers by their names and
+// their string arguments may refer to the scenario params as ${name}.
+type ScenarioFile struct {
+	// Params are the default params of the scenarios
+	Params Params `json:"params,omitempty"`
+	// Networks are the chains, e.g. dev or bor-devnet, the scenarios can run on with the params
+	// which override the defaults on the chain. The scenarios run on any chain if it's empty.
+	Networks  map[string]Params `json:"networks,omitempty"`
+	Scenarios []*FileScenario   `json:"scenarios"`
+}
+
+type FileScenario struct {
+	Scenario
+	// Network selects the current network of the scenario by its chain name, it may refer to params
+	Network string `json:"network,omitempty"`
+	// Node selects the current node of the scenario by its index in the network
+	Node *int `json:"node,omitempty"`
+}
+
+// LoadScenarioFile loads a scenario file, YAML being a superset of JSON both are supported.
+// Numbers are kept as json numbers so that large integers don't lose precision.
+func LoadScenarioFile(path string) (*ScenarioFile, error) {
+	data, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var file ScenarioFile
+
+	if err := yaml.Unmarshal(data, &file, func(d *json.Decoder) *json.Decoder {
+		d.UseNumber()
+		return d
+	}); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if err := file.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return &file, nil
+}
+
+func (f *ScenarioFile) validate() error {
+	names := map[string]struct{}{}
+
+	for i, scenario := range f.Scenarios {
+		if len(scenario.Name) == 0 {
+			return fmt.Errorf("scenario %d has no name", i)
+		}
+
+		if _, ok := names[scenario.Name]; ok {
+			return fmt.Errorf("duplicate scenario: %s", scenario.Name)
+		}
+
+		names[scenario.Name] = struct{}{}
+
+		if len(scenario.Steps) == 0 {
+			return fmt.Errorf("scenario %s has no steps", scenario.Name)
+		}
+
+		for j, step := range scenario.Steps {
+			if step == nil || len(step.Text) == 0 {
+				return fmt.Errorf("step %d of scenario %s has no text", j, scenario.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Names returns the names of the scenarios in the order of the file
+func (f *ScenarioFile) Names() []string {
+	names := make([]string, 0, len(f.Scenarios))
+
+	for _, scenario := range f.Scenarios {
+		names = append(names, scenario.Name)
+	}
+
+	return names
+}
+
+// ScenariosFor returns the scenarios of the file for the devnet of the chain. The params of the scenarios
+// are the defaults of the file overridden by the ones of the chain and then by the given params.
+func (f *ScenarioFile) ScenariosFor(ctx devnet.Context, chain string, params Params) (Scenarios, error) {
+	networkParams, ok := f.Networks[chain]
+
+	if len(f.Networks) > 0 && !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedNetwork, chain)
+	}
+
+	scenarios := Scenarios{}
+
+	for _, s := range f.Scenarios {
+		// each scenario has its own params as the steps may store their results as params
+		scenarioParams := Params{ChainParam: chain}
+
+		for _, p := range []Params{f.Params, networkParams, params} {
+			for name, value := range p {
+				scenarioParams[name] = value
+			}
+		}
+
+		scenarioCtx := ctx
+
+		if len(s.Network) > 0 {
+			network, err := resolveArg(scenarioParams, s.Network)
+
+			if err != nil {
+				return nil, fmt.Errorf("network of scenario %s: %w", s.Name, err)
+			}
+
+			scenarioCtx = scenarioCtx.WithCurrentNetwork(fmt.Sprint(network))
+		}
+
+		if s.Node != nil {
+			scenarioCtx = scenarioCtx.WithCurrentNode(*s.Node)
+		}
+
+		scenario := s.Scenario
+		scenario.Context = scenarioContext{devnet.AsContext(context.WithValue(scenarioCtx, ckParams, scenarioParams))}
+		scenarios[s.Name] = &scenario
+	}
+
+	return scenarios, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package scenarios
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon/cmd/devnet/devnet"
+)
+
+func TestLoadScenarioFile(t *testing.T) {
+	file, err := LoadScenarioFile("examples/transfers.yaml")
+	require.NoError(t, err)
+	require.Equal(t, []string{"fund-account", "deploy-contract"}, file.Names())
+
+	ctx := devnet.AsContext(context.Background())
+
+	_, err = file.ScenariosFor(ctx, "mainnet", nil)
+	require.ErrorIs(t, err, ErrUnsupportedNetwork)
+
+	scenarios, err := file.ScenariosFor(ctx, "bor-devnet", Params{"amount": "2"})
+	require.NoError(t, err)
+	require.Len(t, scenarios, 2)
+
+	fundAccount := scenarios["fund-account"]
+	args, err := ResolveArgs(fundAccount.Context, fundAccount.Steps[0].Args)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"bor-devnet", "funded-account", "2"}, args)
+
+	// the network params override the defaults
+	deployContract := scenarios["deploy-contract"]
+	require.Equal(t, "subscription", deployContract.Steps[1].Result)
+	args, err = ResolveArgs(deployContract.Context, deployContract.Steps[3].Args)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"london", json.Number("0"), "2m"}, args)
+
+	// steps store their results in the params of their scenario only
+	WithParam(deployContract.Context, "subscription", libcommon.Address{1})
+	_, ok := Param[libcommon.Address](deployContract.Context, "subscription")
+	require.True(t, ok)
+	_, ok = Param[libcommon.Address](fundAccount.Context, "subscription")
+	require.False(t, ok)
+
+	_, err = ResolveArgs(fundAccount.Context, []interface{}{"${subscription}"})
+	require.Error(t, err)
+
+	file, err = LoadScenarioFile("examples/reorg.yaml")
+	require.NoError(t, err)
+	require.Equal(t, []string{"partition-reorg", "propagation-reorg", "restart-mid-sync"}, file.Names())
+}
+
+func TestStepRunnerConvertsArgs(t *testing.T) {
+	var called bool
+
+	runner := &stepRunner{Handler: reflect.ValueOf(func(ctx context.Context, address libcommon.Address, amount *big.Int, timeout time.Duration, count uint64, names ...string) error {
+		require.Equal(t, libcommon.HexToAddress("0x67b1d87101671b127f5f8714789C7192f7ad340e"), address)
+		require.Equal(t, "1000000000000000000", amount.String())
+		require.Equal(t, 2*time.Second, timeout)
+		require.Equal(t, uint64(10), count)
+		require.Equal(t, []string{"a", "b"}, names)
+		called = true
+		return nil
+	})}
+
+	ctx := context.Background()
+	_, res := runner.Run(ctx, "test", []interface{}{"0x67b1d87101671b127f5f8714789C7192f7ad340e", "1000000000000000000", "2s", json.Number("10"), "a", "b"}, log.New())
+	require.Nil(t, res)
+	require.True(t, called)
+
+	_, res = runner.Run(ctx, "test", []interface{}{"0x67b1d87101671b127f5f8714789C7192f7ad340e", "1000000000000000000", "2s", "ten"}, log.New())
+	err, ok := res.(error)
+	require.True(t, ok)
+	require.ErrorIs(t, err, ErrCannotConvert)
+}
+
+func TestWriteJUnitReport(t *testing.T) {
+	startedAt := time.Unix(1700000000, 0).UTC()
+	steps := []*Step{{Text: "PingErigonRpc"}, {Text: "AssertBalance"}, {Text: "AwaitBlocks"}}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteJUnitReport(&buf, []*ScenarioResult{{
+		ScenarioName: "fund-account",
+		StartedAt:    startedAt,
+		StepResults: []StepResult{
+			{Status: Passed, FinishedAt: startedAt.Add(time.Second), Step: steps[0]},
+			{Status: Failed, FinishedAt: startedAt.Add(3 * time.Second), Step: steps[1], Err: errors.New("unexpected balance")},
+			{Status: Skipped, FinishedAt: startedAt.Add(3 * time.Second), Step: steps[2]},
+		},
+	}}))
+
+	require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites tests="3" failures="1" errors="0" skipped="1" time="3.000">
+  <testsuite name="fund-account" tests="3" failures="1" errors="0" skipped="1" time="3.000" timestamp="2023-11-14T22:13:20Z">
+    <testcase name="1 PingErigonRpc" classname="fund-account" time="1.000"></testcase>
+    <testcase name="2 AssertBalance" classname="fund-account" time="2.000">
+      <failure message="unexpected balance">unexpected balance</failure>
+    </testcase>
+    <testcase name="3 AwaitBlocks" classname="fund-account" time="0.000">
+      <skipped message="skipped"></skipped>
+    </testcase>
+  </testsuite>
+</testsuites>
+`, buf.String())
+}
@@ -17,12 +17,16 @@
 package scenarios
 
 import (
+	"encoding/xml"
+	"fmt"
+	"io"
 	"time"
 )
 
 type ScenarioResult struct {
-	ScenarioId string
-	StartedAt  time.Time
+	ScenarioId   string
+	ScenarioName string
+	StartedAt    time.Time
 
 	StepResults []StepResult
 }
@@ -68,3 +72,124 @@ func (st StepStatus) String() string {
 		return "unknown"
 	}
 }
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+func junitSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+// WriteJUnitReport writes the results as a JUnit XML report with a test suite per scenario
+// and a test case per step.
+func WriteJUnitReport(w io.Writer, results []*ScenarioResult) error {
+	var report junitTestSuites
+	var total time.Duration
+
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+
+		suite := junitTestSuite{
+			Name:      result.ScenarioName,
+			Timestamp: result.StartedAt.Format(time.RFC3339),
+		}
+
+		if len(suite.Name) == 0 {
+			suite.Name = result.ScenarioId
+		}
+
+		startedAt := result.StartedAt
+
+		for i, stepResult := range result.StepResults {
+			testCase := junitTestCase{
+				Classname: suite.Name,
+				Time:      junitSeconds(stepResult.FinishedAt.Sub(startedAt)),
+			}
+
+			if stepResult.Step != nil {
+				testCase.Name = fmt.Sprintf("%d %s", i+1, stepResult.Step.Text)
+			} else {
+				testCase.Name = fmt.Sprintf("%d", i+1)
+			}
+
+			switch stepResult.Status {
+			case Failed:
+				suite.Failures++
+				testCase.Failure = &junitMessage{Message: fmt.Sprint(stepResult.Err), Text: fmt.Sprintf("%+v", stepResult.Err)}
+			case Undefined:
+				suite.Errors++
+				testCase.Error = &junitMessage{Message: ErrUndefined.Error()}
+			case Skipped, Pending:
+				suite.Skipped++
+				testCase.Skipped = &junitMessage{Message: stepResult.Status.String()}
+			}
+
+			if !stepResult.FinishedAt.IsZero() {
+				startedAt = stepResult.FinishedAt
+			}
+
+			suite.TestCases = append(suite.TestCases, testCase)
+		}
+
+		suite.Tests = len(suite.TestCases)
+		suite.Time = junitSeconds(startedAt.Sub(result.StartedAt))
+
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Errors += suite.Errors
+		report.Skipped += suite.Skipped
+		total += startedAt.Sub(result.StartedAt)
+
+		report.Suites = append(report.Suites, suite)
+	}
+
+	report.Time = junitSeconds(total)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -26,8 +26,14 @@ import (
 type SimulationInitializer func(*SimulationContext)
 
 func Run(ctx context.Context, scenarios ...*Scenario) error {
+	_, err := RunWithResults(ctx, scenarios...)
+	return err
+}
+
+// RunWithResults runs the scenarios and returns their results in the order of the scenarios
+func RunWithResults(ctx context.Context, scenarios ...*Scenario) ([]*ScenarioResult, error) {
 	if len(scenarios) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	return runner{scenarios: scenarios}.runWithOptions(ctx, getDefaultOptions())
@@ -42,7 +48,7 @@ type runner struct {
 	simulationInitializer SimulationInitializer
 }
 
-func (r *runner) concurrent(ctx context.Context, rate int) (err error) {
+func (r *runner) concurrent(ctx context.Context, rate int) (results []*ScenarioResult, err error) {
 	var copyLock sync.Mutex
 
 	queue := make(chan int, rate)
@@ -58,6 +64,8 @@ func (r *runner) concurrent(ctx context.Context, rate int) (err error) {
 		copy(scenarios, r.scenarios)
 	}
 
+	results = make([]*ScenarioResult, len(scenarios))
+
 	simulationContext := SimulationContext{
 		suite: &suite{
 			randomize:      r.randomize,
@@ -71,7 +79,7 @@ func (r *runner) concurrent(ctx context.Context, rate int) (err error) {
 
 		queue <- i // reserve space in queue
 
-		runScenario := func(err *error, Scenario *Scenario) {
+		runScenario := func(err *error, Scenario *Scenario, result **ScenarioResult) {
 			defer func() {
 				<-queue // free a space in queue
 			}()
@@ -88,7 +96,8 @@ func (r *runner) concurrent(ctx context.Context, rate int) (err error) {
 				r.simulationInitializer(&sc)
 			}
 
-			_, serr := suite.runScenario(&scenario)
+			sr, serr := suite.runScenario(&scenario)
+			*result = sr
 			if suite.shouldFail(serr) {
 				copyLock.Lock()
 				*err = serr
@@ -99,9 +108,9 @@ func (r *runner) concurrent(ctx context.Context, rate int) (err error) {
 		if rate == 1 {
 			// Running within the same goroutine for concurrency 1
 			// to preserve original stacks and simplify debugging.
-			runScenario(&err, &scenario)
+			runScenario(&err, &scenario, &results[i])
 		} else {
-			go runScenario(&err, &scenario)
+			go runScenario(&err, &scenario, &results[i])
 		}
 	}
 
@@ -112,10 +121,10 @@ func (r *runner) concurrent(ctx context.Context, rate int) (err error) {
 
 	close(queue)
 
-	return err
+	return results, err
 }
 
-func (runner runner) runWithOptions(ctx context.Context, opt *Options) error {
+func (runner runner) runWithOptions(ctx context.Context, opt *Options) ([]*ScenarioResult, error) {
 	//var output io.Writer = os.Stdout
 	//if nil != opt.Output {
 	//	output = opt.Output
@@ -105,6 +105,8 @@ type Step struct {
 	Args        []interface{} `json:"args,omitempty"`
 	Text        string        `json:"text"`
 	Description string        `json:"description,omitempty"`
+	// Result is the name of the scenario param the first value returned by the step is stored as
+	Result string `json:"result,omitempty"`
 }
 
 type stepRunner struct {
@@ -131,12 +133,38 @@ func (c *stepRunner) Run(ctx context.Context, text string, args []interface{}, l
 		numIn--
 	}
 
-	if len(args) < numIn {
-		return ctx, fmt.Errorf("Expected %d arguments, matched %d from step", typ.NumIn(), len(args))
+	if typ.IsVariadic() {
+		if len(args) < numIn-1 {
+			return ctx, fmt.Errorf("Expected at least %d arguments, matched %d from step", numIn-1, len(args))
+		}
+	} else {
+		if len(args) < numIn {
+			return ctx, fmt.Errorf("Expected %d arguments, matched %d from step", typ.NumIn(), len(args))
+		}
+
+		if len(args) > numIn {
+			return ctx, fmt.Errorf("%w: expected %d, got %d", ErrUnmatchedStepArgumentNumber, numIn, len(args))
+		}
 	}
 
-	for _, arg := range args {
-		values = append(values, reflect.ValueOf(arg))
+	for i, arg := range args {
+		in := len(values)
+
+		var argType reflect.Type
+
+		if typ.IsVariadic() && in >= typ.NumIn()-1 {
+			argType = typ.In(typ.NumIn() - 1).Elem()
+		} else {
+			argType = typ.In(in)
+		}
+
+		value, err := ConvertArg(arg, argType)
+
+		if err != nil {
+			return ctx, fmt.Errorf("argument %d: %w", i, err)
+		}
+
+		values = append(values, value)
 	}
 
 	handler := c.Handler.String()
@@ -175,6 +203,12 @@ func (c *stepRunner) Run(ctx context.Context, text string, args []interface{}, l
 type Scenarios map[string]*Scenario
 
 func (s Scenarios) Run(ctx context.Context, scenarioNames ...string) error {
+	_, err := s.RunWithResults(ctx, scenarioNames...)
+	return err
+}
+
+// RunWithResults runs the named scenarios, or all of them if no names are given, and returns their results
+func (s Scenarios) RunWithResults(ctx context.Context, scenarioNames ...string) ([]*ScenarioResult, error) {
 	var scenarios []*Scenario
 
 	if len(scenarioNames) == 0 {
@@ -191,5 +225,5 @@ func (s Scenarios) Run(ctx context.Context, scenarioNames ...string) error {
 		}
 	}
 
-	return Run(ctx, scenarios...)
+	return RunWithResults(ctx, scenarios...)
 }
@@ -102,6 +102,8 @@ func (s *suite) runStep(ctx context.Context, scenario *Scenario, step *Step, pre
 			}
 		}
 
+		sr.ScenarioId, sr.Step, sr.FinishedAt = scenario.Id, step, TimeNowFunc()
+
 		earlyReturn := prevStepErr != nil || sr.Err == ErrUndefined
 
 		// Run after step handlers.
@@ -156,9 +158,24 @@ func (s *suite) runStep(ctx context.Context, scenario *Scenario, step *Step, pre
 		return ctx, sr
 	}
 
-	ctx, res := match.Run(ctx, step.Text, step.Args, logger)
+	args, err := ResolveArgs(ctx, step.Args)
+
+	if err != nil {
+		sr.Err = err
+		return ctx, sr
+	}
+
+	ctx, res := match.Run(ctx, step.Text, args, logger)
 	ctx, sr.Returns, sr.Err = s.maybeSubSteps(ctx, res, logger)
 
+	if sr.Err == nil && len(step.Result) > 0 {
+		if len(sr.Returns) == 0 {
+			sr.Err = fmt.Errorf("step returned no value to store as %s", step.Result)
+		} else {
+			ctx = WithParam(ctx, step.Result, sr.Returns[0])
+		}
+	}
+
 	return ctx, sr
 }
 
@@ -291,13 +308,13 @@ func (s *suite) runScenario(scenario *Scenario) (sr *ScenarioResult, err error)
 	defer cancel()
 
 	if len(scenario.Steps) == 0 {
-		return &ScenarioResult{ScenarioId: scenario.Id, StartedAt: TimeNowFunc()}, ErrUndefined
+		return &ScenarioResult{ScenarioId: scenario.Id, ScenarioName: scenario.Name, StartedAt: TimeNowFunc()}, ErrUndefined
 	}
 
 	// Before scenario hooks are called in context of first evaluated step
 	// so that error from handler can be added to step.
 
-	sr = &ScenarioResult{ScenarioId: scenario.Id, StartedAt: TimeNowFunc()}
+	sr = &ScenarioResult{ScenarioId: scenario.Id, ScenarioName: scenario.Name, StartedAt: TimeNowFunc()}
 
 	// scenario
 	if s.testingT != nil {
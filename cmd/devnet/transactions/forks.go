@@ -0,0 +1,114 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package transactions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/erigontech/erigon/cmd/devnet/devnet"
+	"github.com/erigontech/erigon/core/types"
+	"github.com/erigontech/erigon/rpc"
+)
+
+// forkHeaderChecks tell whether a header was produced after a fork by the fields the fork added to the header
+var forkHeaderChecks = map[string]func(header *types.Header) bool{
+	"london":   func(header *types.Header) bool { return header.BaseFee != nil },
+	"shanghai": func(header *types.Header) bool { return header.WithdrawalsHash != nil },
+	"cancun": func(header *types.Header) bool {
+		return header.ParentBeaconBlockRoot != nil && header.BlobGasUsed != nil
+	},
+	"prague": func(header *types.Header) bool { return header.RequestsHash != nil },
+}
+
+// AwaitBlockNumber waits until the current node has a block with the number
+func AwaitBlockNumber(ctx context.Context, blockNum uint64, timeout time.Duration) error {
+	return awaitBlockNumber(ctx, devnet.SelectNode(ctx), blockNum, timeout)
+}
+
+func awaitBlockNumber(ctx context.Context, node devnet.Node, blockNum uint64, timeout time.Duration) error {
+	logger := devnet.Logger(ctx)
+
+	deadline := time.Now().Add(timeout)
+
+	for {
+		current, err := node.BlockNumber()
+
+		if err != nil {
+			logger.Error("FAILURE => error getting block number", "error", err)
+		} else if current >= blockNum {
+			logger.Info("Reached block", "blockNum", current)
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for block %d, current block: %d", blockNum, current)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// CheckForkTransition checks that the fork activates at the block, i.e. the block has the header fields
+// the fork adds and its parent does not. It waits for the block for at most the timeout.
+func CheckForkTransition(ctx context.Context, fork string, blockNum uint64, timeout time.Duration) error {
+	isActive, ok := forkHeaderChecks[strings.ToLower(fork)]
+
+	if !ok {
+		return fmt.Errorf("unsupported fork: %s", fork)
+	}
+
+	node := devnet.SelectNode(ctx)
+
+	if err := awaitBlockNumber(ctx, node, blockNum, timeout); err != nil {
+		return err
+	}
+
+	block, err := node.GetBlockByNumber(ctx, rpc.BlockNumber(blockNum), true)
+
+	if err != nil {
+		return fmt.Errorf("failed to get block %d: %w", blockNum, err)
+	}
+
+	if !isActive(block.Header) {
+		return fmt.Errorf("%s is not active at block %d", fork, blockNum)
+	}
+
+	if blockNum == 0 {
+		return nil
+	}
+
+	parent, err := node.GetBlockByNumber(ctx, rpc.BlockNumber(blockNum-1), true)
+
+	if err != nil {
+		return fmt.Errorf("failed to get block %d: %w", blockNum-1, err)
+	}
+
+	if isActive(parent.Header) {
+		return fmt.Errorf("%s is already active before block %d", fork, blockNum)
+	}
+
+	devnet.Logger(ctx).Info("Fork transition checked", "fork", fork, "block", blockNum)
+
+	return nil
+}
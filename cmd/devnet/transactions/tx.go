@@ -45,6 +45,8 @@ func init() {
 		scenarios.StepHandler(SendTxWithDynamicFee),
 		scenarios.StepHandler(AwaitBlocks),
 		scenarios.StepHandler(SendTxLoad),
+		scenarios.StepHandler(AwaitBlockNumber),
+		scenarios.StepHandler(CheckForkTransition),
 	)
 }
 
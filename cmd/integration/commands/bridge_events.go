@@ -0,0 +1,163 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/common/datadir"
+	"github.com/erigontech/erigon-lib/kv"
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon/cmd/hack/tool/fromdb"
+	"github.com/erigontech/erigon/core/types"
+	"github.com/erigontech/erigon/polygon/bor/borcfg"
+	"github.com/erigontech/erigon/polygon/bridge"
+	"github.com/erigontech/erigon/polygon/heimdall"
+	"github.com/erigontech/erigon/turbo/debug"
+	"github.com/erigontech/erigon/turbo/services"
+)
+
+var (
+	bridgeEventsFrom, bridgeEventsTo uint64
+	bridgeEventsRepair               bool
+	bridgeEventsPolygonSync          bool
+)
+
+var cmdReconcileBridgeEvents = &cobra.Command{
+	Use:   "reconcile_bridge_events",
+	Short: "Re-fetch state sync events from Heimdall for a block range and compare them with the bridge store",
+	Example: "go run ./cmd/integration reconcile_bridge_events --datadir=<datadir> --chain=amoy --from=<block> " +
+		"[--to=<block>] [--repair] [--polygon.sync]",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger := debug.SetupCobra(cmd, "integration")
+		ctx, _ := common.RootContext()
+
+		err := reconcileBridgeEvents(ctx, logger)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			logger.Error("Reconciling bridge events", "error", err)
+		}
+		return err
+	},
+}
+
+func init() {
+	withDataDir(cmdReconcileBridgeEvents)
+	withChain(cmdReconcileBridgeEvents)
+	withHeimdall(cmdReconcileBridgeEvents)
+	cmdReconcileBridgeEvents.Flags().Uint64Var(&bridgeEventsFrom, "from", 0, "first block to reconcile")
+	cmdReconcileBridgeEvents.Flags().Uint64Var(&bridgeEventsTo, "to", 0, "last block to reconcile (default: last block processed by the bridge)")
+	cmdReconcileBridgeEvents.Flags().BoolVar(&bridgeEventsRepair, "repair", false, "store the events from Heimdall and unwind the bridge to the first bad block")
+	cmdReconcileBridgeEvents.Flags().BoolVar(&bridgeEventsPolygonSync, "polygon.sync", false, "the bridge store is the separate database of the polygon sync instead of the chain database")
+	rootCmd.AddCommand(cmdReconcileBridgeEvents)
+}
+
+func reconcileBridgeEvents(ctx context.Context, logger log.Logger) error {
+	db, err := openDB(dbCfg(kv.ChainDB, chaindata), true, logger)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	chainConfig := fromdb.ChainConfig(db)
+	borConfig, ok := chainConfig.Bor.(*borcfg.BorConfig)
+	if !ok {
+		return fmt.Errorf("chain %s is not a bor chain", chainConfig.ChainName)
+	}
+
+	_, borSn, _, _, bridgeStore, _, err := allSnapshots(ctx, db, logger)
+	if err != nil {
+		return err
+	}
+	if bridgeEventsPolygonSync {
+		dirs := datadir.New(datadirCli)
+		bridgeStore = bridge.NewSnapshotStore(bridge.NewMdbxStore(dirs.DataDir, logger, true, 0), borSn, chainConfig.Bor)
+		// the store of the chain database is closed with the database
+		defer bridgeStore.Close()
+	}
+	if err := bridgeStore.Prepare(ctx); err != nil {
+		return err
+	}
+
+	if bridgeEventsTo == 0 {
+		lastProcessed, ok, err := bridgeStore.LastProcessedBlockInfo(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("the bridge has not processed any blocks")
+		}
+		bridgeEventsTo = lastProcessed.BlockNum
+	}
+	if bridgeEventsTo < bridgeEventsFrom {
+		return fmt.Errorf("invalid block range [%d, %d]", bridgeEventsFrom, bridgeEventsTo)
+	}
+
+	blockReader, _ := blocksIO(db, logger)
+	heimdallClient := heimdall.NewClient(HeimdallURL, logger)
+	reconciler := bridge.NewReconciler(bridgeStore, heimdallClient, dbHeaderReader{db, blockReader}, borConfig, logger)
+
+	report, err := reconciler.Reconcile(ctx, bridgeEventsFrom, bridgeEventsTo)
+	if err != nil {
+		return err
+	}
+
+	for _, discrepancy := range report.Discrepancies {
+		fmt.Println(discrepancy)
+	}
+
+	firstBadBlock, ok := report.FirstBadBlock()
+	if !ok {
+		logger.Info("No discrepancies found", "from", bridgeEventsFrom, "to", bridgeEventsTo)
+		return nil
+	}
+	logger.Warn("Found discrepancies", "count", len(report.Discrepancies), "firstBadBlock", firstBadBlock)
+
+	if !bridgeEventsRepair {
+		return nil
+	}
+
+	service := bridge.NewService(bridge.ServiceConfig{
+		Store:        bridgeStore,
+		Logger:       logger,
+		BorConfig:    borConfig,
+		EventFetcher: heimdallClient,
+	})
+	if err := reconciler.Repair(ctx, service, report); err != nil {
+		return err
+	}
+
+	logger.Info("Repaired bridge events", "from", firstBadBlock, "to", bridgeEventsTo)
+	return nil
+}
+
+type dbHeaderReader struct {
+	db          kv.RoDB
+	blockReader services.FullBlockReader
+}
+
+func (r dbHeaderReader) HeaderByNumber(ctx context.Context, blockNum uint64) (header *types.Header, err error) {
+	err = r.db.View(ctx, func(tx kv.Tx) error {
+		header, err = r.blockReader.HeaderByNumber(ctx, tx, blockNum)
+		return err
+	})
+	return header, err
+}
@@ -1509,6 +1509,7 @@ func newSync(ctx context.Context, db kv.TemporalRwDB, miningConfig *params.Minin
 
 	statusDataProvider := sentry.NewStatusDataProvider(
 		db,
+		blockReader,
 		chainConfig,
 		genesisBlock,
 		chainConfig.ChainID.Uint64(),
@@ -1649,7 +1650,7 @@ func initConsensusEngine(ctx context.Context, cc *chain2.Config, dir string, db
 		consensusConfig = cc.Bor
 		config.HeimdallURL = HeimdallURL
 		if !config.WithoutHeimdall {
-			heimdallClient = heimdall.NewHttpClient(config.HeimdallURL, logger)
+			heimdallClient = heimdall.NewClient(config.HeimdallURL, logger)
 		}
 	} else {
 		consensusConfig = &config.Ethash
@@ -105,6 +105,8 @@ var (
 
 type HeimdallReader interface {
 	Producers(ctx context.Context, blockNum uint64) (*valset.ValidatorSet, error)
+	CheckpointByBlock(ctx context.Context, blockNum uint64) (*heimdall.Checkpoint, bool, error)
+	MilestoneByBlock(ctx context.Context, blockNum uint64) (*heimdall.Milestone, bool, error)
 	Close()
 }
 
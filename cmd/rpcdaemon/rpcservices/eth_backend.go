@@ -458,6 +458,7 @@ func (back *RemoteBackend) Peers(ctx context.Context) ([]*p2p.PeerInfo, error) {
 				Static:        rpcPeer.ConnIsStatic,
 			},
 			Protocols: nil,
+			Score:     rpcPeer.Score,
 		}
 
 		peers = append(peers, &peer)
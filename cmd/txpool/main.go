@@ -74,6 +74,13 @@ var (
 	mdbxWriteMap bool
 
 	commitEvery time.Duration
+
+	localJournalLimit      int
+	rebroadcastLocalsEvery time.Duration
+
+	orderingPolicy string
+	allowedSenders []string
+	deniedSenders  []string
 )
 
 func init() {
@@ -99,9 +106,14 @@ func init() {
 	rootCmd.PersistentFlags().Uint64Var(&priceBump, "txpool.pricebump", txpoolcfg.DefaultConfig.PriceBump, "Price bump percentage to replace an already existing transaction")
 	rootCmd.PersistentFlags().Uint64Var(&blobPriceBump, "txpool.blobpricebump", txpoolcfg.DefaultConfig.BlobPriceBump, "Price bump percentage to replace an existing blob (type-3) transaction")
 	rootCmd.PersistentFlags().DurationVar(&commitEvery, utils.TxPoolCommitEveryFlag.Name, utils.TxPoolCommitEveryFlag.Value, utils.TxPoolCommitEveryFlag.Usage)
+	rootCmd.PersistentFlags().IntVar(&localJournalLimit, utils.TxPoolLocalJournalLimitFlag.Name, utils.TxPoolLocalJournalLimitFlag.Value, utils.TxPoolLocalJournalLimitFlag.Usage)
+	rootCmd.PersistentFlags().DurationVar(&rebroadcastLocalsEvery, utils.TxPoolRebroadcastLocalsEveryFlag.Name, utils.TxPoolRebroadcastLocalsEveryFlag.Value, utils.TxPoolRebroadcastLocalsEveryFlag.Usage)
 	rootCmd.PersistentFlags().BoolVar(&noTxGossip, utils.TxPoolGossipDisableFlag.Name, utils.TxPoolGossipDisableFlag.Value, utils.TxPoolGossipDisableFlag.Usage)
 	rootCmd.PersistentFlags().BoolVar(&mdbxWriteMap, utils.DbWriteMapFlag.Name, utils.DbWriteMapFlag.Value, utils.DbWriteMapFlag.Usage)
 	rootCmd.Flags().StringSliceVar(&traceSenders, utils.TxPoolTraceSendersFlag.Name, []string{}, utils.TxPoolTraceSendersFlag.Usage)
+	rootCmd.PersistentFlags().StringVar(&orderingPolicy, utils.TxPoolOrderingFlag.Name, utils.TxPoolOrderingFlag.Value, utils.TxPoolOrderingFlag.Usage)
+	rootCmd.Flags().StringSliceVar(&allowedSenders, utils.TxPoolAllowedSendersFlag.Name, []string{}, utils.TxPoolAllowedSendersFlag.Usage)
+	rootCmd.Flags().StringSliceVar(&deniedSenders, utils.TxPoolDeniedSendersFlag.Name, []string{}, utils.TxPoolDeniedSendersFlag.Usage)
 }
 
 var rootCmd = &cobra.Command{
@@ -169,6 +181,8 @@ func doTxpool(ctx context.Context, logger log.Logger) error {
 	cfg.PriceBump = priceBump
 	cfg.BlobPriceBump = blobPriceBump
 	cfg.NoGossip = noTxGossip
+	cfg.LocalJournalLimit = localJournalLimit
+	cfg.RebroadcastLocalsEvery = rebroadcastLocalsEvery
 	cfg.MdbxWriteMap = mdbxWriteMap
 
 	cacheConfig := kvcache.DefaultCoherentConfig
@@ -179,6 +193,13 @@ func doTxpool(ctx context.Context, logger log.Logger) error {
 		sender := libcommon.HexToAddress(senderHex)
 		cfg.TracedSenders[i] = string(sender[:])
 	}
+	cfg.OrderingPolicy = orderingPolicy
+	for _, senderHex := range allowedSenders {
+		cfg.AllowedSenders = append(cfg.AllowedSenders, libcommon.HexToAddress(senderHex))
+	}
+	for _, senderHex := range deniedSenders {
+		cfg.DeniedSenders = append(cfg.DeniedSenders, libcommon.HexToAddress(senderHex))
+	}
 
 	notifyMiner := func() {}
 	txPool, txpoolGrpcServer, err := txpool.Assemble(
@@ -209,6 +209,31 @@ var (
 		Usage: "How often transactions should be committed to the storage",
 		Value: txpoolcfg.DefaultConfig.CommitEvery,
 	}
+	TxPoolLocalJournalLimitFlag = cli.IntFlag{
+		Name:  "txpool.journal.limit",
+		Usage: "Maximum number of local transactions kept in the pool regardless of sub-pool limits until they are mined or replaced (0 disables the journal)",
+		Value: txpoolcfg.DefaultConfig.LocalJournalLimit,
+	}
+	TxPoolRebroadcastLocalsEveryFlag = cli.DurationFlag{
+		Name:  "txpool.rebroadcast.every",
+		Usage: "How often journaled local transactions are re-broadcast to peers until they are mined or replaced (0 disables re-broadcast)",
+		Value: txpoolcfg.DefaultConfig.RebroadcastLocalsEvery,
+	}
+	TxPoolOrderingFlag = cli.StringFlag{
+		Name:  "txpool.ordering",
+		Usage: "Order in which pending transactions are offered for block building: tip (highest effective tip first), fifo (in order of arrival, reset on restart), sender-fair (round-robin between senders)",
+		Value: txpoolcfg.DefaultConfig.OrderingPolicy,
+	}
+	TxPoolAllowedSendersFlag = cli.StringFlag{
+		Name:  "txpool.build.allowedsenders",
+		Usage: "Comma separated list of addresses, only whose transactions are offered for block building",
+		Value: "",
+	}
+	TxPoolDeniedSendersFlag = cli.StringFlag{
+		Name:  "txpool.build.deniedsenders",
+		Usage: "Comma separated list of addresses, whose transactions are never offered for block building",
+		Value: "",
+	}
 	// Miner settings
 	MiningEnabledFlag = cli.BoolFlag{
 		Name:  "mine",
@@ -523,7 +548,7 @@ var (
 	}
 	P2pProtocolVersionFlag = cli.UintSliceFlag{
 		Name:  "p2p.protocol",
-		Usage: "Version of eth p2p protocol",
+		Usage: "Version of eth p2p protocol, eth/69 is opt-in (e.g. 68,67,69)",
 		Value: cli.NewUintSlice(nodecfg.DefaultConfig.P2P.ProtocolVersion...),
 	}
 	P2pProtocolAllowedPorts = cli.UintSliceFlag{
@@ -531,6 +556,10 @@ var (
 		Usage: "Allowed ports to pick for different eth p2p protocol versions as follows <porta>,<portb>,..,<porti>",
 		Value: cli.NewUintSlice(uint(ListenPortFlag.Value), 30304, 30305, 30306, 30307),
 	}
+	P2pSnapServerFlag = cli.BoolFlag{
+		Name:  "p2p.snap-server",
+		Usage: "Serve the snap/1 protocol to peers from the latest state (served by walking the commitment trie, with a per-request budget of visited branches)",
+	}
 	SentryAddrFlag = cli.StringFlag{
 		Name:  "sentry.api.addr",
 		Usage: "Comma separated sentry addresses '<host>:<port>,<host>:<port>'",
@@ -588,6 +617,11 @@ var (
 		Name:  "v5disc",
 		Usage: "Enables the experimental RLPx V5 (Topic Discovery) mechanism",
 	}
+	DiscoveryV5TopicsFlag = cli.StringFlag{
+		Name:  "v5disc.topics",
+		Usage: "Comma separated topics to advertise and search with the V5 discovery (experimental), lets nodes of a private network find each other over public bootnodes. Also makes the node answer the topic requests of other nodes",
+		Value: "",
+	}
 	NetrestrictFlag = cli.StringFlag{
 		Name:  "netrestrict",
 		Usage: "Restricts network communication to the given IP networks (CIDR masks)",
@@ -772,6 +806,12 @@ var (
 		Value: "http://localhost:1317",
 	}
 
+	HeimdallServeAddrFlag = cli.StringFlag{
+		Name:  "bor.heimdall.serve",
+		Usage: "Serve a Heimdall compatible API from the local Heimdall data on this address, so other nodes can use it as their --bor.heimdall (e.g. 127.0.0.1:1317). Requires --polygon.sync",
+		Value: "",
+	}
+
 	// WithoutHeimdallFlag no heimdall (for testing purpose)
 	WithoutHeimdallFlag = cli.BoolFlag{
 		Name:  "bor.withoutheimdall",
@@ -875,6 +915,10 @@ var (
 		Usage: "Enable caplin validator monitoring metrics",
 		Value: false,
 	}
+	CaplinValidatorMonitorIdsFlag = cli.StringSliceFlag{
+		Name:  "caplin.validator-monitor-ids",
+		Usage: "Comma separated list of validator indices or 0x-prefixed public keys tracked by the validator monitor, implies --caplin.validator-monitor",
+	}
 	CaplinMaxPeerCount = cli.Uint64Flag{
 		Name:  "caplin.max-peer-count",
 		Usage: "Max number of peers to connect",
@@ -1269,6 +1313,8 @@ func NewP2PConfig(
 		enodeDBPath = filepath.Join(dirs.Nodes, "eth67")
 	case direct.ETH68:
 		enodeDBPath = filepath.Join(dirs.Nodes, "eth68")
+	case direct.ETH69:
+		enodeDBPath = filepath.Join(dirs.Nodes, "eth69")
 	default:
 		return nil, fmt.Errorf("unknown protocol: %v", protocol)
 	}
@@ -1441,6 +1487,9 @@ func SetP2PConfig(ctx *cli.Context, cfg *p2p.Config, nodeName, datadir string, l
 	if ctx.IsSet(DiscoveryV5Flag.Name) {
 		cfg.DiscoveryV5 = ctx.Bool(DiscoveryV5Flag.Name)
 	}
+	if ctx.IsSet(DiscoveryV5TopicsFlag.Name) {
+		cfg.DiscoveryV5Topics = libcommon.CliString2Array(ctx.String(DiscoveryV5TopicsFlag.Name))
+	}
 
 	if ctx.IsSet(MetricsEnabledFlag.Name) {
 		cfg.MetricsEnabled = ctx.Bool(MetricsEnabledFlag.Name)
@@ -1602,6 +1651,31 @@ func setTxPool(ctx *cli.Context, dbDir string, fullCfg *ethconfig.Config) {
 	if ctx.IsSet(TxPoolGossipDisableFlag.Name) {
 		cfg.NoGossip = ctx.Bool(TxPoolGossipDisableFlag.Name)
 	}
+	if ctx.IsSet(TxPoolLocalJournalLimitFlag.Name) {
+		cfg.LocalJournalLimit = ctx.Int(TxPoolLocalJournalLimitFlag.Name)
+	}
+	if ctx.IsSet(TxPoolRebroadcastLocalsEveryFlag.Name) {
+		cfg.RebroadcastLocalsEvery = ctx.Duration(TxPoolRebroadcastLocalsEveryFlag.Name)
+	}
+	if ctx.IsSet(TxPoolOrderingFlag.Name) {
+		cfg.OrderingPolicy = ctx.String(TxPoolOrderingFlag.Name)
+	}
+	if ctx.IsSet(TxPoolAllowedSendersFlag.Name) {
+		for _, senderHex := range libcommon.CliString2Array(ctx.String(TxPoolAllowedSendersFlag.Name)) {
+			if !libcommon.IsHexAddress(senderHex) {
+				Fatalf("Invalid address in --%s: %s", TxPoolAllowedSendersFlag.Name, senderHex)
+			}
+			cfg.AllowedSenders = append(cfg.AllowedSenders, libcommon.HexToAddress(senderHex))
+		}
+	}
+	if ctx.IsSet(TxPoolDeniedSendersFlag.Name) {
+		for _, senderHex := range libcommon.CliString2Array(ctx.String(TxPoolDeniedSendersFlag.Name)) {
+			if !libcommon.IsHexAddress(senderHex) {
+				Fatalf("Invalid address in --%s: %s", TxPoolDeniedSendersFlag.Name, senderHex)
+			}
+			cfg.DeniedSenders = append(cfg.DeniedSenders, libcommon.HexToAddress(senderHex))
+		}
+	}
 	cfg.LogEvery = 3 * time.Minute
 	cfg.CommitEvery = libcommon.RandomizeDuration(ctx.Duration(TxPoolCommitEveryFlag.Name))
 	cfg.DBDir = dbDir
@@ -1710,6 +1784,7 @@ func setClique(ctx *cli.Context, cfg *params.ConsensusSnapshotConfig, datadir st
 
 func setBorConfig(ctx *cli.Context, cfg *ethconfig.Config, nodeConfig *nodecfg.Config, logger log.Logger) {
 	cfg.HeimdallURL = ctx.String(HeimdallURLFlag.Name)
+	cfg.HeimdallServeAddr = ctx.String(HeimdallServeAddrFlag.Name)
 	cfg.WithoutHeimdall = ctx.Bool(WithoutHeimdallFlag.Name)
 	cfg.WithHeimdallMilestones = ctx.Bool(WithHeimdallMilestones.Name)
 	cfg.WithHeimdallWaypointRecording = ctx.Bool(WithHeimdallWaypoints.Name)
@@ -1720,6 +1795,9 @@ func setBorConfig(ctx *cli.Context, cfg *ethconfig.Config, nodeConfig *nodecfg.C
 		cfg.WithHeimdallMilestones = false
 		cfg.WithHeimdallWaypointRecording = true
 	}
+	if cfg.HeimdallServeAddr != "" && !cfg.PolygonSync {
+		Fatalf("--%s requires --%s", HeimdallServeAddrFlag.Name, PolygonSyncFlag.Name)
+	}
 
 	heimdall.RecordWayPoints(cfg.WithHeimdallWaypointRecording || cfg.PolygonSync || cfg.PolygonSyncStage)
 
@@ -1814,7 +1892,9 @@ func setCaplin(ctx *cli.Context, cfg *ethconfig.Config) {
 	cfg.CaplinConfig.DisabledCheckpointSync = ctx.Bool(CaplinDisableCheckpointSyncFlag.Name)
 	cfg.CaplinConfig.ArchiveStates = ctx.Bool(CaplinArchiveStatesFlag.Name)
 	cfg.CaplinConfig.MevRelayUrl = ctx.String(CaplinMevRelayUrl.Name)
-	cfg.CaplinConfig.EnableValidatorMonitor = ctx.Bool(CaplinValidatorMonitorFlag.Name)
+	cfg.CaplinConfig.ValidatorMonitorIds = ctx.StringSlice(CaplinValidatorMonitorIdsFlag.Name)
+	// Tracking validators is pointless without the monitor, so giving ids enables it.
+	cfg.CaplinConfig.EnableValidatorMonitor = ctx.Bool(CaplinValidatorMonitorFlag.Name) || len(cfg.CaplinConfig.ValidatorMonitorIds) > 0
 	if checkpointUrls := ctx.StringSlice(CaplinCheckpointSyncUrlFlag.Name); len(checkpointUrls) > 0 {
 		clparams.ConfigurableCheckpointsURLs = checkpointUrls
 	}
@@ -1903,6 +1983,8 @@ func SetEthConfig(ctx *cli.Context, nodeConfig *nodecfg.Config, cfg *ethconfig.C
 	cfg.CaplinConfig.BootstrapNodes = ctx.StringSlice(SentinelBootnodes.Name)
 	cfg.CaplinConfig.StaticPeers = ctx.StringSlice(SentinelStaticPeers.Name)
 
+	cfg.SnapServer = ctx.Bool(P2pSnapServerFlag.Name)
+
 	chain := ctx.String(ChainFlag.Name) // mainnet by default
 	if ctx.IsSet(NetworkIdFlag.Name) {
 		cfg.NetworkID = ctx.Uint64(NetworkIdFlag.Name)
@@ -0,0 +1,277 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package aura
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/kv"
+	"github.com/erigontech/erigon-lib/rlp"
+	"github.com/erigontech/erigon/consensus"
+	"github.com/erigontech/erigon/core/rawdb"
+	"github.com/erigontech/erigon/core/types"
+	"github.com/erigontech/erigon/rpc"
+	"github.com/erigontech/erigon/turbo/services"
+)
+
+var (
+	errAuRaNotAvailable = errors.New("aura engine is not available")
+	errNoEpochFound     = errors.New("no epoch transition found")
+	errNoStateAvailable = errors.New("state is not available")
+)
+
+// API is a user facing RPC API to inspect the validator sets, their pending changes
+// and the misbehaviour reports of the AuRa engine.
+type API struct {
+	db          kv.RoDB
+	engine      consensus.EngineReader
+	blockReader services.FullBlockReader
+}
+
+type PendingValidatorSetChange struct {
+	BlockNumber uint64              `json:"blockNumber"`
+	BlockHash   libcommon.Hash      `json:"blockHash"`
+	Validators  []libcommon.Address `json:"validators"`
+	// Signers of the blocks since the signal, the change is finalized once there are
+	// more than half of the current validators.
+	Signers         []libcommon.Address `json:"signers"`
+	RequiredSigners int                 `json:"requiredSigners"`
+}
+
+// GetValidators retrieves the validator set at the specified block.
+func (api *API) GetValidators(ctx context.Context, number *rpc.BlockNumber) ([]libcommon.Address, error) {
+	c := api.aura()
+	if c == nil {
+		return nil, errAuRaNotAvailable
+	}
+	tx, err := api.db.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	header, err := api.header(ctx, tx, number)
+	if err != nil {
+		return nil, err
+	}
+	return c.epochValidators(header.Number.Uint64())
+}
+
+// GetPendingValidatorSetChanges retrieves the validator set changes signalled on the canonical
+// chain which await finality before they take effect.
+func (api *API) GetPendingValidatorSetChanges(ctx context.Context) ([]PendingValidatorSetChange, error) {
+	c := api.aura()
+	if c == nil {
+		return nil, errAuRaNotAvailable
+	}
+	tx, err := api.db.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	head, err := api.header(ctx, tx, nil)
+	if err != nil {
+		return nil, err
+	}
+	headNum := head.Number.Uint64()
+
+	// changes signalled up to the one of the last transition are not pending anymore
+	var fromNum uint64
+	_, _, lastTransition, err := c.e.FindBeforeOrEqualNumber(headNum)
+	if err != nil {
+		return nil, err
+	}
+	if lastTransition != nil {
+		var proof EpochTransitionProof
+		if err := rlp.DecodeBytes(lastTransition, &proof); err != nil {
+			return nil, err
+		}
+		fromNum = proof.SignalNumber + 1
+	}
+
+	var changes []PendingValidatorSetChange
+	if err := c.e.ForEachPendingEpoch(fromNum, func(number uint64, hash libcommon.Hash, proof []byte) error {
+		if number > headNum {
+			return nil
+		}
+		list, _, err := c.cfg.Validators.epochSet(false, number, proof, noStateCall)
+		if err != nil {
+			return fmt.Errorf("pending epoch %d: %w", number, err)
+		}
+		changes = append(changes, PendingValidatorSetChange{BlockNumber: number, BlockHash: hash, Validators: list.validators})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	pending := changes[:0]
+	for _, change := range changes {
+		canonicalHash, ok, err := api.blockReader.CanonicalHash(ctx, tx, change.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+		if !ok || canonicalHash != change.BlockHash {
+			continue
+		}
+
+		validators, err := c.epochValidators(change.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+		change.RequiredSigners = len(validators)/2 + 1
+
+		signers := map[libcommon.Address]struct{}{}
+		for n := change.BlockNumber; n <= headNum && len(signers) < change.RequiredSigners; n++ {
+			h, err := api.blockReader.HeaderByNumber(ctx, tx, n)
+			if err != nil {
+				return nil, err
+			}
+			if h == nil {
+				return nil, fmt.Errorf("missing block %d", n)
+			}
+			if _, ok := signers[h.Coinbase]; !ok {
+				signers[h.Coinbase] = struct{}{}
+				change.Signers = append(change.Signers, h.Coinbase)
+			}
+		}
+		pending = append(pending, change)
+	}
+	return pending, nil
+}
+
+// GetReports retrieves the misbehaviour reports of the canonical blocks in the specified range.
+func (api *API) GetReports(ctx context.Context, fromNumber, toNumber rpc.BlockNumber) ([]Report, error) {
+	c := api.aura()
+	if c == nil {
+		return nil, errAuRaNotAvailable
+	}
+	tx, err := api.db.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	from, err := api.header(ctx, tx, &fromNumber)
+	if err != nil {
+		return nil, err
+	}
+	to, err := api.header(ctx, tx, &toNumber)
+	if err != nil {
+		return nil, err
+	}
+	reports, err := c.e.Reports(from.Number.Uint64(), to.Number.Uint64())
+	if err != nil {
+		return nil, err
+	}
+	// reports of unwound blocks are deleted only once a block of the same height is executed
+	canonical := reports[:0]
+	for _, report := range reports {
+		hash, ok, err := api.blockReader.CanonicalHash(ctx, tx, report.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+		if ok && hash == report.BlockHash {
+			canonical = append(canonical, report)
+		}
+	}
+	return canonical, nil
+}
+
+func (api *API) header(ctx context.Context, tx kv.Tx, number *rpc.BlockNumber) (*types.Header, error) {
+	var header *types.Header
+	var err error
+	if number == nil || *number < 0 {
+		header, err = api.blockReader.HeaderByHash(ctx, tx, rawdb.ReadHeadHeaderHash(tx))
+	} else {
+		header, err = api.blockReader.HeaderByNumber(ctx, tx, uint64(number.Int64()))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, errors.New("unknown block")
+	}
+	return header, nil
+}
+
+// epochValidators returns the validator set of the epoch of the block.
+func (c *AuRa) epochValidators(number uint64) ([]libcommon.Address, error) {
+	// a transition applies to the children of its block
+	transitionNum, transitionHash, transitionProof, err := c.e.FindBeforeOrEqualNumber(max(number, 1) - 1)
+	if err != nil {
+		return nil, err
+	}
+	if transitionProof == nil {
+		return nil, errNoEpochFound
+	}
+
+	validators, err := c.e.GetValidatorSet(transitionHash, transitionNum)
+	if err != nil {
+		return nil, err
+	}
+	if len(validators) > 0 {
+		return validators, nil
+	}
+
+	// not cached by the engine yet: the proofs of the transitions contain the sets, so no state
+	// is needed unlike for the first set of a contract, which is only proven for the genesis
+	var proof EpochTransitionProof
+	if err := rlp.DecodeBytes(transitionProof, &proof); err != nil {
+		return nil, err
+	}
+	list, _, err := c.cfg.Validators.epochSet(proof.SignalNumber == 0, proof.SignalNumber, proof.SetProof, noStateCall)
+	if err != nil {
+		return nil, err
+	}
+	return list.validators, nil
+}
+
+func noStateCall(libcommon.Address, []byte) ([]byte, error) {
+	return nil, errNoStateAvailable
+}
+
+// aura unwraps the engine of the API, the remote engine of the rpcdaemon is set once it connects
+// to the node, so it's unwrapped on every call.
+func (api *API) aura() *AuRa {
+	engine := api.engine
+	for engine != nil {
+		switch e := engine.(type) {
+		case *AuRa:
+			return e
+		case interface{ InnerEngine() consensus.Engine }:
+			engine = e.InnerEngine()
+		case interface{ Engine() consensus.EngineReader }:
+			engine = e.Engine()
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+func NewAuRaAPI(db kv.RoDB, engine consensus.EngineReader, blockReader services.FullBlockReader) rpc.API {
+	return rpc.API{
+		Namespace: "aura",
+		Version:   "1.0",
+		Service:   &API{db: db, engine: engine, blockReader: blockReader},
+		Public:    false,
+	}
+}
@@ -189,6 +189,10 @@ func (e *EpochManager) zoomToAfter(chain consensus.ChainHeaderReader, er *NonTra
 		}
 		epochSet := list.validators
 		log.Trace("[aura] Updating finality checker with new validator set extracted from epoch", "num", lastTransition.BlockNumber)
+		// cache the set, which may come from contract calls, for the RPCs
+		if err := er.PutValidatorSet(lastTransition.BlockHash, lastTransition.BlockNumber, epochSet); err != nil {
+			log.Warn("[aura] could not store validator set", "num", lastTransition.BlockNumber, "err", err)
+		}
 		e.finalityChecker = NewRollingFinality(epochSet)
 		if proof.SignalNumber >= DEBUG_LOG_FROM {
 			fmt.Printf("new rolling finality: %d\n", proof.SignalNumber)
@@ -234,7 +238,8 @@ type AuRa struct {
 
 	step PermissionedStep
 	// History of step hashes recently received from peers.
-	receivedStepHashes ReceivedStepHashes
+	receivedStepHashes     ReceivedStepHashes
+	receivedStepHashesLock sync.RWMutex
 
 	cfg           AuthorityRoundParams
 	EmptyStepsSet *EmptyStepSet
@@ -384,25 +389,27 @@ func (c *AuRa) VerifyHeader(chain consensus.ChainHeaderReader, header *types.Hea
 	return ethash.VerifyHeaderBasics(chain, header, parent, true /*checkTimestamp*/, c.HasGasLimitContract() /*skipGasLimit*/)
 }
 
-// nolint
+// hasReceivedStepHashes returns whether another block of the author was received for the step.
 func (c *AuRa) hasReceivedStepHashes(step uint64, author libcommon.Address, newHash libcommon.Hash) bool {
-	/*
-		self
-			       .received_step_hashes
-			       .read()
-			       .get(&received_step_key)
-			       .map_or(false, |h| *h != new_hash)
-	*/
-	return false
+	c.receivedStepHashesLock.RLock()
+	defer c.receivedStepHashesLock.RUnlock()
+
+	h, ok := c.receivedStepHashes.get(step, author)
+	return ok && h != newHash
 }
 
-// nolint
 func (c *AuRa) insertReceivedStepHashes(step uint64, author libcommon.Address, newHash libcommon.Hash) {
-	/*
-	   	    self.received_step_hashes
-	                      .write()
-	                      .insert(received_step_key, new_hash);
-	*/
+	c.receivedStepHashesLock.Lock()
+	defer c.receivedStepHashesLock.Unlock()
+
+	c.receivedStepHashes.insert(step, author, newHash)
+}
+
+func (c *AuRa) dropAncientReceivedStepHashes(step uint64) {
+	c.receivedStepHashesLock.Lock()
+	defer c.receivedStepHashesLock.Unlock()
+
+	c.receivedStepHashes.dropAncient(step)
 }
 
 // nolint
@@ -742,6 +749,7 @@ func (c *AuRa) Finalize(config *chain.Config, header *types.Header, state *state
 	// check_and_lock_block -> check_epoch_end_signal END
 
 	finalized := buildFinality(c.EpochManager, chain, c.e, c.cfg.Validators, header, syscall)
+	c.reportMisbehaviour(chain, header, c.EpochManager.finalityChecker.signers.validators)
 	c.EpochManager.finalityChecker.print(header.Number.Uint64())
 	epochEndProof, err := isEpochEnd(chain, c.e, finalized, header)
 	if err != nil {
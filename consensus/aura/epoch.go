@@ -18,8 +18,11 @@ package aura
 
 import (
 	"context"
+	"encoding/binary"
 
 	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/common/hexutil"
+	"github.com/erigontech/erigon-lib/common/length"
 	"github.com/erigontech/erigon-lib/kv"
 
 	"github.com/erigontech/erigon/core/rawdb"
@@ -68,3 +71,113 @@ func (cr *NonTransactionalEpochReader) FindBeforeOrEqualNumber(number uint64) (b
 		return err
 	})
 }
+
+// GetValidatorSet returns the cached validator set of the epoch which starts with the transition
+// at the given block, nil if it is not cached.
+func (cr *NonTransactionalEpochReader) GetValidatorSet(hash libcommon.Hash, number uint64) (validators []libcommon.Address, err error) {
+	return validators, cr.db.View(context.Background(), func(tx kv.Tx) error {
+		v, err := tx.GetOne(kv.AuRaValidatorSets, epochKey(number, hash))
+		if err != nil {
+			return err
+		}
+		for i := 0; i+length.Addr <= len(v); i += length.Addr {
+			validators = append(validators, libcommon.BytesToAddress(v[i:i+length.Addr]))
+		}
+		return nil
+	})
+}
+
+// PutValidatorSet caches the validator set of the epoch which starts with the transition at the given block.
+func (cr *NonTransactionalEpochReader) PutValidatorSet(hash libcommon.Hash, number uint64, validators []libcommon.Address) error {
+	if cr.readonly {
+		return nil
+	}
+	v := make([]byte, 0, len(validators)*length.Addr)
+	for _, validator := range validators {
+		v = append(v, validator[:]...)
+	}
+	return cr.db.UpdateNosync(context.Background(), func(tx kv.RwTx) error {
+		return tx.Put(kv.AuRaValidatorSets, epochKey(number, hash), v)
+	})
+}
+
+// ForEachPendingEpoch iterates over the pending epochs signalled at or after the given block.
+func (cr *NonTransactionalEpochReader) ForEachPendingEpoch(fromNumber uint64, walker func(number uint64, hash libcommon.Hash, proof []byte) error) error {
+	return cr.db.View(context.Background(), func(tx kv.Tx) error {
+		return tx.ForEach(kv.PendingEpoch, hexutil.EncodeTs(fromNumber), func(k, v []byte) error {
+			return walker(binary.BigEndian.Uint64(k), libcommon.BytesToHash(k[8:]), v)
+		})
+	})
+}
+
+// PutReports stores the reports of the given block, in a single transaction. The reports of the block
+// and of the ones after it, stored before, are of unwound blocks and are deleted.
+func (cr *NonTransactionalEpochReader) PutReports(number uint64, reports []Report) error {
+	if cr.readonly {
+		return nil
+	}
+	if len(reports) == 0 {
+		// most blocks have no reports, don't open a write transaction for them
+		var stored bool
+		if err := cr.db.View(context.Background(), func(tx kv.Tx) error {
+			c, err := tx.Cursor(kv.AuRaReports)
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			k, _, err := c.Seek(hexutil.EncodeTs(number))
+			stored = k != nil
+			return err
+		}); err != nil || !stored {
+			return err
+		}
+	}
+	return cr.db.UpdateNosync(context.Background(), func(tx kv.RwTx) error {
+		var unwound [][]byte
+		if err := tx.ForEach(kv.AuRaReports, hexutil.EncodeTs(number), func(k, _ []byte) error {
+			unwound = append(unwound, libcommon.Copy(k))
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, k := range unwound {
+			if err := tx.Delete(kv.AuRaReports, k); err != nil {
+				return err
+			}
+		}
+		for _, report := range reports {
+			if err := tx.Put(kv.AuRaReports, report.key(), nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Reports returns the reports of the blocks in [fromNumber, toNumber], of all the forks.
+func (cr *NonTransactionalEpochReader) Reports(fromNumber, toNumber uint64) (reports []Report, err error) {
+	return reports, cr.db.View(context.Background(), func(tx kv.Tx) error {
+		c, err := tx.Cursor(kv.AuRaReports)
+		if err != nil {
+			return err
+		}
+		defer c.Close()
+
+		k, _, err := c.Seek(hexutil.EncodeTs(fromNumber))
+		for ; k != nil && err == nil; k, _, err = c.Next() {
+			report := reportFromKey(k)
+			if report.BlockNumber > toNumber {
+				break
+			}
+			reports = append(reports, report)
+		}
+		return err
+	})
+}
+
+func epochKey(number uint64, hash libcommon.Hash) []byte {
+	k := make([]byte, 8+length.Hash)
+	binary.BigEndian.PutUint64(k, number)
+	copy(k[8:], hash[:])
+	return k
+}
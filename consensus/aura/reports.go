@@ -0,0 +1,143 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package aura
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/common/length"
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon/consensus"
+	"github.com/erigontech/erigon/core/types"
+)
+
+type ReportKind uint8
+
+const (
+	// ReportBenign is misbehaviour which can happen to honest validators, e.g. a skipped step.
+	ReportBenign ReportKind = iota + 1
+	// ReportMalicious is misbehaviour which honest validators don't commit, e.g. a double vote.
+	ReportMalicious
+)
+
+func (k ReportKind) String() string {
+	switch k {
+	case ReportBenign:
+		return "benign"
+	case ReportMalicious:
+		return "malicious"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(k))
+	}
+}
+
+func (k ReportKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// Report of a validator misbehaviour detected by the engine. Unlike in OpenEthereum the reports
+// are not sent to the validator set contract, they are only recorded to be served over RPC.
+type Report struct {
+	Kind        ReportKind        `json:"kind"`
+	Validator   libcommon.Address `json:"validator"`
+	BlockNumber uint64            `json:"blockNumber"`
+	BlockHash   libcommon.Hash    `json:"blockHash"`
+	Reason      string            `json:"reason"`
+}
+
+const reportKeyPrefixLen = 8 + length.Hash + length.Addr + 1
+
+// key is block_num_u64+block_hash+validator_address+kind_u8+reason: a validator can misbehave
+// in several ways in the same block, and blocks of other forks have their own reports
+func (r Report) key() []byte {
+	k := make([]byte, reportKeyPrefixLen+len(r.Reason))
+	binary.BigEndian.PutUint64(k, r.BlockNumber)
+	copy(k[8:], r.BlockHash[:])
+	copy(k[8+length.Hash:], r.Validator[:])
+	k[8+length.Hash+length.Addr] = byte(r.Kind)
+	copy(k[reportKeyPrefixLen:], r.Reason)
+	return k
+}
+
+func reportFromKey(k []byte) Report {
+	return Report{
+		BlockNumber: binary.BigEndian.Uint64(k),
+		BlockHash:   libcommon.BytesToHash(k[8 : 8+length.Hash]),
+		Validator:   libcommon.BytesToAddress(k[8+length.Hash : 8+length.Hash+length.Addr]),
+		Kind:        ReportKind(k[8+length.Hash+length.Addr]),
+		Reason:      string(k[reportKeyPrefixLen:]),
+	}
+}
+
+// reportMisbehaviour reports the double votes, sibling blocks and skipped primaries revealed by
+// the header, see verify_family and report_skipped of OpenEthereum. The validators are the ones
+// of the epoch of the header. The reports are stored at once, replacing the ones of the blocks
+// from the header on, which are left from unwound blocks.
+func (c *AuRa) reportMisbehaviour(chain consensus.ChainHeaderReader, header *types.Header, validators []libcommon.Address) {
+	num, hash := header.Number.Uint64(), header.Hash()
+	var reports []Report
+	report := func(kind ReportKind, validator libcommon.Address, reason string) {
+		log.Debug("[aura] reporting validator", "kind", kind, "validator", validator, "block", num, "reason", reason)
+		reports = append(reports, Report{Kind: kind, Validator: validator, BlockNumber: num, BlockHash: hash, Reason: reason})
+	}
+	defer func() {
+		if err := c.e.PutReports(num, reports); err != nil {
+			log.Warn("[aura] could not store reports", "block", num, "err", err)
+		}
+	}()
+
+	// we're building on top of the genesis block so don't do any reporting
+	if num <= 1 {
+		return
+	}
+	parent := chain.GetHeader(header.ParentHash, num-1)
+	if parent == nil {
+		return
+	}
+	step, parentStep := header.AuRaStep, parent.AuRaStep
+
+	if step == parentStep || (num >= c.cfg.ValidateStepTransition && step <= parentStep) {
+		report(ReportMalicious, header.Coinbase, fmt.Sprintf("multiple blocks proposed for step %d", step))
+	}
+
+	if c.hasReceivedStepHashes(step, header.Coinbase, hash) {
+		report(ReportMalicious, header.Coinbase, fmt.Sprintf("sibling blocks produced in step %d", step))
+	}
+	c.insertReceivedStepHashes(step, header.Coinbase, hash)
+
+	if len(validators) == 0 {
+		return
+	}
+
+	// remove hash records older than two full rounds of steps
+	if siblingMaliceDetectionPeriod := 2 * uint64(len(validators)); parentStep > siblingMaliceDetectionPeriod {
+		c.dropAncientReceivedStepHashes(parentStep - siblingMaliceDetectionPeriod)
+	}
+
+	reported := map[libcommon.Address]struct{}{}
+	for s := parentStep + 1; s < step; s++ {
+		skippedPrimary := validators[s%uint64(len(validators))]
+		// stop reporting once validators start repeating
+		if _, ok := reported[skippedPrimary]; ok {
+			break
+		}
+		reported[skippedPrimary] = struct{}{}
+		report(ReportBenign, skippedPrimary, fmt.Sprintf("skipped step %d", s))
+	}
+}
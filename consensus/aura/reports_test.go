@@ -0,0 +1,102 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package aura
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/kv"
+	"github.com/erigontech/erigon-lib/kv/memdb"
+	"github.com/erigontech/erigon/consensus"
+	"github.com/erigontech/erigon/core/types"
+	"github.com/erigontech/erigon/params"
+)
+
+type testHeaderReader struct {
+	consensus.ChainHeaderReader
+	headers map[libcommon.Hash]*types.Header
+}
+
+func (r testHeaderReader) GetHeader(hash libcommon.Hash, number uint64) *types.Header {
+	return r.headers[hash]
+}
+
+func TestReportMisbehaviour(t *testing.T) {
+	c, err := NewAuRa(params.GnosisChainConfig.Aura, memdb.NewTestDB(t, kv.ChainDB))
+	require.NoError(t, err)
+
+	validators := []libcommon.Address{{1}, {2}, {3}}
+	chain := testHeaderReader{headers: map[libcommon.Hash]*types.Header{}}
+	newHeader := func(parent *types.Header, step uint64, extra byte) *types.Header {
+		h := &types.Header{Number: big.NewInt(1), AuRaStep: step, Coinbase: validators[step%3], Extra: []byte{extra}}
+		if parent != nil {
+			h.ParentHash = parent.Hash()
+			h.Number = new(big.Int).Add(parent.Number, libcommon.Big1)
+		}
+		chain.headers[h.Hash()] = h
+		return h
+	}
+
+	h1 := newHeader(nil, 1, 0)
+	h2 := newHeader(h1, 2, 0)
+	c.reportMisbehaviour(chain, h2, validators)
+	reports, err := c.e.Reports(0, 10)
+	require.NoError(t, err)
+	require.Empty(t, reports)
+
+	// steps 3, 4 and 5 are skipped, but the primary of step 6 is the one of step 3
+	h3 := newHeader(h2, 7, 0)
+	c.reportMisbehaviour(chain, h3, validators)
+	// a block produced before the step of its parent
+	h4 := newHeader(h3, 6, 0)
+	c.reportMisbehaviour(chain, h4, validators)
+
+	reports, err = c.e.Reports(3, 10)
+	require.NoError(t, err)
+	require.Equal(t, []Report{
+		{Kind: ReportBenign, Validator: validators[0], BlockNumber: 3, BlockHash: h3.Hash(), Reason: "skipped step 3"},
+		{Kind: ReportBenign, Validator: validators[1], BlockNumber: 3, BlockHash: h3.Hash(), Reason: "skipped step 4"},
+		{Kind: ReportBenign, Validator: validators[2], BlockNumber: 3, BlockHash: h3.Hash(), Reason: "skipped step 5"},
+		{Kind: ReportMalicious, Validator: validators[0], BlockNumber: 4, BlockHash: h4.Hash(), Reason: "multiple blocks proposed for step 6"},
+	}, reports)
+
+	// a sibling produced in the same step replaces h3 and h4: their reports are unwound
+	h3b := newHeader(h2, 7, 1)
+	c.reportMisbehaviour(chain, h3b, validators)
+	reports, err = c.e.Reports(3, 10)
+	require.NoError(t, err)
+	require.Equal(t, []Report{
+		{Kind: ReportBenign, Validator: validators[0], BlockNumber: 3, BlockHash: h3b.Hash(), Reason: "skipped step 3"},
+		{Kind: ReportBenign, Validator: validators[1], BlockNumber: 3, BlockHash: h3b.Hash(), Reason: "skipped step 4"},
+		{Kind: ReportMalicious, Validator: validators[1], BlockNumber: 3, BlockHash: h3b.Hash(), Reason: "sibling blocks produced in step 7"},
+		{Kind: ReportBenign, Validator: validators[2], BlockNumber: 3, BlockHash: h3b.Hash(), Reason: "skipped step 5"},
+	}, reports)
+
+	// several malicious reports of a validator in the same block
+	h4b := newHeader(h3b, 6, 1)
+	c.reportMisbehaviour(chain, h4b, validators)
+	reports, err = c.e.Reports(4, 4)
+	require.NoError(t, err)
+	require.Equal(t, []Report{
+		{Kind: ReportMalicious, Validator: validators[0], BlockNumber: 4, BlockHash: h4b.Hash(), Reason: "multiple blocks proposed for step 6"},
+		{Kind: ReportMalicious, Validator: validators[0], BlockNumber: 4, BlockHash: h4b.Hash(), Reason: "sibling blocks produced in step 6"},
+	}, reports)
+}
@@ -472,7 +472,7 @@ func (s *ValidatorSafeContract) epochSet(firstInEpoch bool, num uint64, setProof
 		}
 		l, ok := s.getListSyscall(call)
 		if !ok {
-			panic(1)
+			return SimpleList{}, libcommon.Hash{}, errors.New("[ValidatorSafeContract.epochSet] could not get validators")
 		}
 
 		//addresses, err := checkFirstValidatorSetProof(s.contractAddress, oldHeader, state_items)
@@ -495,7 +495,7 @@ func (s *ValidatorSafeContract) epochSet(firstInEpoch bool, num uint64, setProof
 	}
 	ll, ok := s.extractFromEvent(proof.Header, proof.Receipts)
 	if !ok {
-		panic(1)
+		return SimpleList{}, libcommon.Hash{}, errors.New("[ValidatorSafeContract.epochSet] no validator set change event in proof")
 	}
 
 	// ensure receipts match header.
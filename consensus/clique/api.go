@@ -21,6 +21,7 @@ package clique
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/erigontech/erigon/eth/consensuschain"
@@ -33,6 +34,8 @@ import (
 	"github.com/erigontech/erigon/turbo/services"
 )
 
+var errCliqueNotAvailable = errors.New("clique engine is not available")
+
 // API is a user facing RPC API to allow controlling the signer and voting
 // mechanisms of the proof-of-authority scheme.
 type API struct {
@@ -143,33 +146,29 @@ func (api *API) GetSignersAtHash(ctx context.Context, hash libcommon.Hash) ([]li
 }
 
 // Proposals returns the current proposals the node tries to uphold and vote on.
-func (api *API) Proposals() map[libcommon.Address]bool {
-	api.clique.lock.RLock()
-	defer api.clique.lock.RUnlock()
-
-	proposals := make(map[libcommon.Address]bool)
-	for address, auth := range api.clique.proposals {
-		proposals[address] = auth
+func (api *API) Proposals() (map[libcommon.Address]bool, error) {
+	if api.clique == nil {
+		return nil, errCliqueNotAvailable
 	}
-	return proposals
+	return api.clique.Proposals(), nil
 }
 
 // Propose injects a new authorization proposal that the signer will attempt to
-// push through.
-func (api *API) Propose(address libcommon.Address, auth bool) {
-	api.clique.lock.Lock()
-	defer api.clique.lock.Unlock()
-
-	api.clique.proposals[address] = auth
+// push through. Proposals are kept across restarts until they are discarded.
+func (api *API) Propose(address libcommon.Address, auth bool) error {
+	if api.clique == nil {
+		return errCliqueNotAvailable
+	}
+	return api.clique.Propose(address, auth)
 }
 
 // Discard drops a currently running proposal, stopping the signer from casting
 // further votes (either for or against).
-func (api *API) Discard(address libcommon.Address) {
-	api.clique.lock.Lock()
-	defer api.clique.lock.Unlock()
-
-	delete(api.clique.proposals, address)
+func (api *API) Discard(address libcommon.Address) error {
+	if api.clique == nil {
+		return errCliqueNotAvailable
+	}
+	return api.clique.Discard(address)
 }
 
 type status struct {
@@ -183,6 +182,9 @@ type status struct {
 // - the number of signers,
 // - the percentage of in-turn blocks
 func (api *API) Status(ctx context.Context) (*status, error) {
+	if api.clique == nil {
+		return nil, errCliqueNotAvailable
+	}
 	tx, err := api.db.BeginRo(ctx)
 	if err != nil {
 		return nil, err
@@ -205,8 +207,9 @@ func (api *API) Status(ctx context.Context) (*status, error) {
 		end     = header.Number.Uint64()
 		start   = end - numBlocks
 	)
-	if numBlocks > end {
-		start = 1
+	if numBlocks >= end {
+		// skip the genesis, which is not signed
+		start = min(1, end)
 		numBlocks = end - start
 	}
 	signStatus := make(map[libcommon.Address]int)
@@ -228,8 +231,12 @@ func (api *API) Status(ctx context.Context) (*status, error) {
 		}
 		signStatus[sealer]++
 	}
+	var inturnPercent float64
+	if numBlocks > 0 {
+		inturnPercent = float64(100*optimals) / float64(numBlocks)
+	}
 	return &status{
-		InturnPercent: float64(100*optimals) / float64(numBlocks),
+		InturnPercent: inturnPercent,
 		SigningStatus: signStatus,
 		NumBlocks:     numBlocks,
 	}, nil
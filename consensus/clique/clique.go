@@ -227,6 +227,13 @@ func New(cfg *chain.Config, snapshotConfig *params.ConsensusSnapshotConfig, cliq
 		logger:         logger,
 	}
 
+	proposals, err := loadProposals(cliqueDB)
+	if err != nil {
+		logger.Error("on Clique init while loading proposals", "err", err)
+	} else {
+		c.proposals = proposals
+	}
+
 	// warm the cache
 	snapNum, err := lastSnapshot(cliqueDB, logger)
 	if err != nil {
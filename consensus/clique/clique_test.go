@@ -21,6 +21,7 @@ package clique_test
 
 import (
 	"math/big"
+	"reflect"
 	"testing"
 
 	"github.com/holiman/uint256"
@@ -142,3 +143,38 @@ func TestReimportMirroredState(t *testing.T) {
 	}
 
 }
+
+func TestProposalsPersisted(t *testing.T) {
+	cliqueDB := memdb.NewTestDB(t, kv.ConsensusDB)
+	engine := clique.New(params.AllCliqueProtocolChanges, params.CliqueSnapshot, cliqueDB, log.New())
+
+	authorize, drop := libcommon.Address{0x01}, libcommon.Address{0x02}
+	if err := engine.Propose(authorize, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := engine.Propose(drop, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := engine.Discard(authorize); err != nil {
+		t.Fatal(err)
+	}
+	if err := engine.Propose(authorize, true); err != nil {
+		t.Fatal(err)
+	}
+
+	// a restarted engine keeps voting on the proposals
+	restarted := clique.New(params.AllCliqueProtocolChanges, params.CliqueSnapshot, cliqueDB, log.New())
+	want := map[libcommon.Address]bool{authorize: true, drop: false}
+	if have := restarted.Proposals(); !reflect.DeepEqual(have, want) {
+		t.Fatalf("proposals mismatch: have %v, want %v", have, want)
+	}
+
+	if err := restarted.Discard(drop); err != nil {
+		t.Fatal(err)
+	}
+	restarted = clique.New(params.AllCliqueProtocolChanges, params.CliqueSnapshot, cliqueDB, log.New())
+	want = map[libcommon.Address]bool{authorize: true}
+	if have := restarted.Proposals(); !reflect.DeepEqual(have, want) {
+		t.Fatalf("proposals mismatch after discard: have %v, want %v", have, want)
+	}
+}
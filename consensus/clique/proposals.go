@@ -0,0 +1,91 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package clique
+
+import (
+	"context"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/kv"
+)
+
+// Proposals returns the current proposals the signer votes on.
+func (c *Clique) Proposals() map[libcommon.Address]bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	proposals := make(map[libcommon.Address]bool, len(c.proposals))
+	for address, auth := range c.proposals {
+		proposals[address] = auth
+	}
+	return proposals
+}
+
+// Propose adds a proposal to authorize or drop the address, which is cast as a
+// vote in the headers prepared by this node until it is discarded.
+func (c *Clique) Propose(address libcommon.Address, auth bool) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err := storeProposal(c.DB, address, auth); err != nil {
+		return err
+	}
+	c.proposals[address] = auth
+	return nil
+}
+
+// Discard drops the proposal for the address.
+func (c *Clique) Discard(address libcommon.Address) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err := deleteProposal(c.DB, address); err != nil {
+		return err
+	}
+	delete(c.proposals, address)
+	return nil
+}
+
+// loadProposals loads the proposals persisted by Propose.
+func loadProposals(db kv.RwDB) (map[libcommon.Address]bool, error) {
+	proposals := make(map[libcommon.Address]bool)
+	if err := db.View(context.Background(), func(tx kv.Tx) error {
+		return tx.ForEach(kv.CliqueProposals, nil, func(k, v []byte) error {
+			proposals[libcommon.BytesToAddress(k)] = len(v) > 0 && v[0] == 1
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return proposals, nil
+}
+
+func storeProposal(db kv.RwDB, address libcommon.Address, auth bool) error {
+	v := []byte{0}
+	if auth {
+		v[0] = 1
+	}
+	return db.Update(context.Background(), func(tx kv.RwTx) error {
+		return tx.Put(kv.CliqueProposals, address.Bytes(), v)
+	})
+}
+
+func deleteProposal(db kv.RwDB, address libcommon.Address) error {
+	return db.Update(context.Background(), func(tx kv.RwTx) error {
+		return tx.Delete(kv.CliqueProposals, address.Bytes())
+	})
+}
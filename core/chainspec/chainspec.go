@@ -0,0 +1,138 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+// Package chainspec validates and compares chain specs, i.e. the chain configs
+// of params/chainspecs or of the genesis files of private networks.
+package chainspec
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/erigontech/erigon-lib/chain"
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/common/datadir"
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon/core"
+	"github.com/erigontech/erigon/core/types"
+	"github.com/erigontech/erigon/params"
+	"github.com/erigontech/erigon/polygon/bor/borcfg"
+)
+
+// Spec is a chain config along with its genesis, which is nil if the spec has no genesis
+type Spec struct {
+	Name    string
+	Config  *chain.Config
+	Genesis *types.Genesis
+
+	genesisHash *libcommon.Hash
+}
+
+// Load loads the spec of a known chain, given by its name, or the spec of a JSON file
+// which holds either a genesis, as used by `erigon init`, or a chain config only
+func Load(nameOrPath string) (*Spec, error) {
+	if config := params.ChainConfigByChainName(nameOrPath); config != nil {
+		return &Spec{
+			Name:        nameOrPath,
+			Config:      config,
+			Genesis:     core.GenesisBlockByChainName(nameOrPath),
+			genesisHash: params.GenesisHashByChainName(nameOrPath),
+		}, nil
+	}
+
+	data, err := os.ReadFile(nameOrPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(strings.TrimSuffix(filepath.Base(nameOrPath), filepath.Ext(nameOrPath)), data)
+}
+
+// Parse parses a genesis or a chain config, the name is used if the config has no chain name
+func Parse(name string, data []byte) (*Spec, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("invalid chain spec %s: %w", name, err)
+	}
+
+	spec := &Spec{Name: name}
+
+	if _, ok := fields["config"]; ok {
+		spec.Genesis = &types.Genesis{}
+		if err := json.Unmarshal(data, spec.Genesis); err != nil {
+			return nil, fmt.Errorf("invalid genesis %s: %w", name, err)
+		}
+		spec.Config = spec.Genesis.Config
+	} else {
+		spec.Config = &chain.Config{}
+		if err := json.Unmarshal(data, spec.Config); err != nil {
+			return nil, fmt.Errorf("invalid chain config %s: %w", name, err)
+		}
+	}
+
+	if spec.Config == nil {
+		return nil, fmt.Errorf("invalid genesis %s: no chain config", name)
+	}
+
+	if spec.Config.BorJSON != nil && spec.Config.Bor == nil {
+		borConfig := &borcfg.BorConfig{}
+		if err := json.Unmarshal(spec.Config.BorJSON, borConfig); err != nil {
+			return nil, fmt.Errorf("invalid 'bor' chain config %s: %w", name, err)
+		}
+		spec.Config.Bor = borConfig
+	}
+
+	if spec.Config.ChainName != "" {
+		spec.Name = spec.Config.ChainName
+	}
+
+	return spec, nil
+}
+
+// GenesisTime returns the timestamp of the genesis, 0 if the spec has no genesis
+func (s *Spec) GenesisTime() uint64 {
+	if s.Genesis == nil {
+		return 0
+	}
+	return s.Genesis.Timestamp
+}
+
+// GenesisHash returns the hash of the genesis block, computing the genesis state in a
+// temporary dir for genesis files. It is nil if the spec has no genesis.
+func (s *Spec) GenesisHash(logger log.Logger) (*libcommon.Hash, error) {
+	if s.genesisHash != nil || s.Genesis == nil {
+		return s.genesisHash, nil
+	}
+
+	tmpDir, err := os.MkdirTemp("", "chainspec-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	block, _, err := core.GenesisToBlock(s.Genesis, datadir.New(tmpDir), logger)
+	if err != nil {
+		return nil, fmt.Errorf("can't compute the genesis of %s: %w", s.Name, err)
+	}
+
+	hash := block.Hash()
+	s.genesisHash = &hash
+
+	return s.genesisHash, nil
+}
@@ -0,0 +1,193 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package chainspec
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/erigontech/erigon-lib/chain/networkname"
+	"github.com/erigontech/erigon/core/forkid"
+	"github.com/erigontech/erigon/params"
+)
+
+func TestValidateKnownChains(t *testing.T) {
+	for _, name := range []string{
+		networkname.Mainnet,
+		networkname.Holesky,
+		networkname.Sepolia,
+		networkname.Gnosis,
+		networkname.Chiado,
+		networkname.Amoy,
+		networkname.BorMainnet,
+		networkname.BorDevnet,
+	} {
+		t.Run(name, func(t *testing.T) {
+			spec, err := Load(name)
+			require.NoError(t, err)
+
+			issues := Validate(spec)
+			require.False(t, HasErrors(issues), "%v", issues)
+		})
+	}
+}
+
+func TestValidateIssues(t *testing.T) {
+	spec, err := Parse("private", []byte(`{
+		"config": {
+			"chainId": 1337,
+			"homesteadBlock": 0,
+			"eip150Block": 0,
+			"eip155Block": 0,
+			"byzantiumBlock": 0,
+			"constantinopleBlock": 0,
+			"petersburgBlock": 0,
+			"istanbulBlock": 0,
+			"berlinBlock": 0,
+			"londonBlock": 0,
+			"terminalTotalDifficulty": 0,
+			"shanghaiTime": 100,
+			"cancunTime": 0,
+			"pragueTime": 200,
+			"blobSchedule": {
+				"cancun": {"target": 7, "max": 6, "baseFeeUpdateFraction": 3338477},
+				"prague": {"target": 6, "max": 9}
+			},
+			"depositContractAddress": "0x4242424242424242424242424242424242424242",
+			"clique": {"period": 5, "epoch": 30000}
+		},
+		"gasLimit": "0x1c9c380",
+		"difficulty": "0x1",
+		"extraData": "0x00",
+		"alloc": {}
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, "private", spec.Name)
+
+	var errs []string
+	var warnings []string
+
+	for _, issue := range Validate(spec) {
+		if issue.Severity == Error {
+			errs = append(errs, issue.Field)
+		} else {
+			warnings = append(warnings, issue.Field)
+		}
+	}
+
+	require.Equal(t, []string{
+		"cancunTime",                 // enabled before shanghaiTime
+		"cancunTime",                 // EIP-4788 contract missing at genesis
+		"blobSchedule.cancun.target", // higher than max
+		"extraData",                  // no room for clique signers
+	}, errs)
+
+	require.Equal(t, []string{
+		"pragueTime", // EIP-2935 contract to deploy before activation
+		"pragueTime", // EIP-7002
+		"pragueTime", // EIP-7251
+		"blobSchedule.prague",
+	}, warnings)
+}
+
+func TestTimeline(t *testing.T) {
+	spec, err := Load(networkname.Mainnet)
+	require.NoError(t, err)
+
+	hash, err := spec.GenesisHash(nil)
+	require.NoError(t, err)
+	require.Equal(t, params.MainnetGenesisHash, *hash)
+
+	timeline := Timeline(spec.Config, *hash, spec.GenesisTime())
+
+	genesis := timeline[0]
+	require.Equal(t, []string{"genesis"}, genesis.Names)
+	require.Equal(t, forkid.ID{Hash: [4]byte{0xfc, 0x64, 0xec, 0x04}, Next: 1150000}, genesis.ID)
+
+	homestead := timeline[1]
+	require.Equal(t, []string{"homesteadBlock"}, homestead.Names)
+	require.Equal(t, uint64(1150000), homestead.Activation)
+	require.Equal(t, forkid.ID{Hash: [4]byte{0x97, 0xc2, 0xc3, 0x4c}, Next: 1920000}, homestead.ID)
+
+	var constantinople Fork
+	for _, fork := range timeline {
+		if fork.Activation == 7280000 {
+			constantinople = fork
+		}
+	}
+	require.Equal(t, []string{"constantinopleBlock", "petersburgBlock"}, constantinople.Names)
+
+	cancun := timeline[len(timeline)-1]
+	require.Equal(t, []string{"cancunTime"}, cancun.Names)
+	require.True(t, cancun.TimeBased)
+	require.Equal(t, forkid.ID{Hash: [4]byte{0x9f, 0x3d, 0x22, 0x54}, Next: 0}, cancun.ID)
+
+	heightForks, timeForks := forkid.GatherForks(spec.Config, 0)
+	require.Equal(t, forkid.NewIDFromForks(heightForks, timeForks, *hash, math.MaxUint64, math.MaxUint64), cancun.ID)
+}
+
+func TestDiff(t *testing.T) {
+	holesky, err := Load(networkname.Holesky)
+	require.NoError(t, err)
+
+	differences, err := Diff(holesky.Config, holesky.Config)
+	require.NoError(t, err)
+	require.Empty(t, differences)
+
+	private, err := Parse("private", []byte(`{
+		"chainName": "holesky",
+		"chainId": 17000,
+		"homesteadBlock": 0,
+		"eip150Block": 0,
+		"eip155Block": 0,
+		"byzantiumBlock": 0,
+		"constantinopleBlock": 0,
+		"petersburgBlock": 0,
+		"istanbulBlock": 0,
+		"berlinBlock": 0,
+		"londonBlock": 0,
+		"terminalTotalDifficulty": 0,
+		"terminalTotalDifficultyPassed": true,
+		"shanghaiTime": 1696000704,
+		"cancunTime": 1707305664,
+		"blobSchedule": {
+			"cancun": {"target": 4, "max": 6, "baseFeeUpdateFraction": 3338477},
+			"prague": {"target": 6, "max": 9, "baseFeeUpdateFraction": 5007716}
+		},
+		"depositContractAddress": "0x4242424242424242424242424242424242424242"
+	}`))
+	require.NoError(t, err)
+
+	differences, err = Diff(holesky.Config, private.Config)
+	require.NoError(t, err)
+	require.Equal(t, []Difference{
+		{"blobSchedule.cancun.target", "3", "4"},
+		{"pragueTime", "1740434112", ""},
+	}, differences)
+}
+
+func TestTimelineWithoutForks(t *testing.T) {
+	spec, err := Parse("private", []byte(`{"chainId": 1337, "londonBlock": 0}`))
+	require.NoError(t, err)
+
+	timeline := Timeline(spec.Config, params.MainnetGenesisHash, 0)
+	require.Len(t, timeline, 1)
+	require.Equal(t, uint64(0), timeline[0].ID.Next)
+	require.Equal(t, forkid.NewIDFromForks(nil, nil, params.MainnetGenesisHash, 0, math.MaxUint64), timeline[0].ID)
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package chainspec
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/erigontech/erigon-lib/chain"
+)
+
+// Difference is a field of the chain configs which differs, the values are
+// the json encoded values of the field and empty if the field is not set
+type Difference struct {
+	Field string
+	A     string
+	B     string
+}
+
+// Diff returns the fields which differ between the chain configs, sorted by field,
+// nested fields such as the blob schedule or the consensus sections are compared by leaf
+func Diff(a *chain.Config, b *chain.Config) ([]Difference, error) {
+	fieldsA, err := configFields(a)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldsB, err := configFields(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var differences []Difference
+
+	for field, valueA := range fieldsA {
+		if valueB := fieldsB[field]; valueA != valueB {
+			differences = append(differences, Difference{field, valueA, valueB})
+		}
+	}
+
+	for field, valueB := range fieldsB {
+		if _, ok := fieldsA[field]; !ok {
+			differences = append(differences, Difference{field, "", valueB})
+		}
+	}
+
+	slices.SortFunc(differences, func(x, y Difference) int {
+		return strings.Compare(x.Field, y.Field)
+	})
+
+	return differences, nil
+}
+
+// configFields flattens the json encoding of the config into its leaf values by dotted field path
+func configFields(config *chain.Config) (map[string]string, error) {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	fields := map[string]string{}
+	if err := flatten("", value, fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+func flatten(path string, value interface{}, fields map[string]string) error {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if len(value) == 0 && path != "" {
+			fields[path] = "{}"
+		}
+
+		for key, nested := range value {
+			if path != "" {
+				key = path + "." + key
+			}
+			if err := flatten(key, nested, fields); err != nil {
+				return err
+			}
+		}
+	case nil:
+		// unset fields are compared as missing
+	default:
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		fields[path] = string(encoded)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package chainspec
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/erigontech/erigon-lib/chain"
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon/core/forkid"
+)
+
+// Fork is an entry of the fork ID timeline of a chain, the forks activated at the same block
+// or time share an entry
+type Fork struct {
+	Names []string
+	// Activation is the block number or, for time based forks, the timestamp of the fork
+	Activation uint64
+	TimeBased  bool
+	// ID is the EIP-2124 fork ID nodes advertise from the activation on
+	ID forkid.ID
+}
+
+// Timeline returns the EIP-2124 fork IDs of the chain starting with the genesis ID
+func Timeline(config *chain.Config, genesisHash libcommon.Hash, genesisTime uint64) []Fork {
+	heightForks, timeForks := forkid.GatherForks(config, genesisTime)
+	names := forkNames(config)
+
+	timeline := []Fork{{
+		Names: []string{"genesis"},
+		ID:    forkid.NewIDFromForks(heightForks, timeForks, genesisHash, 0, genesisTime),
+	}}
+
+	for _, height := range heightForks {
+		timeline = append(timeline, Fork{
+			Names:      names[forkKey{height, false}],
+			Activation: height,
+			ID:         forkid.NewIDFromForks(heightForks, timeForks, genesisHash, height, genesisTime),
+		})
+	}
+
+	for _, time := range timeForks {
+		timeline = append(timeline, Fork{
+			Names:      names[forkKey{time, true}],
+			Activation: time,
+			TimeBased:  true,
+			ID:         forkid.NewIDFromForks(heightForks, timeForks, genesisHash, math.MaxUint64, time),
+		})
+	}
+
+	return timeline
+}
+
+type forkKey struct {
+	activation uint64
+	timeBased  bool
+}
+
+// forkNames returns the json names of the forks by activation, the forks are
+// gathered the same way as forkid.GatherForks does
+func forkNames(config *chain.Config) map[forkKey][]string {
+	names := map[forkKey][]string{}
+
+	kind := reflect.TypeOf(chain.Config{})
+	conf := reflect.ValueOf(config).Elem()
+
+	for i := 0; i < kind.NumField(); i++ {
+		field := kind.Field(i)
+		if field.Type != reflect.TypeOf(new(big.Int)) {
+			continue
+		}
+
+		timeBased := strings.HasSuffix(field.Name, "Time")
+		if !timeBased && !strings.HasSuffix(field.Name, "Block") {
+			continue
+		}
+
+		if rule := conf.Field(i).Interface().(*big.Int); rule != nil {
+			key := forkKey{rule.Uint64(), timeBased}
+			names[key] = append(names[key], jsonName(field))
+		}
+	}
+
+	if config.Aura != nil && config.Aura.PosdaoTransition != nil {
+		key := forkKey{*config.Aura.PosdaoTransition, false}
+		names[key] = append(names[key], "aura.PosdaoTransition")
+	}
+
+	if config.Bor != nil {
+		if block := config.Bor.GetAgraBlock(); block != nil {
+			key := forkKey{block.Uint64(), false}
+			names[key] = append(names[key], "bor.agraBlock")
+		}
+		if block := config.Bor.GetNapoliBlock(); block != nil {
+			key := forkKey{block.Uint64(), false}
+			names[key] = append(names[key], "bor.napoliBlock")
+		}
+	}
+
+	return names
+}
+
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
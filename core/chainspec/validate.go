@@ -0,0 +1,401 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package chainspec
+
+import (
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+
+	"github.com/erigontech/erigon-lib/chain"
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/common/length"
+	"github.com/erigontech/erigon/consensus/clique"
+	"github.com/erigontech/erigon/params"
+	"github.com/erigontech/erigon/polygon/bor/borcfg"
+)
+
+type Severity string
+
+const (
+	// Error is an issue which makes the chain misbehave, e.g. forks which can't activate
+	Error Severity = "error"
+	// Warning is an issue which may be intended, e.g. a system contract deployed after the genesis
+	Warning Severity = "warning"
+)
+
+// Issue is a problem found in a chain spec
+type Issue struct {
+	Severity Severity
+	Field    string
+	Message  string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s: %s", i.Severity, i.Field, i.Message)
+}
+
+// Validate checks the fork ordering, the system contracts of the forks, the blob schedule
+// and the consensus engine section of the spec
+func Validate(spec *Spec) []Issue {
+	var v validator
+
+	if spec.Config.ChainID == nil {
+		v.errorf("chainId", "no chain id")
+	}
+
+	v.checkForkOrder(spec.Config)
+	v.checkSystemContracts(spec)
+	v.checkBlobSchedule(spec.Config)
+	v.checkConsensus(spec)
+
+	return v.issues
+}
+
+// HasErrors returns whether any of the issues is an error
+func HasErrors(issues []Issue) bool {
+	for _, issue := range issues {
+		if issue.Severity == Error {
+			return true
+		}
+	}
+	return false
+}
+
+type validator struct {
+	issues []Issue
+}
+
+func (v *validator) errorf(field string, format string, args ...interface{}) {
+	v.issues = append(v.issues, Issue{Error, field, fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) warnf(field string, format string, args ...interface{}) {
+	v.issues = append(v.issues, Issue{Warning, field, fmt.Sprintf(format, args...)})
+}
+
+type timeFork struct {
+	name string
+	time *big.Int
+}
+
+func timeForks(config *chain.Config) []timeFork {
+	return []timeFork{
+		{"shanghaiTime", config.ShanghaiTime},
+		{"cancunTime", config.CancunTime},
+		{"pragueTime", config.PragueTime},
+		{"osakaTime", config.OsakaTime},
+	}
+}
+
+func (v *validator) checkForkOrder(config *chain.Config) {
+	if err := config.CheckConfigForkOrder(); err != nil {
+		v.errorf("forks", "%s", err)
+	}
+
+	var last timeFork
+
+	for _, fork := range timeForks(config) {
+		if last.name != "" && fork.time != nil {
+			if last.time == nil {
+				v.errorf(fork.name, "enabled at %v, but %s is not enabled", fork.time, last.name)
+			} else if last.time.Cmp(fork.time) > 0 {
+				v.errorf(fork.name, "enabled at %v, before %s enabled at %v", fork.time, last.name, last.time)
+			}
+		}
+		last = fork
+	}
+
+	if config.ShanghaiTime != nil {
+		if config.LondonBlock == nil {
+			v.errorf("shanghaiTime", "enabled at %v, but londonBlock is not enabled", config.ShanghaiTime)
+		}
+
+		if config.Bor == nil && config.TerminalTotalDifficulty == nil {
+			v.errorf("shanghaiTime", "enabled at %v, but the chain has no terminalTotalDifficulty to transition to proof of stake", config.ShanghaiTime)
+		}
+	}
+
+	if config.PragueTime != nil && config.Bor == nil && config.DepositContract == (libcommon.Address{}) {
+		v.warnf("depositContractAddress", "not set, EIP-6110 deposit requests are not collected from pragueTime %v", config.PragueTime)
+	}
+}
+
+type systemContract struct {
+	eip     string
+	fork    string
+	address libcommon.Address
+}
+
+var systemContracts = []systemContract{
+	{"EIP-4788", "cancunTime", params.BeaconRootsAddress},
+	{"EIP-2935", "pragueTime", params.HistoryStorageAddress},
+	{"EIP-7002", "pragueTime", params.WithdrawalRequestAddress},
+	{"EIP-7251", "pragueTime", params.ConsolidationRequestAddress},
+}
+
+// checkSystemContracts checks that the system contracts of the forks are in the genesis of the chain if
+// the forks are active at the genesis, the contracts of later forks need to be deployed before activation
+func (v *validator) checkSystemContracts(spec *Spec) {
+	if spec.Config.Bor != nil {
+		return
+	}
+
+	forkTimes := map[string]*big.Int{
+		"cancunTime": spec.Config.CancunTime,
+		"pragueTime": spec.Config.PragueTime,
+	}
+
+	for _, contract := range systemContracts {
+		forkTime := forkTimes[contract.fork]
+		if forkTime == nil {
+			continue
+		}
+
+		if spec.Genesis == nil {
+			v.warnf(contract.fork, "%s system contract %s is not checked, the spec has no genesis", contract.eip, contract.address)
+			continue
+		}
+
+		if account, ok := spec.Genesis.Alloc[contract.address]; ok && len(account.Code) > 0 {
+			continue
+		}
+
+		if forkTime.Uint64() <= spec.Genesis.Timestamp {
+			v.errorf(contract.fork, "%s system contract %s is missing from the genesis alloc, the fork is active at the genesis", contract.eip, contract.address)
+		} else {
+			v.warnf(contract.fork, "%s system contract %s is not in the genesis alloc, it must be deployed before %v", contract.eip, contract.address, forkTime)
+		}
+	}
+}
+
+func (v *validator) checkBlobSchedule(config *chain.Config) {
+	if config.MinBlobGasPrice != nil && *config.MinBlobGasPrice == 0 {
+		v.errorf("minBlobGasPrice", "zero, the blob base fee can't increase from zero")
+	}
+
+	var cancun, prague *chain.BlobConfig
+	if config.BlobSchedule != nil {
+		cancun, prague = config.BlobSchedule.Cancun, config.BlobSchedule.Prague
+	}
+
+	v.checkBlobConfig("blobSchedule.cancun", cancun, "cancunTime", config.CancunTime)
+	v.checkBlobConfig("blobSchedule.prague", prague, "pragueTime", config.PragueTime)
+
+	if config.CancunTime != nil && config.PragueTime != nil {
+		b := config.BlobSchedule
+
+		if b.MaxBlobsPerBlock(true) < b.MaxBlobsPerBlock(false) {
+			v.warnf("blobSchedule.prague.max", "%d is lower than the cancun max of %d", b.MaxBlobsPerBlock(true), b.MaxBlobsPerBlock(false))
+		}
+	}
+}
+
+func (v *validator) checkBlobConfig(field string, blobConfig *chain.BlobConfig, forkField string, forkTime *big.Int) {
+	if forkTime == nil {
+		if blobConfig != nil {
+			v.warnf(field, "set, but %s is not enabled", forkField)
+		}
+		return
+	}
+
+	if blobConfig == nil {
+		v.warnf(field, "not set, the EIP-7840 defaults of the fork are used")
+		return
+	}
+
+	if blobConfig.Target == nil || blobConfig.Max == nil || blobConfig.BaseFeeUpdateFraction == nil {
+		v.warnf(field, "incomplete, the EIP-7840 defaults of the fork are used for the missing values")
+	}
+
+	if blobConfig.Target != nil && *blobConfig.Target == 0 {
+		v.errorf(field+".target", "zero")
+	}
+
+	if blobConfig.Max != nil && *blobConfig.Max == 0 {
+		v.errorf(field+".max", "zero")
+	}
+
+	if blobConfig.Target != nil && blobConfig.Max != nil && *blobConfig.Target > *blobConfig.Max {
+		v.errorf(field+".target", "%d is higher than the max of %d", *blobConfig.Target, *blobConfig.Max)
+	}
+
+	if blobConfig.BaseFeeUpdateFraction != nil && *blobConfig.BaseFeeUpdateFraction == 0 {
+		v.errorf(field+".baseFeeUpdateFraction", "zero")
+	}
+}
+
+func (v *validator) checkConsensus(spec *Spec) {
+	config := spec.Config
+
+	sections := map[chain.ConsensusName]bool{
+		chain.EtHashConsensus: config.Ethash != nil,
+		chain.CliqueConsensus: config.Clique != nil,
+		chain.AuRaConsensus:   config.Aura != nil,
+		chain.BorConsensus:    config.Bor != nil,
+	}
+
+	var engines []chain.ConsensusName
+	for _, engine := range []chain.ConsensusName{chain.EtHashConsensus, chain.CliqueConsensus, chain.AuRaConsensus, chain.BorConsensus} {
+		if sections[engine] {
+			engines = append(engines, engine)
+		}
+	}
+
+	switch {
+	case len(engines) > 1:
+		v.errorf("consensus", "multiple consensus engine sections %v", engines)
+	case len(engines) == 0 && config.Consensus != "":
+		v.errorf("consensus", "%s, but the spec has no %s section", config.Consensus, config.Consensus)
+	case len(engines) == 0 && (config.TerminalTotalDifficulty == nil || config.TerminalTotalDifficulty.Sign() != 0):
+		v.warnf("consensus", "no consensus engine section, the chain is not proof of stake from the genesis")
+	case config.Consensus != "" && !sections[config.Consensus]:
+		v.errorf("consensus", "%s, but the spec has a %s section", config.Consensus, engines[0])
+	}
+
+	if config.Clique != nil {
+		v.checkClique(spec)
+	}
+
+	if config.Aura != nil {
+		v.checkAura(config.Aura)
+	}
+
+	if bor, ok := config.Bor.(*borcfg.BorConfig); ok && bor != nil {
+		v.checkBor(bor)
+	}
+}
+
+func (v *validator) checkClique(spec *Spec) {
+	if spec.Config.Clique.Period == 0 {
+		v.warnf("clique.period", "zero, blocks are only sealed when there are transactions")
+	}
+
+	if spec.Genesis == nil {
+		return
+	}
+
+	extra := spec.Genesis.ExtraData
+	signers := len(extra) - clique.ExtraVanity - clique.ExtraSeal
+
+	switch {
+	case signers < 0 || signers%length.Addr != 0:
+		v.errorf("extraData", "%d bytes, clique expects %d bytes of vanity, the signer addresses and %d bytes of seal", len(extra), clique.ExtraVanity, clique.ExtraSeal)
+	case signers == 0:
+		v.errorf("extraData", "no clique signers")
+	}
+}
+
+func (v *validator) checkAura(aura *chain.AuRaConfig) {
+	if aura.StepDuration == nil || *aura.StepDuration == 0 {
+		v.errorf("aura.stepDuration", "not set")
+	}
+
+	if aura.Validators == nil {
+		v.errorf("aura.validators", "not set")
+		return
+	}
+
+	if aura.Validators.Multi != nil {
+		if _, ok := aura.Validators.Multi[0]; !ok {
+			v.errorf("aura.validators.multi", "no validator set at block 0")
+		}
+
+		blocks := slices.Sorted(maps.Keys(aura.Validators.Multi))
+
+		for _, block := range blocks {
+			v.checkAuraValidators(fmt.Sprintf("aura.validators.multi.%d", block), aura.Validators.Multi[block])
+		}
+		return
+	}
+
+	v.checkAuraValidators("aura.validators", aura.Validators)
+}
+
+func (v *validator) checkAuraValidators(field string, validators *chain.ValidatorSetJson) {
+	var sets int
+
+	if len(validators.List) > 0 {
+		sets++
+	}
+	if validators.SafeContract != nil {
+		sets++
+	}
+	if validators.Contract != nil {
+		sets++
+	}
+	if validators.Multi != nil {
+		sets++
+	}
+
+	if sets != 1 {
+		v.errorf(field, "expects exactly one of list, safeContract, contract or multi")
+	}
+}
+
+func (v *validator) checkBor(bor *borcfg.BorConfig) {
+	blockValues := []struct {
+		name   string
+		values map[string]uint64
+	}{
+		{"bor.period", bor.Period},
+		{"bor.producerDelay", bor.ProducerDelay},
+		{"bor.sprint", bor.Sprint},
+		{"bor.backupMultiplier", bor.BackupMultiplier},
+	}
+
+	for _, field := range blockValues {
+		if _, ok := field.values["0"]; !ok {
+			v.errorf(field.name, "no value at block 0")
+		}
+	}
+
+	if bor.ValidatorContract == "" {
+		v.errorf("bor.validatorContract", "not set")
+	}
+
+	if bor.StateReceiverContract == "" {
+		v.errorf("bor.stateReceiverContract", "not set")
+	}
+
+	forks := []struct {
+		name  string
+		block *big.Int
+	}{
+		{"bor.jaipurBlock", bor.JaipurBlock},
+		{"bor.delhiBlock", bor.DelhiBlock},
+		{"bor.indoreBlock", bor.IndoreBlock},
+		{"bor.agraBlock", bor.AgraBlock},
+		{"bor.napoliBlock", bor.NapoliBlock},
+		{"bor.ahmedabadBlock", bor.AhmedabadBlock},
+	}
+
+	for i := 1; i < len(forks); i++ {
+		last, fork := forks[i-1], forks[i]
+
+		if fork.block == nil {
+			continue
+		}
+
+		if last.block == nil {
+			v.errorf(fork.name, "enabled at %v, but %s is not enabled", fork.block, last.name)
+		} else if last.block.Cmp(fork.block) > 0 {
+			v.errorf(fork.name, "enabled at %v, before %s enabled at %v", fork.block, last.name, last.block)
+		}
+	}
+}
@@ -36,6 +36,11 @@ import (
 
 const (
 	LEN_48 = 48 // KZGCommitment & KZGProof sizes
+
+	// BlobTxWrapperVersion0 - EIP-4844 network wrapper: a KZG proof per blob
+	BlobTxWrapperVersion0 byte = 0
+	// BlobTxWrapperVersion1 - EIP-7594 network wrapper: fixedgas.CellsPerExtBlob cell proofs per blob, the version is encoded explicitly
+	BlobTxWrapperVersion1 byte = 1
 )
 
 type KZGCommitment [LEN_48]byte // Compressed BLS12-381 G1 element
@@ -47,10 +52,11 @@ type KZGProofs []KZGProof
 type Blobs []Blob
 
 type BlobTxWrapper struct {
-	Tx          BlobTx
-	Commitments BlobKzgs
-	Blobs       Blobs
-	Proofs      KZGProofs
+	Tx             BlobTx
+	WrapperVersion byte
+	Commitments    BlobKzgs
+	Blobs          Blobs
+	Proofs         KZGProofs // cell proofs for BlobTxWrapperVersion1
 }
 
 /* Blob methods */
@@ -279,13 +285,26 @@ func (txw *BlobTxWrapper) ValidateBlobTransactionWrapper() error {
 	l2 := len(txw.Commitments)
 	l3 := len(txw.Blobs)
 	l4 := len(txw.Proofs)
-	if l1 != l2 || l1 != l3 || l1 != l4 {
-		return fmt.Errorf("lengths don't match %v %v %v %v", l1, l2, l3, l4)
-	}
-	kzgCtx := libkzg.Ctx()
-	err := kzgCtx.VerifyBlobKZGProofBatch(toBlobs(txw.Blobs), toComms(txw.Commitments), toProofs(txw.Proofs))
-	if err != nil {
-		return fmt.Errorf("error during proof verification: %v", err)
+	switch txw.WrapperVersion {
+	case BlobTxWrapperVersion0:
+		if l1 != l2 || l1 != l3 || l1 != l4 {
+			return fmt.Errorf("lengths don't match %v %v %v %v", l1, l2, l3, l4)
+		}
+		kzgCtx := libkzg.Ctx()
+		err := kzgCtx.VerifyBlobKZGProofBatch(toBlobs(txw.Blobs), toComms(txw.Commitments), toProofs(txw.Proofs))
+		if err != nil {
+			return fmt.Errorf("error during proof verification: %v", err)
+		}
+	case BlobTxWrapperVersion1:
+		if l1 != l2 || l1 != l3 || l1*fixedgas.CellsPerExtBlob != l4 {
+			return fmt.Errorf("lengths don't match %v %v %v %v", l1, l2, l3, l4)
+		}
+		err := libkzg.VerifyCellProofBatch(toBlobs(txw.Blobs), toComms(txw.Commitments), toProofs(txw.Proofs))
+		if err != nil {
+			return fmt.Errorf("error during cell proof verification: %v", err)
+		}
+	default:
+		return fmt.Errorf("unknown blob txn wrapper version: %d", txw.WrapperVersion)
 	}
 	for i, h := range txw.Tx.BlobVersionedHashes {
 		if computed := txw.Commitments[i].ComputeVersionedHash(); computed != h {
@@ -357,6 +376,22 @@ func (txw *BlobTxWrapper) DecodeRLP(s *rlp.Stream) error {
 		return err
 	}
 
+	// EIP-7594 wrapper has the version between the txn and the blobs
+	kind, _, err := s.Kind()
+	if err != nil {
+		return err
+	}
+	if kind != rlp.List {
+		version, err := s.Uint()
+		if err != nil {
+			return err
+		}
+		if version != uint64(BlobTxWrapperVersion1) {
+			return fmt.Errorf("unknown blob txn wrapper version: %d", version)
+		}
+		txw.WrapperVersion = byte(version)
+	}
+
 	if err := txw.Blobs.DecodeRLP(s); err != nil {
 		return err
 	}
@@ -380,6 +415,9 @@ func (txw *BlobTxWrapper) EncodingSize() int {
 func (txw *BlobTxWrapper) payloadSize() (payloadSize int) {
 	l, _, _, _, _ := txw.Tx.payloadSize()
 	payloadSize += l + rlp.ListPrefixLen(l)
+	if txw.WrapperVersion != BlobTxWrapperVersion0 {
+		payloadSize += rlp.U64Len(uint64(txw.WrapperVersion))
+	}
 	l = txw.Blobs.payloadSize()
 	payloadSize += l + rlp.ListPrefixLen(l)
 	l = txw.Commitments.payloadSize()
@@ -408,6 +446,11 @@ func (txw *BlobTxWrapper) MarshalBinaryWrapped(w io.Writer) error {
 	if _, err := w.Write(bw.Bytes()[1:]); err != nil {
 		return err
 	}
+	if txw.WrapperVersion != BlobTxWrapperVersion0 {
+		if err := rlp.EncodeInt(uint64(txw.WrapperVersion), w, b[:]); err != nil {
+			return err
+		}
+	}
 
 	if err := txw.Blobs.encodePayload(w, b[:], txw.Blobs.payloadSize()); err != nil {
 		return err
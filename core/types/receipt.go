@@ -395,6 +395,46 @@ func (r *ReceiptForStorage) DecodeRLP(s *rlp.Stream) error {
 
 }
 
+// Receipt69 is a wrapper around a Receipt with the eth/69 network serialization,
+// which omits the Bloom field and always carries the transaction type.
+type Receipt69 Receipt
+
+// receiptRLP69 is the eth/69 network encoding of a receipt.
+type receiptRLP69 struct {
+	Type              uint8
+	PostStateOrStatus []byte
+	CumulativeGasUsed uint64
+	Logs              []*Log
+}
+
+// EncodeRLP implements rlp.Encoder, and flattens the consensus fields of a receipt
+// except the Bloom into an RLP stream.
+func (r *Receipt69) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, &receiptRLP69{
+		Type:              r.Type,
+		PostStateOrStatus: (*Receipt)(r).statusEncoding(),
+		CumulativeGasUsed: r.CumulativeGasUsed,
+		Logs:              r.Logs,
+	})
+}
+
+// DecodeRLP implements rlp.Decoder, and loads the consensus fields of a receipt
+// from an RLP stream, re-computing the Bloom from the logs.
+func (r *Receipt69) DecodeRLP(s *rlp.Stream) error {
+	var dec receiptRLP69
+	if err := s.Decode(&dec); err != nil {
+		return err
+	}
+	if err := (*Receipt)(r).setStatus(dec.PostStateOrStatus); err != nil {
+		return err
+	}
+	r.Type = dec.Type
+	r.CumulativeGasUsed = dec.CumulativeGasUsed
+	r.Logs = dec.Logs
+	r.Bloom = CreateBloom(Receipts{(*Receipt)(r)})
+	return nil
+}
+
 // Receipts implements DerivableList for receipts.
 type Receipts []*Receipt
 
@@ -558,3 +558,42 @@ func TestReceiptUnmarshalBinary(t *testing.T) {
 		}
 	})
 }
+
+func TestReceipt69EncodingDecoding(t *testing.T) {
+	t.Parallel()
+	receipt := &Receipt{
+		Type:              DynamicFeeTxType,
+		Status:            ReceiptStatusSuccessful,
+		CumulativeGasUsed: 21000,
+		Logs: []*Log{
+			{
+				Address: libcommon.BytesToAddress([]byte{0x11}),
+				Topics:  []libcommon.Hash{libcommon.HexToHash("dead"), libcommon.HexToHash("beef")},
+				Data:    []byte{0x01, 0x00, 0xff},
+			},
+		},
+	}
+	receipt.Bloom = CreateBloom(Receipts{receipt})
+
+	enc, err := rlp.EncodeToBytes((*Receipt69)(receipt))
+	if err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	consensusEnc, err := rlp.EncodeToBytes(receipt)
+	if err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	assert.Less(t, len(enc), len(consensusEnc), "eth/69 encoding must not contain the bloom")
+
+	var dec Receipt69
+	if err := rlp.DecodeBytes(enc, &dec); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	assert.Equal(t, receipt.Type, dec.Type)
+	assert.Equal(t, receipt.Status, dec.Status)
+	assert.Equal(t, receipt.CumulativeGasUsed, dec.CumulativeGasUsed)
+	assert.Equal(t, receipt.Bloom, dec.Bloom)
+	assert.Equal(t, receipt.Logs[0].Address, dec.Logs[0].Address)
+	assert.Equal(t, receipt.Logs[0].Topics, dec.Logs[0].Topics)
+	assert.Equal(t, receipt.Logs[0].Data, dec.Logs[0].Data)
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/valyala/fastjson"
 
 	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/common/fixedgas"
 	"github.com/erigontech/erigon-lib/common/hexutil"
 )
 
@@ -618,6 +619,9 @@ func UnmarshalBlobTxJSON(input []byte) (Transaction, error) {
 		Blobs:       dec.Blobs,
 		Proofs:      dec.Proofs,
 	}
+	if len(dec.Blobs) != len(dec.Proofs) && len(dec.Blobs)*fixedgas.CellsPerExtBlob == len(dec.Proofs) {
+		btx.WrapperVersion = BlobTxWrapperVersion1
+	}
 	err := btx.ValidateBlobTransactionWrapper()
 	if err != nil {
 		return nil, err
@@ -639,6 +639,41 @@ func (branchData BranchData) MergeHexBranches(branchData2 BranchData, newData []
 	return newData, nil
 }
 
+// BranchCell is a cell of a branch node as it is stored in the commitment domain.
+type BranchCell struct {
+	Extension   []byte // nibbles between the branch and the node the cell points to
+	AccountAddr []byte // plain key of the account the cell holds, if any
+	StorageAddr []byte // plain key of the storage slot the cell holds, if any
+	Hash        []byte
+}
+
+// Cells decodes the cells of a complete branch, as it is read from the commitment domain, by nibble.
+func (branchData BranchData) Cells() (cells [16]*BranchCell, err error) {
+	if len(branchData) < 4 {
+		return cells, fmt.Errorf("branch data too short: %x", []byte(branchData))
+	}
+	afterMap := binary.BigEndian.Uint16(branchData[2:])
+	pos := 4
+	for bitset := afterMap; bitset != 0; bitset &= bitset - 1 {
+		nibble := bits.TrailingZeros16(bitset)
+		if pos >= len(branchData) {
+			return cells, fmt.Errorf("branch data too short for nibble %x: %x", nibble, []byte(branchData))
+		}
+		var c cell
+		fields := cellFields(branchData[pos])
+		if pos, err = c.fillFromFields(branchData, pos+1, fields); err != nil {
+			return cells, fmt.Errorf("failed to decode cell at nibble %x: %w", nibble, err)
+		}
+		cells[nibble] = &BranchCell{
+			Extension:   common.Copy(c.extension[:c.extLen]),
+			AccountAddr: common.Copy(c.accountAddr[:c.accountAddrLen]),
+			StorageAddr: common.Copy(c.storageAddr[:c.storageAddrLen]),
+			Hash:        common.Copy(c.hash[:c.hashLen]),
+		}
+	}
+	return cells, nil
+}
+
 func (branchData BranchData) decodeCells() (touchMap, afterMap uint16, row [16]*cell, err error) {
 	touchMap = binary.BigEndian.Uint16(branchData[0:])
 	afterMap = binary.BigEndian.Uint16(branchData[2:])
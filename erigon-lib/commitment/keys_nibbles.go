@@ -57,6 +57,12 @@ func hexNibblesToCompactBytes(key []byte) []byte {
 	return buf
 }
 
+// BranchKey returns the key under which the branch at the given nibble path is
+// stored in the commitment domain.
+func BranchKey(nibbles []byte) []byte {
+	return hexNibblesToCompactBytes(nibbles)
+}
+
 // hasTerm returns whether a hex nibble key has the terminator flag.
 func hasTerm(s []byte) bool {
 	return len(s) > 0 && s[len(s)-1] == terminatorHexByte
@@ -37,6 +37,9 @@ const (
 	BlobSize                    = FieldElementsPerBlob * 32
 	BlobGasPerBlob       uint64 = 0x20000
 
+	// EIP-7594: PeerDAS
+	CellsPerExtBlob = 128 // a blob extended with erasure coding is split into cells, each with its own KZG proof
+
 	// EIP-7702: set code tx
 	PerEmptyAccountCost = 25000
 	PerAuthBaseCost     = 12500
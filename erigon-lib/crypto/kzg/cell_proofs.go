@@ -0,0 +1,96 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package kzg
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+
+	"github.com/erigontech/erigon-lib/common/fixedgas"
+)
+
+var (
+	goethkzgCtx  *goethkzg.Context
+	initCellsCtx sync.Once
+)
+
+// CellsCtx returns the context to compute and verify EIP-7594 cell proofs, with the same trusted setup as Ctx.
+// It is initialized on first use: it is expensive, and needed only after Osaka.
+func CellsCtx() *goethkzg.Context {
+	initCellsCtx.Do(func() {
+		var err error
+		if trustedSetupFile != "" {
+			file, err := os.ReadFile(trustedSetupFile)
+			if err != nil {
+				panic(fmt.Sprintf("could not read file, err: %v", err))
+			}
+
+			setup := new(goethkzg.JSONTrustedSetup)
+			if err = json.Unmarshal(file, setup); err != nil {
+				panic(fmt.Sprintf("could not unmarshal, err: %v", err))
+			}
+
+			goethkzgCtx, err = goethkzg.NewContext4096(setup)
+			if err != nil {
+				panic(fmt.Sprintf("could not create cells KZG context, err: %v", err))
+			}
+		} else {
+			goethkzgCtx, err = goethkzg.NewContext4096Secure()
+			if err != nil {
+				panic(fmt.Sprintf("could not create cells KZG context, err : %v", err))
+			}
+		}
+	})
+	return goethkzgCtx
+}
+
+// VerifyCellProofBatch verifies the cell proofs of the blobs of an EIP-7594 blob txn wrapper: fixedgas.CellsPerExtBlob
+// proofs per blob, in the order of cells. The cells are computed from the blobs, and all of them are verified at once,
+// see verify_cell_kzg_proof_batch of https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/polynomial-commitments-sampling.md
+func VerifyCellProofBatch(blobs []gokzg4844.BlobRef, commitments []gokzg4844.KZGCommitment, proofs []gokzg4844.KZGProof) error {
+	if len(blobs) != len(commitments) || len(blobs)*fixedgas.CellsPerExtBlob != len(proofs) {
+		return fmt.Errorf("%d blobs, %d commitments and %d cell proofs", len(blobs), len(commitments), len(proofs))
+	}
+	ctx := CellsCtx()
+	cellCommitments := make([]goethkzg.KZGCommitment, 0, len(proofs))
+	cellIndices := make([]uint64, 0, len(proofs))
+	cells := make([]*goethkzg.Cell, 0, len(proofs))
+	for i, blob := range blobs {
+		if len(blob) != len(goethkzg.Blob{}) {
+			return fmt.Errorf("blob %d: size %d", i, len(blob))
+		}
+		blobCells, err := ctx.ComputeCells((*goethkzg.Blob)(blob), 0)
+		if err != nil {
+			return err
+		}
+		for cellIndex, cell := range blobCells {
+			cellCommitments = append(cellCommitments, goethkzg.KZGCommitment(commitments[i]))
+			cellIndices = append(cellIndices, uint64(cellIndex))
+			cells = append(cells, cell)
+		}
+	}
+	cellProofs := make([]goethkzg.KZGProof, len(proofs))
+	for i, proof := range proofs {
+		cellProofs[i] = goethkzg.KZGProof(proof)
+	}
+	return ctx.VerifyCellKZGProofBatch(cellCommitments, cellIndices, cells, cellProofs)
+}
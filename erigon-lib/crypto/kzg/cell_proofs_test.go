@@ -0,0 +1,75 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package kzg
+
+import (
+	"math/rand"
+	"testing"
+
+	goethkzg "github.com/crate-crypto/go-eth-kzg"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/stretchr/testify/require"
+)
+
+func testBlob(seed int64) gokzg4844.BlobRef {
+	rnd := rand.New(rand.NewSource(seed))
+	var blob gokzg4844.Blob
+	for i := 0; i < gokzg4844.ScalarsPerBlob; i++ {
+		// the first byte is left zero, so every field element is below the modulus
+		rnd.Read(blob[i*gokzg4844.SerializedScalarSize+1 : (i+1)*gokzg4844.SerializedScalarSize])
+	}
+	return blob[:]
+}
+
+func cellProofs(t *testing.T, blob gokzg4844.BlobRef) (gokzg4844.KZGCommitment, []gokzg4844.KZGProof) {
+	commitment, err := Ctx().BlobToKZGCommitment(blob, 0)
+	require.NoError(t, err)
+	_, cellProofs, err := CellsCtx().ComputeCellsAndKZGProofs((*goethkzg.Blob)(blob), 0)
+	require.NoError(t, err)
+	proofs := make([]gokzg4844.KZGProof, len(cellProofs))
+	for i, proof := range cellProofs {
+		proofs[i] = gokzg4844.KZGProof(proof)
+	}
+	return commitment, proofs
+}
+
+func TestVerifyCellProofBatch(t *testing.T) {
+	blob, other := testBlob(1), testBlob(2)
+	commitment, proofs := cellProofs(t, blob)
+	otherCommitment, otherProofs := cellProofs(t, other)
+	require.NoError(t, VerifyCellProofBatch([]gokzg4844.BlobRef{blob}, []gokzg4844.KZGCommitment{commitment}, proofs))
+
+	// several blobs, duplicates among them
+	blobs := []gokzg4844.BlobRef{blob, other, blob}
+	commitments := []gokzg4844.KZGCommitment{commitment, otherCommitment, commitment}
+	allProofs := append(append(append([]gokzg4844.KZGProof{}, proofs...), otherProofs...), proofs...)
+	require.NoError(t, VerifyCellProofBatch(blobs, commitments, allProofs))
+
+	// proofs of other cells
+	swapped := append([]gokzg4844.KZGProof{}, proofs...)
+	swapped[0], swapped[1] = swapped[1], swapped[0]
+	require.Error(t, VerifyCellProofBatch([]gokzg4844.BlobRef{blob}, []gokzg4844.KZGCommitment{commitment}, swapped))
+
+	// proofs of another blob
+	require.Error(t, VerifyCellProofBatch([]gokzg4844.BlobRef{blob}, []gokzg4844.KZGCommitment{commitment}, otherProofs))
+
+	// commitment of another blob
+	require.Error(t, VerifyCellProofBatch([]gokzg4844.BlobRef{blob}, []gokzg4844.KZGCommitment{otherCommitment}, proofs))
+
+	// wrong number of proofs
+	require.Error(t, VerifyCellProofBatch([]gokzg4844.BlobRef{blob}, []gokzg4844.KZGCommitment{commitment}, proofs[1:]))
+}
@@ -123,3 +123,59 @@ func (s *TxPoolClient) Nonce(ctx context.Context, in *txpool_proto.NonceRequest,
 func (s *TxPoolClient) GetBlobs(ctx context.Context, in *txpool_proto.GetBlobsRequest, opts ...grpc.CallOption) (*txpool_proto.GetBlobsReply, error) {
 	return s.server.GetBlobs(ctx, in)
 }
+
+func (s *TxPoolClient) Lifecycle(ctx context.Context, in *txpool_proto.LifecycleRequest, opts ...grpc.CallOption) (*txpool_proto.LifecycleReply, error) {
+	return s.server.Lifecycle(ctx, in)
+}
+
+// -- start TxnEvents
+
+func (s *TxPoolClient) TxnEvents(ctx context.Context, in *txpool_proto.TxnEventsRequest, opts ...grpc.CallOption) (txpool_proto.Txpool_TxnEventsClient, error) {
+	ch := make(chan *txnEventsReply, 16384)
+	streamServer := &TxPoolTxnEventsS{ch: ch, ctx: ctx}
+	go func() {
+		defer close(ch)
+		streamServer.Err(s.server.TxnEvents(in, streamServer))
+	}()
+	return &TxPoolTxnEventsC{ch: ch, ctx: ctx}, nil
+}
+
+type txnEventsReply struct {
+	r   *txpool_proto.TxnEventsReply
+	err error
+}
+
+type TxPoolTxnEventsS struct {
+	ch  chan *txnEventsReply
+	ctx context.Context
+	grpc.ServerStream
+}
+
+func (s *TxPoolTxnEventsS) Send(m *txpool_proto.TxnEventsReply) error {
+	s.ch <- &txnEventsReply{r: m}
+	return nil
+}
+func (s *TxPoolTxnEventsS) Context() context.Context { return s.ctx }
+func (s *TxPoolTxnEventsS) Err(err error) {
+	if err == nil {
+		return
+	}
+	s.ch <- &txnEventsReply{err: err}
+}
+
+type TxPoolTxnEventsC struct {
+	ch  chan *txnEventsReply
+	ctx context.Context
+	grpc.ClientStream
+}
+
+func (c *TxPoolTxnEventsC) Recv() (*txpool_proto.TxnEventsReply, error) {
+	m, ok := <-c.ch
+	if !ok || m == nil {
+		return nil, io.EOF
+	}
+	return m.r, m.err
+}
+func (c *TxPoolTxnEventsC) Context() context.Context { return c.ctx }
+
+// -- end TxnEvents
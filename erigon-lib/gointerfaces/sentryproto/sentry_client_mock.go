@@ -483,6 +483,50 @@ func (c *MockSentryClientPenalizePeerCall) DoAndReturn(f func(context.Context, *
 	return c
 }
 
+// ScorePeer mocks base method.
+func (m *MockSentryClient) ScorePeer(ctx context.Context, in *ScorePeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ScorePeer", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScorePeer indicates an expected call of ScorePeer.
+func (mr *MockSentryClientMockRecorder) ScorePeer(ctx, in any, opts ...any) *MockSentryClientScorePeerCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScorePeer", reflect.TypeOf((*MockSentryClient)(nil).ScorePeer), varargs...)
+	return &MockSentryClientScorePeerCall{Call: call}
+}
+
+// MockSentryClientScorePeerCall wrap *gomock.Call
+type MockSentryClientScorePeerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSentryClientScorePeerCall) Return(arg0 *emptypb.Empty, arg1 error) *MockSentryClientScorePeerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSentryClientScorePeerCall) Do(f func(context.Context, *ScorePeerRequest, ...grpc.CallOption) (*emptypb.Empty, error)) *MockSentryClientScorePeerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSentryClientScorePeerCall) DoAndReturn(f func(context.Context, *ScorePeerRequest, ...grpc.CallOption) (*emptypb.Empty, error)) *MockSentryClientScorePeerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SendMessageById mocks base method.
 func (m *MockSentryClient) SendMessageById(ctx context.Context, in *SendMessageByIdRequest, opts ...grpc.CallOption) (*SentPeers, error) {
 	m.ctrl.T.Helper()
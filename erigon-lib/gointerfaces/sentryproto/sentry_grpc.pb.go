@@ -23,6 +23,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	Sentry_SetStatus_FullMethodName                = "/sentry.Sentry/SetStatus"
 	Sentry_PenalizePeer_FullMethodName             = "/sentry.Sentry/PenalizePeer"
+	Sentry_ScorePeer_FullMethodName                = "/sentry.Sentry/ScorePeer"
 	Sentry_PeerMinBlock_FullMethodName             = "/sentry.Sentry/PeerMinBlock"
 	Sentry_HandShake_FullMethodName                = "/sentry.Sentry/HandShake"
 	Sentry_SendMessageByMinBlock_FullMethodName    = "/sentry.Sentry/SendMessageByMinBlock"
@@ -45,6 +46,8 @@ type SentryClient interface {
 	// SetStatus - force new ETH client state of sentry - network_id, max_block, etc...
 	SetStatus(ctx context.Context, in *StatusData, opts ...grpc.CallOption) (*SetStatusReply, error)
 	PenalizePeer(ctx context.Context, in *PenalizePeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ScorePeer - updates reputation of the peer, peers with low reputation are disconnected and not dialed
+	ScorePeer(ctx context.Context, in *ScorePeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	PeerMinBlock(ctx context.Context, in *PeerMinBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// HandShake - pre-requirement for all Send* methods - returns list of ETH protocol versions,
 	// without knowledge of protocol - impossible encode correct P2P message
@@ -95,6 +98,16 @@ func (c *sentryClient) PenalizePeer(ctx context.Context, in *PenalizePeerRequest
 	return out, nil
 }
 
+func (c *sentryClient) ScorePeer(ctx context.Context, in *ScorePeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Sentry_ScorePeer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sentryClient) PeerMinBlock(ctx context.Context, in *PeerMinBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -250,6 +263,8 @@ type SentryServer interface {
 	// SetStatus - force new ETH client state of sentry - network_id, max_block, etc...
 	SetStatus(context.Context, *StatusData) (*SetStatusReply, error)
 	PenalizePeer(context.Context, *PenalizePeerRequest) (*emptypb.Empty, error)
+	// ScorePeer - updates reputation of the peer, peers with low reputation are disconnected and not dialed
+	ScorePeer(context.Context, *ScorePeerRequest) (*emptypb.Empty, error)
 	PeerMinBlock(context.Context, *PeerMinBlockRequest) (*emptypb.Empty, error)
 	// HandShake - pre-requirement for all Send* methods - returns list of ETH protocol versions,
 	// without knowledge of protocol - impossible encode correct P2P message
@@ -286,6 +301,9 @@ func (UnimplementedSentryServer) SetStatus(context.Context, *StatusData) (*SetSt
 func (UnimplementedSentryServer) PenalizePeer(context.Context, *PenalizePeerRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PenalizePeer not implemented")
 }
+func (UnimplementedSentryServer) ScorePeer(context.Context, *ScorePeerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScorePeer not implemented")
+}
 func (UnimplementedSentryServer) PeerMinBlock(context.Context, *PeerMinBlockRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PeerMinBlock not implemented")
 }
@@ -382,6 +400,24 @@ func _Sentry_PenalizePeer_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sentry_ScorePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScorePeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentryServer).ScorePeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sentry_ScorePeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentryServer).ScorePeer(ctx, req.(*ScorePeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Sentry_PeerMinBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PeerMinBlockRequest)
 	if err := dec(in); err != nil {
@@ -617,6 +653,10 @@ var Sentry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PenalizePeer",
 			Handler:    _Sentry_PenalizePeer_Handler,
 		},
+		{
+			MethodName: "ScorePeer",
+			Handler:    _Sentry_ScorePeer_Handler,
+		},
 		{
 			MethodName: "PeerMinBlock",
 			Handler:    _Sentry_PeerMinBlock_Handler,
@@ -431,6 +431,45 @@ func (c *MockSentryServerPenalizePeerCall) DoAndReturn(f func(context.Context, *
 	return c
 }
 
+// ScorePeer mocks base method.
+func (m *MockSentryServer) ScorePeer(arg0 context.Context, arg1 *ScorePeerRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScorePeer", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScorePeer indicates an expected call of ScorePeer.
+func (mr *MockSentryServerMockRecorder) ScorePeer(arg0, arg1 any) *MockSentryServerScorePeerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScorePeer", reflect.TypeOf((*MockSentryServer)(nil).ScorePeer), arg0, arg1)
+	return &MockSentryServerScorePeerCall{Call: call}
+}
+
+// MockSentryServerScorePeerCall wrap *gomock.Call
+type MockSentryServerScorePeerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSentryServerScorePeerCall) Return(arg0 *emptypb.Empty, arg1 error) *MockSentryServerScorePeerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSentryServerScorePeerCall) Do(f func(context.Context, *ScorePeerRequest) (*emptypb.Empty, error)) *MockSentryServerScorePeerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSentryServerScorePeerCall) DoAndReturn(f func(context.Context, *ScorePeerRequest) (*emptypb.Empty, error)) *MockSentryServerScorePeerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SendMessageById mocks base method.
 func (m *MockSentryServer) SendMessageById(arg0 context.Context, arg1 *SendMessageByIdRequest) (*SentPeers, error) {
 	m.ctrl.T.Helper()
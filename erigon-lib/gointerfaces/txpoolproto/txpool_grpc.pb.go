@@ -31,6 +31,8 @@ const (
 	Txpool_Status_FullMethodName       = "/txpool.Txpool/Status"
 	Txpool_Nonce_FullMethodName        = "/txpool.Txpool/Nonce"
 	Txpool_GetBlobs_FullMethodName     = "/txpool.Txpool/GetBlobs"
+	Txpool_Lifecycle_FullMethodName    = "/txpool.Txpool/Lifecycle"
+	Txpool_TxnEvents_FullMethodName    = "/txpool.Txpool/TxnEvents"
 )
 
 // TxpoolClient is the client API for Txpool service.
@@ -58,6 +60,10 @@ type TxpoolClient interface {
 	Nonce(ctx context.Context, in *NonceRequest, opts ...grpc.CallOption) (*NonceReply, error)
 	// returns the list of blobs and proofs for a given list of blob hashes
 	GetBlobs(ctx context.Context, in *GetBlobsRequest, opts ...grpc.CallOption) (*GetBlobsReply, error)
+	// returns lifecycle status of given transactions, or of all journaled local transactions if no hashes given
+	Lifecycle(ctx context.Context, in *LifecycleRequest, opts ...grpc.CallOption) (*LifecycleReply, error)
+	// subscribe to transactions lifecycle events: added, promoted, demoted, replaced, discarded, mined
+	TxnEvents(ctx context.Context, in *TxnEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TxnEventsReply], error)
 }
 
 type txpoolClient struct {
@@ -177,6 +183,35 @@ func (c *txpoolClient) GetBlobs(ctx context.Context, in *GetBlobsRequest, opts .
 	return out, nil
 }
 
+func (c *txpoolClient) Lifecycle(ctx context.Context, in *LifecycleRequest, opts ...grpc.CallOption) (*LifecycleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LifecycleReply)
+	err := c.cc.Invoke(ctx, Txpool_Lifecycle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txpoolClient) TxnEvents(ctx context.Context, in *TxnEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TxnEventsReply], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Txpool_ServiceDesc.Streams[1], Txpool_TxnEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TxnEventsRequest, TxnEventsReply]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Txpool_TxnEventsClient = grpc.ServerStreamingClient[TxnEventsReply]
+
 // TxpoolServer is the server API for Txpool service.
 // All implementations must embed UnimplementedTxpoolServer
 // for forward compatibility.
@@ -202,6 +237,10 @@ type TxpoolServer interface {
 	Nonce(context.Context, *NonceRequest) (*NonceReply, error)
 	// returns the list of blobs and proofs for a given list of blob hashes
 	GetBlobs(context.Context, *GetBlobsRequest) (*GetBlobsReply, error)
+	// returns lifecycle status of given transactions, or of all journaled local transactions if no hashes given
+	Lifecycle(context.Context, *LifecycleRequest) (*LifecycleReply, error)
+	// subscribe to transactions lifecycle events: added, promoted, demoted, replaced, discarded, mined
+	TxnEvents(*TxnEventsRequest, grpc.ServerStreamingServer[TxnEventsReply]) error
 	mustEmbedUnimplementedTxpoolServer()
 }
 
@@ -242,6 +281,12 @@ func (UnimplementedTxpoolServer) Nonce(context.Context, *NonceRequest) (*NonceRe
 func (UnimplementedTxpoolServer) GetBlobs(context.Context, *GetBlobsRequest) (*GetBlobsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlobs not implemented")
 }
+func (UnimplementedTxpoolServer) Lifecycle(context.Context, *LifecycleRequest) (*LifecycleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lifecycle not implemented")
+}
+func (UnimplementedTxpoolServer) TxnEvents(*TxnEventsRequest, grpc.ServerStreamingServer[TxnEventsReply]) error {
+	return status.Errorf(codes.Unimplemented, "method TxnEvents not implemented")
+}
 func (UnimplementedTxpoolServer) mustEmbedUnimplementedTxpoolServer() {}
 func (UnimplementedTxpoolServer) testEmbeddedByValue()                {}
 
@@ -436,6 +481,35 @@ func _Txpool_GetBlobs_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Txpool_Lifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxpoolServer).Lifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Txpool_Lifecycle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxpoolServer).Lifecycle(ctx, req.(*LifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Txpool_TxnEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TxnEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TxpoolServer).TxnEvents(m, &grpc.GenericServerStream[TxnEventsRequest, TxnEventsReply]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Txpool_TxnEventsServer = grpc.ServerStreamingServer[TxnEventsReply]
+
 // Txpool_ServiceDesc is the grpc.ServiceDesc for Txpool service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -479,6 +553,10 @@ var Txpool_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlobs",
 			Handler:    _Txpool_GetBlobs_Handler,
 		},
+		{
+			MethodName: "Lifecycle",
+			Handler:    _Txpool_Lifecycle_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -486,6 +564,11 @@ var Txpool_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Txpool_OnAdd_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "TxnEvents",
+			Handler:       _Txpool_TxnEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "txpool/txpool.proto",
 }
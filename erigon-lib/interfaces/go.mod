@@ -0,0 +1,3 @@
+module github.com/erigontech/interfaces
+
+go 1.18
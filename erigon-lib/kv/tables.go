@@ -137,6 +137,7 @@ const (
 
 	CliqueSeparate     = "CliqueSeparate"
 	CliqueLastSnapshot = "CliqueLastSnapshot"
+	CliqueProposals    = "CliqueProposals" // signer address -> 1 to authorize, 0 to drop
 
 	// Node database tables (see nodedb.go)
 
@@ -166,6 +167,9 @@ const (
 	Epoch        = "DevEpoch"        // block_num_u64+block_hash->transition_proof
 	PendingEpoch = "DevPendingEpoch" // block_num_u64+block_hash->transition_proof
 
+	AuRaValidatorSets = "AuRaValidatorSets" // block_num_u64+block_hash->validator addresses of the epoch which starts with the transition at the block
+	AuRaReports       = "AuRaReports"       // block_num_u64+block_hash+validator_address+kind_u8+reason->empty
+
 	// BOR
 	BorFinality             = "BorFinality"
 	BorTxLookup             = "BlockBorTransactionLookup" // transaction_hash -> block_num_u64
@@ -266,6 +270,12 @@ const (
 
 	HighestFinalized = "HighestFinalized" // hash -> transaction/receipt lookup metadata
 
+	// EIP-4881 finalized deposit tree snapshot
+	DepositSnapshot = "DepositSnapshot"
+
+	// Sync committee period => best LightClientUpdate of the period (version byte + SSZ)
+	LightClientUpdates = "LightClientUpdates"
+
 	// BlockRoot => Beacon Block Header
 	BeaconBlockHeaders = "BeaconBlockHeaders"
 
@@ -318,6 +328,7 @@ var (
 	PlainStateVersion = []byte("PlainStateVersion")
 
 	HighestFinalizedKey = []byte("HighestFinalized")
+	DepositSnapshotKey  = []byte("DepositSnapshot")
 
 	StatesProcessingKey          = []byte("StatesProcessing")
 	MinimumPrunableStepDomainKey = []byte("MinimumPrunableStepDomainKey")
@@ -363,6 +374,8 @@ var ChaindataTables = []string{
 	HeaderTD,
 	Epoch,
 	PendingEpoch,
+	AuRaValidatorSets,
+	AuRaReports,
 	BorFinality,
 	BorTxLookup,
 	BorSeparate,
@@ -425,6 +438,8 @@ var ChaindataTables = []string{
 	BlockRootToParentRoot,
 	BeaconBlockHeaders,
 	HighestFinalized,
+	DepositSnapshot,
+	LightClientUpdates,
 	BlockRootToBlockHash,
 	BlockRootToBlockNumber,
 	LastBeaconSnapshot,
@@ -463,12 +478,16 @@ const (
 	RecentLocalTransaction = "RecentLocalTransaction" // sequence_u64 -> tx_hash
 	PoolTransaction        = "PoolTransaction"        // txHash -> sender+tx_rlp
 	PoolInfo               = "PoolInfo"               // option_key -> option_value
+	PoolLocalJournal       = "PoolLocalJournal"       // txHash -> sender+tx_rlp : local txns retained until mined or replaced
+	PoolPrivateTransaction = "PoolPrivateTransaction" // txHash -> max_block_u64 : txns excluded from gossip
 )
 
 var TxPoolTables = []string{
 	RecentLocalTransaction,
 	PoolTransaction,
 	PoolInfo,
+	PoolLocalJournal,
+	PoolPrivateTransaction,
 }
 var SentryTables = []string{
 	Inodes,
@@ -477,6 +496,7 @@ var SentryTables = []string{
 var ConsensusTables = append([]string{
 	CliqueSeparate,
 	CliqueLastSnapshot,
+	CliqueProposals,
 },
 	ChaindataTables..., //TODO: move bor tables from chaintables to `ConsensusTables`
 )
@@ -582,8 +602,10 @@ var ChaindataTablesCfg = TableCfg{
 }
 
 var AuRaTablesCfg = TableCfg{
-	Epoch:        {},
-	PendingEpoch: {},
+	Epoch:             {},
+	PendingEpoch:      {},
+	AuRaValidatorSets: {},
+	AuRaReports:       {},
 }
 
 var BorTablesCfg = TableCfg{
@@ -85,6 +85,21 @@ func (m *sentryMultiplexer) PenalizePeer(ctx context.Context, in *sentryproto.Pe
 	return &emptypb.Empty{}, g.Wait()
 }
 
+func (m *sentryMultiplexer) ScorePeer(ctx context.Context, in *sentryproto.ScorePeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	g, gctx := errgroup.WithContext(ctx)
+
+	for _, client := range m.clients {
+		client := client
+
+		g.Go(func() error {
+			_, err := client.ScorePeer(gctx, in, opts...)
+			return err
+		})
+	}
+
+	return &emptypb.Empty{}, g.Wait()
+}
+
 func (m *sentryMultiplexer) PeerMinBlock(ctx context.Context, in *sentryproto.PeerMinBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	g, gctx := errgroup.WithContext(ctx)
 
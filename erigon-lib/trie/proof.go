@@ -120,6 +120,69 @@ func (t *Trie) Prove(key []byte, fromLevel int, storage bool) ([][]byte, error)
 	return proof, nil
 }
 
+// EncodedNodeAt returns the RLP encoding of the node located at the given
+// nibble path. When storage is set, the path may continue below an account
+// leaf into the account's storage trie. The boolean result is false if the
+// path does not end on a node boundary of the (partially loaded) trie.
+func (t *Trie) EncodedNodeAt(hex []byte, storage bool) ([]byte, bool, error) {
+	hasher := newHasher(t.valueNodesRLPEncoded)
+	defer returnHasherToPool(hasher)
+	tn := t.RootNode
+	for {
+		if n, ok := tn.(*AccountNode); ok {
+			if !storage {
+				return nil, false, nil
+			}
+			tn = n.Storage
+			continue
+		}
+		if len(hex) == 0 {
+			break
+		}
+		switch n := tn.(type) {
+		case *ShortNode:
+			nKey := n.Key
+			if nKey[len(nKey)-1] == 16 {
+				nKey = nKey[:len(nKey)-1]
+			}
+			if len(hex) < len(nKey) || !bytes.Equal(nKey, hex[:len(nKey)]) {
+				return nil, false, nil
+			}
+			tn = n.Val
+			hex = hex[len(nKey):]
+		case *DuoNode:
+			i1, i2 := n.childrenIdx()
+			switch hex[0] {
+			case i1:
+				tn = n.child1
+			case i2:
+				tn = n.child2
+			default:
+				return nil, false, nil
+			}
+			hex = hex[1:]
+		case *FullNode:
+			tn = n.Children[hex[0]]
+			hex = hex[1:]
+		case HashNode:
+			return nil, false, fmt.Errorf("encountered hashNode unexpectedly, remaining path %x", hex)
+		default:
+			return nil, false, nil
+		}
+	}
+	switch n := tn.(type) {
+	case *ShortNode, *DuoNode, *FullNode:
+		enc, err := hasher.hashChildren(n, 0)
+		if err != nil {
+			return nil, false, err
+		}
+		return libcommon.CopyBytes(enc), true, nil
+	case HashNode:
+		return nil, false, fmt.Errorf("encountered hashNode unexpectedly at path end")
+	}
+	return nil, false, nil
+}
+
 func decodeRef(buf []byte) (Node, []byte, error) {
 	kind, val, rest, err := rlp.Split(buf)
 	if err != nil {
@@ -286,6 +349,49 @@ func verifyProof(root libcommon.Hash, key []byte, proofs map[libcommon.Hash]Node
 	}
 }
 
+// VerifyProof checks a merkle proof for key against root. Unlike the account
+// and storage proof verifiers above, the proof is an unordered set of nodes, as
+// used by range proofs where the nodes of several paths are merged together.
+// A nil value with a nil error proves the absence of key.
+func VerifyProof(root libcommon.Hash, key []byte, proof [][]byte) ([]byte, error) {
+	nodes := make(map[libcommon.Hash]Node, len(proof))
+	for _, enc := range proof {
+		n, err := decodeNode(enc)
+		if err != nil {
+			return nil, err
+		}
+		nodes[crypto.Keccak256Hash(enc)] = n
+	}
+	key = keybytesToHex(key)
+	var node Node = HashNode{hash: root[:]}
+	for {
+		switch nt := node.(type) {
+		case nil:
+			return nil, nil
+		case *FullNode:
+			if len(key) == 0 {
+				return nil, errors.New("full nodes should not have values")
+			}
+			node, key = nt.Children[key[0]], key[1:]
+		case *ShortNode:
+			shortHex := nt.Key
+			if len(shortHex) > len(key) || !bytes.Equal(shortHex, key[:len(shortHex)]) {
+				return nil, nil
+			}
+			node, key = nt.Val, key[len(shortHex):]
+		case HashNode:
+			var ok bool
+			if node, ok = nodes[libcommon.BytesToHash(nt.hash)]; !ok {
+				return nil, fmt.Errorf("missing hash %s", nt)
+			}
+		case ValueNode:
+			return nt, nil
+		default:
+			return nil, fmt.Errorf("unexpected type: %T", node)
+		}
+	}
+}
+
 func VerifyAccountProof(stateRoot libcommon.Hash, proof *accounts.AccProofResult) error {
 	accountKey := crypto.Keccak256Hash(proof.Address[:])
 	return VerifyAccountProofByHash(stateRoot, accountKey, proof)
@@ -99,6 +99,7 @@ import (
 	"github.com/erigontech/erigon/eth/ethconfig"
 	"github.com/erigontech/erigon/eth/ethconsensusconfig"
 	"github.com/erigontech/erigon/eth/protocols/eth"
+	"github.com/erigontech/erigon/eth/protocols/snap"
 	"github.com/erigontech/erigon/eth/stagedsync"
 	"github.com/erigontech/erigon/eth/stagedsync/stages"
 	"github.com/erigontech/erigon/ethdb/privateapi"
@@ -223,6 +224,7 @@ type Ethereum struct {
 	polygonDownloadSync *stagedsync.Sync
 	polygonBridge       *bridge.Service
 	heimdallService     *heimdall.Service
+	heimdallHttpServer  *heimdall.HttpServer
 	stopNode            func() error
 	bgComponentsEg      errgroup.Group
 }
@@ -472,6 +474,9 @@ func New(ctx context.Context, stack *node.Node, config *ethconfig.Config, logger
 
 			cfg.ListenAddr = fmt.Sprintf("%s:%d", listenHost, listenPort)
 			server := sentry.NewGrpcServer(backend.sentryCtx, nil, readNodeInfo, &cfg, protocol, logger)
+			if config.SnapServer {
+				server.EnableSnap(snap.NewServer(backend.chainDB, blockReader, logger))
+			}
 			backend.sentryServers = append(backend.sentryServers, server)
 			sentries = append(sentries, direct.NewSentryClientDirect(protocol, server))
 		}
@@ -547,7 +552,7 @@ func New(ctx context.Context, stack *node.Node, config *ethconfig.Config, logger
 
 	if chainConfig.Bor != nil {
 		if !config.WithoutHeimdall {
-			heimdallClient = heimdall.NewHttpClient(config.HeimdallURL, logger)
+			heimdallClient = heimdall.NewClient(config.HeimdallURL, logger)
 		}
 
 		if config.PolygonSync {
@@ -572,6 +577,12 @@ func New(ctx context.Context, stack *node.Node, config *ethconfig.Config, logger
 
 			backend.polygonBridge = polygonBridge
 			backend.heimdallService = heimdallService
+
+			if config.HeimdallServeAddr != "" {
+				backend.heimdallHttpServer = heimdall.NewHttpServer(config.HeimdallServeAddr, heimdallStore, bridgeStore, heimdallClient, logger)
+			}
+		} else if config.HeimdallServeAddr != "" {
+			logger.Warn("Heimdall data is served only with polygon sync, not serving it", "addr", config.HeimdallServeAddr)
 		}
 
 		flags.Milestone = config.WithHeimdallMilestones
@@ -606,6 +617,7 @@ func New(ctx context.Context, stack *node.Node, config *ethconfig.Config, logger
 
 	statusDataProvider := sentry.NewStatusDataProvider(
 		backend.chainDB,
+		backend.blockReader,
 		chainConfig,
 		genesis,
 		backend.config.NetworkID,
@@ -1042,6 +1054,15 @@ func New(ctx context.Context, stack *node.Node, config *ethconfig.Config, logger
 	}
 
 	if chainConfig.Bor != nil && config.PolygonSync {
+		var blockProducerConfig *polygonsync.BlockProducerConfig
+		if config.Miner.Enabled {
+			blockProducerConfig = &polygonsync.BlockProducerConfig{
+				Signer:  config.Miner.Etherbase,
+				Builder: polygonsync.NewBlockBuilder(logger, chainConfig, backend.engine, backend.chainDB, blockReader, txnProvider, &config.Miner, tmpdir),
+				Output:  backend.minedBlocks,
+			}
+		}
+
 		backend.polygonSyncService = polygonsync.NewService(
 			config,
 			logger,
@@ -1056,6 +1077,7 @@ func New(ctx context.Context, stack *node.Node, config *ethconfig.Config, logger
 			backend.notifications,
 			backend.engineBackendRPC,
 			backend,
+			blockProducerConfig,
 		)
 
 		// we need to initiate download before the heimdall services start rather than
@@ -1205,14 +1227,21 @@ func (s *Ethereum) Etherbase() (eb libcommon.Address, err error) {
 // and updates the minimum price required by the transaction pool.
 func (s *Ethereum) StartMining(ctx context.Context, db kv.RwDB, stateDiffClient *direct.StateDiffClientDirect, mining *stagedsync.Sync, miner stagedsync.MiningState, gasPrice *uint256.Int, quitCh chan struct{}, heimdallStore heimdall.Store, tmpDir string, logger log.Logger) error {
 
+	// with polygon sync the header downloader is not used, so its progress must not stop sealing
+	astridEnabled := s.chainConfig.Bor != nil && s.config.PolygonSync
+
 	var borcfg *bor.Bor
 	if b, ok := s.engine.(*bor.Bor); ok {
 		borcfg = b
-		b.HeaderProgress(s.sentriesClient.Hd)
+		if !astridEnabled {
+			b.HeaderProgress(s.sentriesClient.Hd)
+		}
 	} else if br, ok := s.engine.(*merge.Merge); ok {
 		if b, ok := br.InnerEngine().(*bor.Bor); ok {
 			borcfg = b
-			b.HeaderProgress(s.sentriesClient.Hd)
+			if !astridEnabled {
+				b.HeaderProgress(s.sentriesClient.Hd)
+			}
 		}
 	}
 
@@ -1240,6 +1269,11 @@ func (s *Ethereum) StartMining(ctx context.Context, db kv.RwDB, stateDiffClient
 				return crypto.Sign(crypto.Keccak256(message), miner.MiningConfig.SigKey)
 			})
 
+			if astridEnabled {
+				// blocks are produced by the polygon sync service, which also fetches the spans
+				return nil
+			}
+
 			if !s.config.WithoutHeimdall {
 				err := stagedsync.FetchSpanZeroForMiningIfNeeded(
 					ctx,
@@ -1635,6 +1669,25 @@ func (s *Ethereum) Start() error {
 
 			return err
 		})
+
+		if s.heimdallHttpServer != nil {
+			s.bgComponentsEg.Go(func() error {
+				defer s.logger.Info("heimdall http server goroutine terminated")
+				// the stores are opened by the polygon services
+				ctx := s.sentryCtx
+				err := <-s.heimdallService.Ready(ctx)
+				if err == nil {
+					err = <-s.polygonBridge.Ready(ctx)
+				}
+				if err == nil {
+					err = s.heimdallHttpServer.Run(ctx)
+				}
+				if err != nil && !errors.Is(err, context.Canceled) {
+					s.logger.Error("heimdall http server error", "err", err)
+				}
+				return err
+			})
+		}
 	} else {
 		diagnostics.Send(diagnostics.SyncStageList{StagesList: diagnostics.InitStagesFromList(s.stagedSync.StagesIdsList())})
 		go stages2.StageLoop(s.sentryCtx, s.chainDB, s.stagedSync, s.sentriesClient.Hd, s.waitForStageLoopStop, s.config.Sync.LoopThrottle, s.logger, s.blockReader, hook)
@@ -1751,6 +1804,10 @@ func (s *Ethereum) ChainConfig() *chain.Config {
 	return s.chainConfig
 }
 
+func (s *Ethereum) Engine() consensus.Engine {
+	return s.engine
+}
+
 func (s *Ethereum) StagedSync() *stagedsync.Sync {
 	return s.stagedSync
 }
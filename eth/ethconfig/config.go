@@ -226,8 +226,13 @@ type Config struct {
 
 	StateStream bool
 
+	// SnapServer advertises the snap/1 protocol and serves the latest state to peers
+	SnapServer bool
+
 	// URL to connect to Heimdall node
 	HeimdallURL string
+	// Address to serve the Heimdall API from the local stores on
+	HeimdallServeAddr string
 	// No heimdall service
 	WithoutHeimdall bool
 	// Heimdall services active
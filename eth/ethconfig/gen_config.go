@@ -46,7 +46,9 @@ func (c Config) MarshalTOML() (interface{}, error) {
 		RPCGasCap                           uint64  `toml:",omitempty"`
 		RPCTxFeeCap                         float64 `toml:",omitempty"`
 		StateStream                         bool
+		SnapServer                          bool
 		HeimdallURL                         string
+		HeimdallServeAddr                   string
 		WithoutHeimdall                     bool
 		WithHeimdallMilestones              bool
 		WithHeimdallWaypointRecording       bool
@@ -94,7 +96,9 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.RPCGasCap = c.RPCGasCap
 	enc.RPCTxFeeCap = c.RPCTxFeeCap
 	enc.StateStream = c.StateStream
+	enc.SnapServer = c.SnapServer
 	enc.HeimdallURL = c.HeimdallURL
+	enc.HeimdallServeAddr = c.HeimdallServeAddr
 	enc.WithoutHeimdall = c.WithoutHeimdall
 	enc.WithHeimdallMilestones = c.WithHeimdallMilestones
 	enc.WithHeimdallWaypointRecording = c.WithHeimdallWaypointRecording
@@ -146,7 +150,9 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		RPCGasCap                           *uint64  `toml:",omitempty"`
 		RPCTxFeeCap                         *float64 `toml:",omitempty"`
 		StateStream                         *bool
+		SnapServer                          *bool
 		HeimdallURL                         *string
+		HeimdallServeAddr                   *string
 		WithoutHeimdall                     *bool
 		WithHeimdallMilestones              *bool
 		WithHeimdallWaypointRecording       *bool
@@ -243,9 +249,15 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.StateStream != nil {
 		c.StateStream = *dec.StateStream
 	}
+	if dec.SnapServer != nil {
+		c.SnapServer = *dec.SnapServer
+	}
 	if dec.HeimdallURL != nil {
 		c.HeimdallURL = *dec.HeimdallURL
 	}
+	if dec.HeimdallServeAddr != nil {
+		c.HeimdallServeAddr = *dec.HeimdallServeAddr
+	}
 	if dec.WithoutHeimdall != nil {
 		c.WithoutHeimdall = *dec.WithoutHeimdall
 	}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/erigontech/erigon-lib/rlp"
 	"github.com/erigontech/erigon/core/forkid"
+	"github.com/erigontech/erigon/p2p/enode"
 	"github.com/erigontech/erigon/p2p/enr"
 )
 
@@ -59,3 +60,16 @@ func LoadENRForkID(r *enr.Record) (*forkid.ID, error) {
 	}
 	return &entry.ForkID, nil
 }
+
+// NewNodeFilter returns a filter of dial candidates, which rejects nodes that don't advertise
+// the `eth` entry, or advertise a fork ID incompatible with the current chain.
+func NewNodeFilter(forkFilter func() forkid.Filter) func(*enode.Node) bool {
+	return func(n *enode.Node) bool {
+		forkID, err := LoadENRForkID(n.Record())
+		if err != nil || forkID == nil {
+			return false
+		}
+		filter := forkFilter()
+		return filter == nil || filter(*forkID) == nil
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package eth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon/core/forkid"
+	"github.com/erigontech/erigon/p2p/enode"
+	"github.com/erigontech/erigon/p2p/enr"
+)
+
+func TestNodeFilter(t *testing.T) {
+	heightForks := []uint64{100, 200}
+	genesis := libcommon.HexToHash("0x01")
+	otherGenesis := libcommon.HexToHash("0x02")
+
+	node := func(entry enr.Entry) *enode.Node {
+		var r enr.Record
+		if entry != nil {
+			r.Set(entry)
+		}
+		return enode.SignNull(&r, enode.ID{1})
+	}
+
+	var forkFilter forkid.Filter
+	filter := NewNodeFilter(func() forkid.Filter { return forkFilter })
+	assert.True(t, filter(node(CurrentENREntryFromForks(heightForks, nil, otherGenesis, 150, 0))), "no filter until status is known")
+	assert.False(t, filter(node(nil)), "nodes without eth entry are rejected")
+
+	forkFilter = forkid.NewFilterFromForks(heightForks, nil, genesis, 150, 0)
+	assert.False(t, filter(node(nil)), "nodes without eth entry are rejected")
+	assert.True(t, filter(node(CurrentENREntryFromForks(heightForks, nil, genesis, 150, 0))))
+	assert.True(t, filter(node(CurrentENREntryFromForks(heightForks, nil, genesis, 250, 0))), "remote is ahead")
+	assert.False(t, filter(node(CurrentENREntryFromForks(heightForks, nil, otherGenesis, 150, 0))))
+}
@@ -170,7 +170,7 @@ type cachedReceipts struct {
 	PendingIndex    int // index of the first not-found receipt in the query
 }
 
-func AnswerGetReceiptsQueryCacheOnly(ctx context.Context, receiptsGetter ReceiptsGetter, query GetReceiptsPacket) (*cachedReceipts, bool, error) {
+func AnswerGetReceiptsQueryCacheOnly(ctx context.Context, receiptsGetter ReceiptsGetter, query GetReceiptsPacket, version uint) (*cachedReceipts, bool, error) {
 	var (
 		bytes        int
 		receiptsList []rlp.RawValue
@@ -185,7 +185,7 @@ func AnswerGetReceiptsQueryCacheOnly(ctx context.Context, receiptsGetter Receipt
 			break
 		}
 		if receipts, ok := receiptsGetter.GetCachedReceipts(ctx, hash); ok {
-			if encoded, err := rlp.EncodeToBytes(receipts); err != nil {
+			if encoded, err := EncodeReceipts(receipts, version); err != nil {
 				return nil, needMore, fmt.Errorf("failed to encode receipt: %w", err)
 			} else {
 				receiptsList = append(receiptsList, encoded)
@@ -206,7 +206,7 @@ func AnswerGetReceiptsQueryCacheOnly(ctx context.Context, receiptsGetter Receipt
 	}, needMore, nil
 }
 
-func AnswerGetReceiptsQuery(ctx context.Context, cfg *chain.Config, receiptsGetter ReceiptsGetter, br services.FullBlockReader, db kv.TemporalTx, query GetReceiptsPacket, cachedReceipts *cachedReceipts) ([]rlp.RawValue, error) { //nolint:unparam
+func AnswerGetReceiptsQuery(ctx context.Context, cfg *chain.Config, receiptsGetter ReceiptsGetter, br services.FullBlockReader, db kv.TemporalTx, query GetReceiptsPacket, cachedReceipts *cachedReceipts, version uint) ([]rlp.RawValue, error) { //nolint:unparam
 	// Gather state data until the fetch or network limits is reached
 	var (
 		bytes        int
@@ -260,7 +260,7 @@ func AnswerGetReceiptsQuery(ctx context.Context, cfg *chain.Config, receiptsGett
 		//}
 
 		// If known, encode and queue for response packet
-		if encoded, err := rlp.EncodeToBytes(results); err != nil {
+		if encoded, err := EncodeReceipts(results, version); err != nil {
 			return nil, fmt.Errorf("failed to encode receipt: %w", err)
 		} else {
 			receipts = append(receipts, encoded)
@@ -232,3 +232,54 @@ func TestEth66Messages(t *testing.T) {
 		}
 	}
 }
+
+func TestEth69ReceiptsRoundTrip(t *testing.T) {
+	receipts := types.Receipts{
+		{
+			Type:              types.LegacyTxType,
+			Status:            types.ReceiptStatusFailed,
+			CumulativeGasUsed: 1,
+			Logs: []*types.Log{
+				{Address: common.BytesToAddress([]byte{0x11}), Topics: []common.Hash{{0x22}}, Data: []byte{0x01, 0x00, 0xff}},
+			},
+		},
+		{
+			Type:              types.DynamicFeeTxType,
+			Status:            types.ReceiptStatusSuccessful,
+			CumulativeGasUsed: 2,
+			Logs:              []*types.Log{},
+		},
+	}
+	for _, receipt := range receipts {
+		receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+	}
+
+	encoded, err := EncodeReceipts(receipts, 69)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := rlp.EncodeToBytes(&ReceiptsRLPPacket66{RequestId: 1111, ReceiptsRLPPacket: ReceiptsRLPPacket{encoded}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var packet ReceiptsPacket69
+	if err := rlp.DecodeBytes(data, &packet); err != nil {
+		t.Fatal(err)
+	}
+	if packet.RequestId != 1111 || len(packet.Receipts) != 1 || len(packet.Receipts[0]) != len(receipts) {
+		t.Fatalf("unexpected packet: %+v", packet)
+	}
+	for i, receipt := range packet.Receipts[0] {
+		if receipt.Type != receipts[i].Type || receipt.Status != receipts[i].Status || receipt.CumulativeGasUsed != receipts[i].CumulativeGasUsed {
+			t.Errorf("receipt %d: consensus fields mismatch", i)
+		}
+		if receipt.Bloom != receipts[i].Bloom {
+			t.Errorf("receipt %d: bloom was not re-computed", i)
+		}
+	}
+
+	if err := rlp.DecodeBytes([]byte{0xc2, 0x01, 0x05}, &packet); err == nil {
+		t.Error("expected a malformed eth/69 receipts packet to fail decoding")
+	}
+}
@@ -0,0 +1,756 @@
+// Copyright 2020 The go-ethereum Authors
+// (original work)
+// Copyright 2024 The Erigon Authors
+// (modifications)
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package snap
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/common/length"
+	"github.com/erigontech/erigon-lib/crypto"
+	"github.com/erigontech/erigon-lib/kv"
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon-lib/rlp"
+	"github.com/erigontech/erigon-lib/state"
+	"github.com/erigontech/erigon-lib/trie"
+	"github.com/erigontech/erigon-lib/types/accounts"
+	"github.com/erigontech/erigon/eth/stagedsync/stages"
+	"github.com/erigontech/erigon/p2p"
+	"github.com/erigontech/erigon/turbo/services"
+)
+
+const (
+	// softResponseLimit is the maximum number of bytes to deliver in a response.
+	softResponseLimit = 2 * 1024 * 1024
+
+	// maxCodeLookups is the maximum number of bytecodes to serve. This number is
+	// there to limit the number of disk lookups.
+	maxCodeLookups = 1024
+
+	// maxTrieNodeLookups is the maximum number of state trie nodes to serve. This
+	// number is there to limit the number of disk lookups.
+	maxTrieNodeLookups = 1024
+
+	// stateLookupSlack defines the ratio by how much a state response can exceed
+	// the requested limit in order to try and avoid breaking up contracts into
+	// multiple packages and proving them.
+	stateLookupSlack = 0.1
+
+	// maxBranchLookups is the maximum number of commitment trie branches read
+	// to serve a single request. Once it is reached, the response is cut short.
+	maxBranchLookups = 16384
+
+	// codeOwnersLimit is the number of code hashes whose owning accounts are
+	// remembered to serve bytecode requests.
+	codeOwnersLimit = 1 << 16
+
+	// maxCodeScan is the maximum number of codes read from the Code domain to
+	// look up the code hashes of a single request by. Once it is reached, the
+	// codes not found yet are left out of the response.
+	maxCodeScan = 4096
+)
+
+var maxHash = libcommon.HexToHash("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+
+// Server answers snap/1 requests from the latest state kept in the Accounts,
+// Storage and Code domains, with merkle proofs taken from the commitment domain.
+//
+// The domains are keyed by plain addresses and slots while snap ranges are
+// ordered by their hashes, so ranges are found by walking the commitment trie,
+// which is keyed by hashes and holds the plain keys in its leaves. The number
+// of branches read per request is capped. Requests for any state root other
+// than the latest committed one are answered with an empty response, which the
+// protocol defines as "state not available".
+type Server struct {
+	db          kv.TemporalRoDB
+	blockReader services.HeaderReader
+	logger      log.Logger
+
+	// The Code domain is keyed by address. The owners of the code hashes of
+	// accounts recently served in account ranges are remembered, other code
+	// hashes are looked up by walking the domain, starting where the previous
+	// walk stopped so that consecutive walks cover all of it
+	codeOwners   *lru.Cache[libcommon.Hash, libcommon.Address]
+	codeScanMu   sync.Mutex
+	codeScanFrom []byte
+}
+
+func NewServer(db kv.TemporalRoDB, blockReader services.HeaderReader, logger log.Logger) *Server {
+	codeOwners, err := lru.New[libcommon.Hash, libcommon.Address](codeOwnersLimit)
+	if err != nil {
+		panic(err)
+	}
+	return &Server{db: db, blockReader: blockReader, logger: logger, codeOwners: codeOwners}
+}
+
+// HandleMessage answers a single snap request read from a peer. A non-nil error
+// means the peer misbehaved and should be disconnected; failures to read the
+// local state only result in an empty response.
+func (s *Server) HandleMessage(ctx context.Context, msg p2p.Msg, w p2p.MsgWriter) error {
+	if msg.Size > ProtocolMaxMsgSize {
+		return fmt.Errorf("%w: %v > %v", errMsgTooLarge, msg.Size, ProtocolMaxMsgSize)
+	}
+	defer msg.Discard()
+
+	switch msg.Code {
+	case GetAccountRangeMsg:
+		var req GetAccountRangePacket
+		if err := msg.Decode(&req); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+		accounts, proof, err := s.ServiceGetAccountRangeQuery(ctx, &req)
+		if err != nil {
+			s.logger.Debug("[snap] failed to serve account range", "root", req.Root, "origin", req.Origin, "err", err)
+			accounts, proof = nil, nil
+		}
+		return p2p.Send(w, AccountRangeMsg, &AccountRangePacket{ID: req.ID, Accounts: accounts, Proof: proof})
+	case GetStorageRangesMsg:
+		var req GetStorageRangesPacket
+		if err := msg.Decode(&req); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+		slots, proof, err := s.ServiceGetStorageRangesQuery(ctx, &req)
+		if err != nil {
+			s.logger.Debug("[snap] failed to serve storage ranges", "root", req.Root, "accounts", len(req.Accounts), "err", err)
+			slots, proof = nil, nil
+		}
+		return p2p.Send(w, StorageRangesMsg, &StorageRangesPacket{ID: req.ID, Slots: slots, Proof: proof})
+	case GetByteCodesMsg:
+		var req GetByteCodesPacket
+		if err := msg.Decode(&req); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+		codes, err := s.ServiceGetByteCodesQuery(ctx, &req)
+		if err != nil {
+			s.logger.Debug("[snap] failed to serve bytecodes", "hashes", len(req.Hashes), "err", err)
+			codes = nil
+		}
+		return p2p.Send(w, ByteCodesMsg, &ByteCodesPacket{ID: req.ID, Codes: codes})
+	case GetTrieNodesMsg:
+		var req GetTrieNodesPacket
+		if err := msg.Decode(&req); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+		nodes, err := s.ServiceGetTrieNodesQuery(ctx, &req)
+		if err != nil {
+			s.logger.Debug("[snap] failed to serve trie nodes", "root", req.Root, "paths", len(req.Paths), "err", err)
+			nodes = nil
+		}
+		return p2p.Send(w, TrieNodesMsg, &TrieNodesPacket{ID: req.ID, Nodes: nodes})
+	case AccountRangeMsg, StorageRangesMsg, ByteCodesMsg, TrieNodesMsg:
+		// We never send snap requests, so responses are unsolicited
+		return fmt.Errorf("%w: unsolicited response %v", errInvalidMsgCode, msg.Code)
+	default:
+		return fmt.Errorf("%w: %v", errInvalidMsgCode, msg.Code)
+	}
+}
+
+// ServiceGetAccountRangeQuery assembles the response to an account range query.
+func (s *Server) ServiceGetAccountRangeQuery(ctx context.Context, req *GetAccountRangePacket) ([]*AccountData, [][]byte, error) {
+	if req.Bytes > softResponseLimit {
+		req.Bytes = softResponseLimit
+	}
+	tx, err := s.db.BeginTemporalRo(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	domains, err := s.openState(ctx, tx, req.Root)
+	if err != nil || domains == nil {
+		return nil, nil, err
+	}
+	defer domains.Close()
+
+	type accountEntry struct {
+		hash       libcommon.Hash
+		key        []byte
+		account    accounts.Account
+		hasStorage bool
+	}
+	var (
+		entries []accountEntry
+		size    uint64
+	)
+	sdCtx := domains.GetCommitmentContext()
+	pred, err := newAccountTrie(newBranchReader(domains)).forEach(req.Origin, func(leaf *trieLeaf) (bool, error) {
+		v, _, err := domains.GetLatest(kv.AccountsDomain, leaf.key)
+		if err != nil {
+			return false, err
+		}
+		var acc accounts.Account
+		if err := accounts.DeserialiseV3(&acc, v); err != nil {
+			return false, fmt.Errorf("decoding account %x: %w", leaf.key, err)
+		}
+		// The storage root is only known once the witness is generated, so size
+		// the body as if it was present whenever the account has storage
+		root := trie.EmptyRoot
+		if leaf.hasStorage() {
+			root = libcommon.Hash{}
+			sdCtx.TouchKey(kv.AccountsDomain, string(leaf.key), nil)
+		}
+		body, err := EncodeSlimAccount(acc.Nonce, &acc.Balance, root, acc.CodeHash)
+		if err != nil {
+			return false, err
+		}
+		if !acc.IsEmptyCodeHash() {
+			s.codeOwners.Add(acc.CodeHash, libcommon.BytesToAddress(leaf.key))
+		}
+		size += uint64(length.Hash + len(body))
+		entries = append(entries, accountEntry{hash: leaf.hash, key: leaf.key, account: acc, hasStorage: leaf.hasStorage()})
+
+		return bytes.Compare(leaf.hash[:], req.Limit[:]) < 0 && size <= req.Bytes, nil
+	})
+	if err != nil && !errors.Is(err, errLookupLimit) {
+		return nil, nil, err
+	}
+	// Expand the paths of the range edges and of the account preceding the
+	// origin, so that the origin and the last account can be proven
+	if len(entries) > 0 {
+		sdCtx.TouchKey(kv.AccountsDomain, string(entries[0].key), nil)
+		sdCtx.TouchKey(kv.AccountsDomain, string(entries[len(entries)-1].key), nil)
+	}
+	if pred != nil {
+		sdCtx.TouchKey(kv.AccountsDomain, string(pred.key), nil)
+	}
+	if len(entries) == 0 && pred == nil {
+		// Empty state, nothing to prove
+		return nil, nil, nil
+	}
+
+	proofTrie, _, err := sdCtx.Witness(ctx, req.Root[:], "snap")
+	if err != nil {
+		return nil, nil, err
+	}
+	accs := make([]*AccountData, 0, len(entries))
+	for _, e := range entries {
+		root := trie.EmptyRoot
+		if e.hasStorage {
+			acc, ok := proofTrie.GetAccount(e.hash[:])
+			if !ok || acc == nil {
+				return nil, nil, fmt.Errorf("account %x missing from witness", e.hash)
+			}
+			root = acc.Root
+		}
+		body, err := EncodeSlimAccount(e.account.Nonce, &e.account.Balance, root, e.account.CodeHash)
+		if err != nil {
+			return nil, nil, err
+		}
+		accs = append(accs, &AccountData{Hash: e.hash, Body: body})
+	}
+
+	// Prove the origin and the last returned account. The witness contains the
+	// paths of both neighbours of the origin, which covers the origin path too.
+	var proof proofSet
+	if err := proof.add(proofTrie.Prove(req.Origin[:], 0, false)); err != nil {
+		return nil, nil, err
+	}
+	if len(accs) > 0 {
+		if err := proof.add(proofTrie.Prove(accs[len(accs)-1].Hash[:], 0, false)); err != nil {
+			return nil, nil, err
+		}
+	}
+	return accs, proof.nodes, nil
+}
+
+// ServiceGetStorageRangesQuery assembles the response to a storage ranges query.
+func (s *Server) ServiceGetStorageRangesQuery(ctx context.Context, req *GetStorageRangesPacket) ([][]*StorageData, [][]byte, error) {
+	if req.Bytes > softResponseLimit {
+		req.Bytes = softResponseLimit
+	}
+	tx, err := s.db.BeginTemporalRo(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	domains, err := s.openState(ctx, tx, req.Root)
+	if err != nil || domains == nil {
+		return nil, nil, err
+	}
+	defer domains.Close()
+
+	accountTrie := newAccountTrie(newBranchReader(domains))
+
+	// Calculate the hard limit at which to abort, even if mid storage trie
+	hardLimit := uint64(float64(req.Bytes) * (1 + stateLookupSlack))
+
+	var (
+		slots [][]*StorageData
+		proof proofSet
+		size  uint64
+	)
+	for _, accHash := range req.Accounts {
+		// If we've exceeded the requested data limit, abort without opening
+		// a new storage range (that we'd need to prove due to exceeded size)
+		if size >= req.Bytes {
+			break
+		}
+		// The first account might start from a different origin and end sooner
+		var origin libcommon.Hash
+		if len(req.Origin) > 0 {
+			origin, req.Origin = libcommon.BytesToHash(req.Origin), nil
+		}
+		limit := maxHash
+		if len(req.Limit) > 0 {
+			limit, req.Limit = libcommon.BytesToHash(req.Limit), nil
+		}
+		acc, err := accountTrie.find(accHash)
+		if errors.Is(err, errLookupLimit) {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if acc == nil {
+			// Unknown account, the requested state is not available to us
+			break
+		}
+
+		var (
+			storage []*StorageData
+			keys    [][]byte
+			pred    *trieLeaf
+			abort   bool
+		)
+		if storageTrie := accountTrie.storageTrie(acc); storageTrie != nil {
+			pred, err = storageTrie.forEach(origin, func(leaf *trieLeaf) (bool, error) {
+				if size >= hardLimit {
+					abort = true
+					return false, nil
+				}
+				v, _, err := domains.GetLatest(kv.StorageDomain, leaf.key)
+				if err != nil {
+					return false, err
+				}
+				body, err := encodeStorageValue(v)
+				if err != nil {
+					return false, err
+				}
+				size += uint64(length.Hash + len(body))
+				storage = append(storage, &StorageData{Hash: leaf.hash, Body: body})
+				keys = append(keys, leaf.key)
+				return bytes.Compare(leaf.hash[:], limit[:]) < 0, nil
+			})
+			if errors.Is(err, errLookupLimit) {
+				abort = true
+			} else if err != nil {
+				return nil, nil, err
+			}
+		}
+		if len(storage) > 0 {
+			slots = append(slots, storage)
+		}
+		// If the slot range is either partial or starts from a non-zero origin,
+		// it needs to be proven, after which the request is considered served
+		if origin != (libcommon.Hash{}) || (abort && len(storage) > 0) {
+			sdCtx := domains.GetCommitmentContext()
+			sdCtx.TouchKey(kv.AccountsDomain, string(acc.key), nil)
+			if pred != nil {
+				sdCtx.TouchKey(kv.StorageDomain, string(pred.key), nil)
+			}
+			if len(keys) > 0 {
+				sdCtx.TouchKey(kv.StorageDomain, string(keys[0]), nil)
+				sdCtx.TouchKey(kv.StorageDomain, string(keys[len(keys)-1]), nil)
+			}
+			proofTrie, _, err := sdCtx.Witness(ctx, req.Root[:], "snap")
+			if err != nil {
+				return nil, nil, err
+			}
+			accountProof, err := proofTrie.Prove(accHash[:], 0, false)
+			if err != nil {
+				return nil, nil, err
+			}
+			if err := proof.add(proofTrie.Prove(append(accHash[:], origin[:]...), len(accountProof), true)); err != nil {
+				return nil, nil, err
+			}
+			if len(storage) > 0 {
+				last := storage[len(storage)-1].Hash
+				if err := proof.add(proofTrie.Prove(append(accHash[:], last[:]...), len(accountProof), true)); err != nil {
+					return nil, nil, err
+				}
+			}
+			break
+		}
+		if abort {
+			break
+		}
+	}
+	return slots, proof.nodes, nil
+}
+
+// ServiceGetByteCodesQuery assembles the response to a byte codes query.
+func (s *Server) ServiceGetByteCodesQuery(ctx context.Context, req *GetByteCodesPacket) ([][]byte, error) {
+	if req.Bytes > softResponseLimit {
+		req.Bytes = softResponseLimit
+	}
+	hashes := req.Hashes
+	if len(hashes) > maxCodeLookups {
+		hashes = hashes[:maxCodeLookups]
+	}
+	tx, err := s.db.BeginTemporalRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var (
+		found   = make(map[libcommon.Hash][]byte, len(hashes))
+		missing = make(map[libcommon.Hash]struct{})
+	)
+	for _, h := range hashes {
+		if h == trie.EmptyCodeHash {
+			continue
+		}
+		if owner, ok := s.codeOwners.Get(h); ok {
+			code, _, err := tx.GetLatest(kv.CodeDomain, owner[:])
+			if err != nil {
+				return nil, err
+			}
+			if len(code) > 0 && crypto.Keccak256Hash(code) == h {
+				found[h] = libcommon.Copy(code)
+				continue
+			}
+			// The account was changed since it was served
+			s.codeOwners.Remove(h)
+		}
+		missing[h] = struct{}{}
+	}
+	if len(missing) > 0 {
+		if err := s.findCodes(tx, missing, found); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		codes [][]byte
+		bytes uint64
+	)
+	for _, h := range hashes {
+		if h == trie.EmptyCodeHash {
+			// Peers should not request the empty code, but if they do, at
+			// least sent them back a correct response without db lookups
+			codes = append(codes, []byte{})
+			continue
+		}
+		code, ok := found[h]
+		if !ok {
+			continue
+		}
+		codes = append(codes, code)
+		bytes += uint64(len(code))
+		if bytes > req.Bytes {
+			break
+		}
+	}
+	return codes, nil
+}
+
+// findCodes looks the missing code hashes up in the Code domain by hashing the
+// codes stored in it. At most maxCodeScan codes are read, continuing from where
+// the previous call stopped. Found codes are moved from missing to found.
+func (s *Server) findCodes(tx kv.TemporalTx, missing map[libcommon.Hash]struct{}, found map[libcommon.Hash][]byte) error {
+	s.codeScanMu.Lock()
+	defer s.codeScanMu.Unlock()
+
+	aggTx := tx.(state.HasAggTx).AggTx().(*state.AggregatorRoTx)
+	from, wrapped := s.codeScanFrom, s.codeScanFrom == nil
+	for scanned := 0; scanned < maxCodeScan && len(missing) > 0; {
+		limit := maxCodeScan - scanned
+		it, err := aggTx.RangeLatest(tx, kv.CodeDomain, from, nil, limit)
+		if err != nil {
+			return err
+		}
+		read := 0
+		for len(missing) > 0 && it.HasNext() {
+			k, v, err := it.Next()
+			if err != nil {
+				it.Close()
+				return err
+			}
+			read++
+			from = append(libcommon.Copy(k), 0)
+			if len(v) == 0 {
+				continue
+			}
+			h := crypto.Keccak256Hash(v)
+			if _, ok := missing[h]; ok {
+				found[h] = libcommon.Copy(v)
+				delete(missing, h)
+				s.codeOwners.Add(h, libcommon.BytesToAddress(k))
+			}
+		}
+		exhausted := read < limit && !it.HasNext()
+		it.Close()
+		scanned += read
+		if exhausted {
+			// The end of the domain is reached, continue from its start
+			// unless it was already walked from there
+			from = nil
+			if wrapped {
+				break
+			}
+			wrapped = true
+		}
+	}
+	s.codeScanFrom = from
+	return nil
+}
+
+// trieNodeLookup is a single node requested by GetTrieNodes, together with a
+// state key underneath it whose witness expands the path to the node.
+type trieNodeLookup struct {
+	accHash libcommon.Hash // Account whose storage trie is addressed, zero for the account trie
+	storage bool
+	path    []byte // Nibble path within the account or storage trie
+	key     []byte // Plain state key underneath the node, nil if not found
+}
+
+// ServiceGetTrieNodesQuery assembles the response to a trie nodes query.
+func (s *Server) ServiceGetTrieNodesQuery(ctx context.Context, req *GetTrieNodesPacket) ([][]byte, error) {
+	if req.Bytes > softResponseLimit {
+		req.Bytes = softResponseLimit
+	}
+	var lookups []*trieNodeLookup
+	for _, pathset := range req.Paths {
+		if len(pathset) == 0 || len(lookups) >= maxTrieNodeLookups {
+			break
+		}
+		if len(pathset) == 1 {
+			lookups = append(lookups, &trieNodeLookup{path: compactToNibbles(pathset[0])})
+			continue
+		}
+		if len(pathset[0]) != length.Hash {
+			break
+		}
+		accHash := libcommon.BytesToHash(pathset[0])
+		for _, path := range pathset[1:] {
+			if len(lookups) >= maxTrieNodeLookups {
+				break
+			}
+			lookups = append(lookups, &trieNodeLookup{accHash: accHash, storage: true, path: compactToNibbles(path)})
+		}
+	}
+	if len(lookups) == 0 {
+		return nil, nil
+	}
+
+	tx, err := s.db.BeginTemporalRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	domains, err := s.openState(ctx, tx, req.Root)
+	if err != nil || domains == nil {
+		return nil, err
+	}
+	defer domains.Close()
+
+	// Find a leaf underneath every requested path, its witness expands the path
+	accountTrie := newAccountTrie(newBranchReader(domains))
+	storageOwners := map[libcommon.Hash]*trieLeaf{}
+	for _, l := range lookups {
+		if !l.storage {
+			leaf, err := accountTrie.first(l.path)
+			if errors.Is(err, errLookupLimit) {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			if leaf != nil {
+				l.key = leaf.key
+			}
+			continue
+		}
+		owner, ok := storageOwners[l.accHash]
+		if !ok {
+			if owner, err = accountTrie.find(l.accHash); err != nil && !errors.Is(err, errLookupLimit) {
+				return nil, err
+			}
+			storageOwners[l.accHash] = owner
+		}
+		if owner == nil {
+			continue
+		}
+		storageTrie := accountTrie.storageTrie(owner)
+		if storageTrie == nil {
+			continue
+		}
+		leaf, err := storageTrie.first(l.path)
+		if errors.Is(err, errLookupLimit) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if leaf != nil {
+			l.key = leaf.key
+		}
+	}
+
+	sdCtx := domains.GetCommitmentContext()
+	touched := false
+	for _, l := range lookups {
+		if l.key == nil {
+			continue
+		}
+		touched = true
+		if l.storage {
+			sdCtx.TouchKey(kv.AccountsDomain, string(storageOwners[l.accHash].key), nil)
+			sdCtx.TouchKey(kv.StorageDomain, string(l.key), nil)
+		} else {
+			sdCtx.TouchKey(kv.AccountsDomain, string(l.key), nil)
+		}
+	}
+	if !touched {
+		return nil, nil
+	}
+	proofTrie, _, err := sdCtx.Witness(ctx, req.Root[:], "snap")
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		nodes [][]byte
+		bytes uint64
+	)
+	for _, l := range lookups {
+		if l.key == nil {
+			break
+		}
+		path := l.path
+		if l.storage {
+			path = append(keybytesToNibbles(l.accHash[:]), l.path...)
+		}
+		node, ok, err := proofTrie.EncodedNodeAt(path, l.storage)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		nodes = append(nodes, node)
+		bytes += uint64(len(node))
+		if bytes > req.Bytes {
+			break
+		}
+	}
+	return nodes, nil
+}
+
+// openState opens the latest state and checks that it matches the requested
+// root. A nil result without an error means the state is not available.
+func (s *Server) openState(ctx context.Context, tx kv.TemporalTx, root libcommon.Hash) (*state.SharedDomains, error) {
+	// Unknown roots are rejected before the state is opened
+	executed, err := stages.GetStageProgress(tx, stages.Execution)
+	if err != nil {
+		return nil, err
+	}
+	header, err := s.blockReader.HeaderByNumber(ctx, tx, executed)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil || header.Root != root {
+		return nil, nil
+	}
+	domains, err := state.NewSharedDomains(tx, s.logger)
+	if err != nil {
+		return nil, err
+	}
+	if domains.BlockNum() != executed {
+		domains.Close()
+		return nil, nil
+	}
+	return domains, nil
+}
+
+// encodeStorageValue encodes a storage domain value the way it is stored in
+// the leaves of the storage trie.
+func encodeStorageValue(v []byte) ([]byte, error) {
+	return rlp.EncodeToBytes(bytes.TrimLeft(v, "\x00"))
+}
+
+// compactToNibbles converts a compact (hex-prefix) encoded trie path into
+// nibbles. The empty path addresses the root.
+func compactToNibbles(compact []byte) []byte {
+	if len(compact) == 0 {
+		return nil
+	}
+	kb := trie.CompactToKeybytes(compact)
+	return kb.ToHex()
+}
+
+func keybytesToNibbles(key []byte) []byte {
+	nibbles := make([]byte, 0, 2*len(key))
+	for _, b := range key {
+		nibbles = append(nibbles, b>>4, b&0x0f)
+	}
+	return nibbles
+}
+
+func hasNibblePrefix(key []byte, nibbles []byte) bool {
+	if len(nibbles) > 2*len(key) {
+		return false
+	}
+	for i, n := range nibbles {
+		b := key[i/2]
+		if i%2 == 0 {
+			b >>= 4
+		} else {
+			b &= 0x0f
+		}
+		if b != n {
+			return false
+		}
+	}
+	return true
+}
+
+// proofSet accumulates the trie nodes of several merkle proofs, without
+// duplicates.
+type proofSet struct {
+	nodes [][]byte
+	seen  map[string]struct{}
+}
+
+func (p *proofSet) add(nodes [][]byte, err error) error {
+	if err != nil {
+		return err
+	}
+	if p.seen == nil {
+		p.seen = map[string]struct{}{}
+	}
+	for _, n := range nodes {
+		if _, ok := p.seen[string(n)]; ok {
+			continue
+		}
+		p.seen[string(n)] = struct{}{}
+		p.nodes = append(p.nodes, n)
+	}
+	return nil
+}
@@ -0,0 +1,311 @@
+// Copyright 2024 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package snap_test
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/crypto"
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon-lib/rlp"
+	"github.com/erigontech/erigon-lib/trie"
+	"github.com/erigontech/erigon-lib/types/accounts"
+	"github.com/erigontech/erigon/core"
+	"github.com/erigontech/erigon/core/types"
+	"github.com/erigontech/erigon/eth/protocols/snap"
+	"github.com/erigontech/erigon/p2p"
+	"github.com/erigontech/erigon/params"
+	"github.com/erigontech/erigon/turbo/stages/mock"
+)
+
+var (
+	testKey, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	testAddr   = crypto.PubkeyToAddress(testKey.PublicKey)
+
+	bigContract   = libcommon.HexToAddress("0x00000000000000000000000000000000000c0de1")
+	smallContract = libcommon.HexToAddress("0x00000000000000000000000000000000000c0de2")
+	bigCode       = []byte{0x60, 0x01, 0x60, 0x00, 0x55, 0x00}
+	smallCode     = []byte{0x60, 0x02, 0x60, 0x00, 0x55, 0x00}
+)
+
+// newTestServer builds a chain on top of a genesis with plenty of accounts and
+// two contracts, and returns a snap server for it together with its state root.
+func newTestServer(t *testing.T) (*snap.Server, libcommon.Hash) {
+	alloc := types.GenesisAlloc{testAddr: {Balance: big.NewInt(1e18)}}
+	for i := 0; i < 100; i++ {
+		addr := libcommon.BytesToAddress(crypto.Keccak256([]byte{byte(i)}))
+		alloc[addr] = types.GenesisAccount{Balance: big.NewInt(int64(i + 1)), Nonce: uint64(i)}
+	}
+	bigStorage := map[libcommon.Hash]libcommon.Hash{}
+	for i := 1; i <= 60; i++ {
+		bigStorage[libcommon.BigToHash(big.NewInt(int64(i)))] = libcommon.BigToHash(big.NewInt(int64(i * 1000)))
+	}
+	alloc[bigContract] = types.GenesisAccount{Balance: big.NewInt(1), Code: bigCode, Storage: bigStorage}
+	alloc[smallContract] = types.GenesisAccount{Balance: big.NewInt(2), Code: smallCode, Storage: map[libcommon.Hash]libcommon.Hash{
+		libcommon.HexToHash("0x01"): libcommon.HexToHash("0x0a"),
+		libcommon.HexToHash("0x02"): libcommon.HexToHash("0x0b"),
+	}}
+	gspec := &types.Genesis{Config: params.TestChainConfig, Alloc: alloc}
+	m := mock.MockWithGenesis(t, gspec, testKey, false)
+
+	signer := types.LatestSignerForChainID(nil)
+	chain, err := core.GenerateChain(m.ChainConfig, m.Genesis, m.Engine, m.DB, 2, func(i int, block *core.BlockGen) {
+		txn, err := types.SignTx(types.NewTransaction(block.TxNonce(testAddr), libcommon.Address{byte(i + 1)}, uint256.NewInt(1000), params.TxGas, uint256.NewInt(1), nil), *signer, testKey)
+		require.NoError(t, err)
+		block.AddTx(txn)
+	})
+	require.NoError(t, err)
+	require.NoError(t, m.InsertChain(chain))
+
+	return snap.NewServer(m.DB, m.BlockReader, log.New()), chain.TopBlock.Root()
+}
+
+type testAccount struct {
+	Nonce    uint64
+	Balance  *uint256.Int
+	Root     libcommon.Hash
+	CodeHash libcommon.Hash
+}
+
+func decodeAccount(t *testing.T, body []byte) ([]byte, testAccount) {
+	full, err := snap.FullAccountRLP(body)
+	require.NoError(t, err)
+	var acc testAccount
+	require.NoError(t, rlp.DecodeBytes(full, &acc))
+	return full, acc
+}
+
+func incHash(h libcommon.Hash) libcommon.Hash {
+	for i := len(h) - 1; i >= 0; i-- {
+		h[i]++
+		if h[i] != 0 {
+			break
+		}
+	}
+	return h
+}
+
+var maxHash = libcommon.HexToHash("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+
+func TestServeAccountRange(t *testing.T) {
+	server, root := newTestServer(t)
+	ctx := context.Background()
+
+	all, proof, err := server.ServiceGetAccountRangeQuery(ctx, &snap.GetAccountRangePacket{Root: root, Limit: maxHash, Bytes: 1 << 20})
+	require.NoError(t, err)
+	require.Greater(t, len(all), 100)
+
+	// The complete range must hash to the state root
+	tr := trie.New(libcommon.Hash{})
+	for i, acc := range all {
+		if i > 0 {
+			require.Negative(t, bytes.Compare(all[i-1].Hash[:], acc.Hash[:]))
+		}
+		_, decoded := decodeAccount(t, acc.Body)
+		tr.UpdateAccount(acc.Hash[:], &accounts.Account{Initialised: true, Nonce: decoded.Nonce, Balance: *decoded.Balance, Root: decoded.Root, CodeHash: decoded.CodeHash})
+	}
+	require.Equal(t, root, tr.Hash())
+	verifyAccountRange(t, root, libcommon.Hash{}, all, proof)
+
+	// Fetch the same range in small chunks, each proven against the root
+	var paged []*snap.AccountData
+	origin := libcommon.Hash{}
+	for {
+		accs, proof, err := server.ServiceGetAccountRangeQuery(ctx, &snap.GetAccountRangePacket{Root: root, Origin: origin, Limit: maxHash, Bytes: 500})
+		require.NoError(t, err)
+		require.NotEmpty(t, accs)
+		require.Less(t, len(accs), len(all))
+		verifyAccountRange(t, root, origin, accs, proof)
+		paged = append(paged, accs...)
+		last := accs[len(accs)-1].Hash
+		if last == all[len(all)-1].Hash {
+			break
+		}
+		origin = incHash(last)
+	}
+	require.Equal(t, all, paged)
+
+	// Limit ends the range right after the first account at or past it
+	accs, _, err := server.ServiceGetAccountRangeQuery(ctx, &snap.GetAccountRangePacket{Root: root, Limit: all[2].Hash, Bytes: 1 << 20})
+	require.NoError(t, err)
+	require.Equal(t, all[:3], accs)
+
+	// Unknown roots are not available
+	accs, proof, err = server.ServiceGetAccountRangeQuery(ctx, &snap.GetAccountRangePacket{Root: libcommon.Hash{1}, Limit: maxHash, Bytes: 1 << 20})
+	require.NoError(t, err)
+	require.Empty(t, accs)
+	require.Empty(t, proof)
+}
+
+func verifyAccountRange(t *testing.T, root, origin libcommon.Hash, accs []*snap.AccountData, proof [][]byte) {
+	t.Helper()
+	require.NotEmpty(t, proof)
+	_, err := trie.VerifyProof(root, origin[:], proof)
+	require.NoError(t, err)
+	last := accs[len(accs)-1]
+	value, err := trie.VerifyProof(root, last.Hash[:], proof)
+	require.NoError(t, err)
+	full, _ := decodeAccount(t, last.Body)
+	require.Equal(t, full, value)
+}
+
+func TestServeStorageRanges(t *testing.T) {
+	server, root := newTestServer(t)
+	ctx := context.Background()
+
+	all, _, err := server.ServiceGetAccountRangeQuery(ctx, &snap.GetAccountRangePacket{Root: root, Limit: maxHash, Bytes: 1 << 20})
+	require.NoError(t, err)
+	storageRoots := map[libcommon.Hash]libcommon.Hash{}
+	for _, acc := range all {
+		_, decoded := decodeAccount(t, acc.Body)
+		storageRoots[acc.Hash] = decoded.Root
+	}
+	bigHash, smallHash := crypto.Keccak256Hash(bigContract[:]), crypto.Keccak256Hash(smallContract[:])
+
+	// Complete storage of both contracts, no proof needed
+	slots, proof, err := server.ServiceGetStorageRangesQuery(ctx, &snap.GetStorageRangesPacket{Root: root, Accounts: []libcommon.Hash{bigHash, smallHash}, Bytes: 1 << 20})
+	require.NoError(t, err)
+	require.Len(t, slots, 2)
+	require.Len(t, slots[0], 60)
+	require.Len(t, slots[1], 2)
+	require.Empty(t, proof)
+	for i, accHash := range []libcommon.Hash{bigHash, smallHash} {
+		tr := trie.New(libcommon.Hash{})
+		for _, slot := range slots[i] {
+			var value []byte
+			require.NoError(t, rlp.DecodeBytes(slot.Body, &value))
+			tr.Update(slot.Hash[:], value)
+		}
+		require.Equal(t, storageRoots[accHash], tr.Hash())
+	}
+
+	// Partial ranges of the big contract are proven against its storage root
+	var paged []*snap.StorageData
+	origin := libcommon.Hash{}
+	for {
+		req := &snap.GetStorageRangesPacket{Root: root, Accounts: []libcommon.Hash{bigHash, smallHash}, Bytes: 300}
+		if origin != (libcommon.Hash{}) {
+			req.Origin = origin[:]
+		}
+		res, proof, err := server.ServiceGetStorageRangesQuery(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.NotEmpty(t, proof)
+		storageRoot := storageRoots[bigHash]
+		_, err = trie.VerifyProof(storageRoot, origin[:], proof)
+		require.NoError(t, err)
+		last := res[0][len(res[0])-1]
+		value, err := trie.VerifyProof(storageRoot, last.Hash[:], proof)
+		require.NoError(t, err)
+		require.Equal(t, last.Body, value)
+
+		paged = append(paged, res[0]...)
+		if last.Hash == slots[0][len(slots[0])-1].Hash {
+			break
+		}
+		origin = incHash(last.Hash)
+	}
+	require.Equal(t, slots[0], paged)
+}
+
+func TestServeByteCodes(t *testing.T) {
+	server, root := newTestServer(t)
+	ctx := context.Background()
+	req := &snap.GetByteCodesPacket{
+		Hashes: []libcommon.Hash{crypto.Keccak256Hash(bigCode), trie.EmptyCodeHash, {0xde, 0xad}, crypto.Keccak256Hash(smallCode)},
+		Bytes:  1 << 20,
+	}
+
+	// Codes are found by hash before their accounts are served
+	codes, err := server.ServiceGetByteCodesQuery(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{bigCode, {}, smallCode}, codes)
+
+	_, _, err = server.ServiceGetAccountRangeQuery(ctx, &snap.GetAccountRangePacket{Root: root, Limit: maxHash, Bytes: 1 << 20})
+	require.NoError(t, err)
+	codes, err = server.ServiceGetByteCodesQuery(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{bigCode, {}, smallCode}, codes)
+
+	// The response is cut once it exceeds the requested size
+	req.Bytes = 1
+	codes, err = server.ServiceGetByteCodesQuery(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{bigCode}, codes)
+}
+
+func TestServeTrieNodes(t *testing.T) {
+	server, root := newTestServer(t)
+	ctx := context.Background()
+
+	all, _, err := server.ServiceGetAccountRangeQuery(ctx, &snap.GetAccountRangePacket{Root: root, Limit: maxHash, Bytes: 1 << 20})
+	require.NoError(t, err)
+	bigHash := crypto.Keccak256Hash(bigContract[:])
+	var storageRoot libcommon.Hash
+	for _, acc := range all {
+		if acc.Hash == bigHash {
+			_, decoded := decodeAccount(t, acc.Body)
+			storageRoot = decoded.Root
+		}
+	}
+
+	nodes, err := server.ServiceGetTrieNodesQuery(ctx, &snap.GetTrieNodesPacket{
+		Root: root,
+		Paths: []snap.TrieNodePathSet{
+			{{0x00}},                     // account trie root
+			{{0x10 | all[0].Hash[0]>>4}}, // first nibble of an existing account
+			{bigHash[:], {0x00}},         // storage trie root
+		},
+		Bytes: 1 << 20,
+	})
+	require.NoError(t, err)
+	require.Len(t, nodes, 3)
+	require.Equal(t, root, crypto.Keccak256Hash(nodes[0]))
+	require.True(t, bytes.Contains(nodes[0], crypto.Keccak256(nodes[1])))
+	require.Equal(t, storageRoot, crypto.Keccak256Hash(nodes[2]))
+}
+
+func TestHandleMessage(t *testing.T) {
+	server, _ := newTestServer(t)
+	in, out := p2p.MsgPipe()
+	defer in.Close()
+	defer out.Close()
+
+	go func() {
+		msg, err := in.ReadMsg()
+		if err != nil {
+			return
+		}
+		_ = server.HandleMessage(context.Background(), msg, in)
+	}()
+	require.NoError(t, p2p.Send(out, snap.GetByteCodesMsg, &snap.GetByteCodesPacket{ID: 42, Hashes: []libcommon.Hash{crypto.Keccak256Hash(smallCode)}, Bytes: 1024}))
+
+	msg, err := out.ReadMsg()
+	require.NoError(t, err)
+	require.Equal(t, uint64(snap.ByteCodesMsg), msg.Code)
+	var res snap.ByteCodesPacket
+	require.NoError(t, msg.Decode(&res))
+	require.Equal(t, uint64(42), res.ID)
+	require.Equal(t, [][]byte{smallCode}, res.Codes)
+}
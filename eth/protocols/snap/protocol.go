@@ -0,0 +1,185 @@
+// Copyright 2020 The go-ethereum Authors
+// (original work)
+// Copyright 2024 The Erigon Authors
+// (modifications)
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package snap
+
+import (
+	"errors"
+
+	"github.com/holiman/uint256"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/rlp"
+	"github.com/erigontech/erigon-lib/trie"
+)
+
+// Constants to match up protocol versions and messages
+const (
+	SNAP1 = 1
+)
+
+// ProtocolName is the official short name of the `snap` protocol used during
+// devp2p capability negotiation.
+const ProtocolName = "snap"
+
+// ProtocolLength is the number of implemented messages of the snap/1 protocol.
+const ProtocolLength = 8
+
+// ProtocolMaxMsgSize is the maximum cap on the size of a protocol message.
+const ProtocolMaxMsgSize = 10 * 1024 * 1024
+
+const (
+	GetAccountRangeMsg  = 0x00
+	AccountRangeMsg     = 0x01
+	GetStorageRangesMsg = 0x02
+	StorageRangesMsg    = 0x03
+	GetByteCodesMsg     = 0x04
+	ByteCodesMsg        = 0x05
+	GetTrieNodesMsg     = 0x06
+	TrieNodesMsg        = 0x07
+)
+
+var (
+	errMsgTooLarge    = errors.New("message too long")
+	errDecode         = errors.New("invalid message")
+	errInvalidMsgCode = errors.New("invalid message code")
+)
+
+// GetAccountRangePacket represents an account query.
+type GetAccountRangePacket struct {
+	ID     uint64         // Request ID to match up responses with
+	Root   libcommon.Hash // Root hash of the account trie to serve
+	Origin libcommon.Hash // Hash of the first account to retrieve
+	Limit  libcommon.Hash // Hash of the last account to retrieve
+	Bytes  uint64         // Soft limit at which to stop returning data
+}
+
+// AccountRangePacket represents an account query response.
+type AccountRangePacket struct {
+	ID       uint64         // ID of the request this is a response for
+	Accounts []*AccountData // List of consecutive accounts from the trie
+	Proof    [][]byte       // List of trie nodes proving the account range
+}
+
+// AccountData represents a single account in a query response.
+type AccountData struct {
+	Hash libcommon.Hash // Hash of the account
+	Body rlp.RawValue   // Account body in slim format
+}
+
+// GetStorageRangesPacket represents an storage slot query.
+type GetStorageRangesPacket struct {
+	ID       uint64           // Request ID to match up responses with
+	Root     libcommon.Hash   // Root hash of the account trie to serve
+	Accounts []libcommon.Hash // Account hashes of the storage tries to serve
+	Origin   []byte           // Hash of the first storage slot to retrieve (large contract mode)
+	Limit    []byte           // Hash of the last storage slot to retrieve (large contract mode)
+	Bytes    uint64           // Soft limit at which to stop returning data
+}
+
+// StorageRangesPacket represents a storage slot query response.
+type StorageRangesPacket struct {
+	ID    uint64           // ID of the request this is a response for
+	Slots [][]*StorageData // Lists of consecutive storage slots for the requested accounts
+	Proof [][]byte         // Merkle proofs for the *last* slot range, if it's incomplete
+}
+
+// StorageData represents a single storage slot in a query response.
+type StorageData struct {
+	Hash libcommon.Hash // Hash of the storage slot
+	Body []byte         // Data content of the slot
+}
+
+// GetByteCodesPacket represents a contract bytecode query.
+type GetByteCodesPacket struct {
+	ID     uint64           // Request ID to match up responses with
+	Hashes []libcommon.Hash // Code hashes to retrieve the code for
+	Bytes  uint64           // Soft limit at which to stop returning data
+}
+
+// ByteCodesPacket represents a contract bytecode query response.
+type ByteCodesPacket struct {
+	ID    uint64   // ID of the request this is a response for
+	Codes [][]byte // Requested contract bytecodes
+}
+
+// GetTrieNodesPacket represents a state trie node query.
+type GetTrieNodesPacket struct {
+	ID    uint64            // Request ID to match up responses with
+	Root  libcommon.Hash    // Root hash of the account trie to serve
+	Paths []TrieNodePathSet // Trie node hashes to retrieve the nodes for
+	Bytes uint64            // Soft limit at which to stop returning data
+}
+
+// TrieNodePathSet is a list of trie node paths to retrieve. A naive way to
+// represent trie nodes would be a simple list of `account || storage` path
+// segments concatenated, but that would be very wasteful on the network.
+//
+// Instead, this array special cases the first element as the path in the
+// account trie and the remaining elements as paths in the storage trie. To
+// address an account node, the slice should have a length of 1 consisting
+// of only the account path. There's no need to be able to address both an
+// account node and a storage node in the same request as it cannot happen
+// that a slot is accessed before the account path is fully expanded.
+type TrieNodePathSet [][]byte
+
+// TrieNodesPacket represents a state trie node query response.
+type TrieNodesPacket struct {
+	ID    uint64   // ID of the request this is a response for
+	Nodes [][]byte // Requested state trie nodes
+}
+
+// slimAccount is the account encoding used by the snap protocol. It differs
+// from the consensus encoding by leaving the storage root and code hash empty
+// when they are equal to the empty defaults.
+type slimAccount struct {
+	Nonce    uint64
+	Balance  *uint256.Int
+	Root     []byte
+	CodeHash []byte
+}
+
+// EncodeSlimAccount encodes an account into the snap protocol slim format.
+func EncodeSlimAccount(nonce uint64, balance *uint256.Int, root, codeHash libcommon.Hash) ([]byte, error) {
+	acc := slimAccount{Nonce: nonce, Balance: balance}
+	if root != trie.EmptyRoot {
+		acc.Root = root[:]
+	}
+	if codeHash != trie.EmptyCodeHash {
+		acc.CodeHash = codeHash[:]
+	}
+	return rlp.EncodeToBytes(&acc)
+}
+
+// FullAccountRLP converts a slim encoded account into the consensus encoding
+// stored in the leaves of the account trie.
+func FullAccountRLP(slim []byte) ([]byte, error) {
+	var acc slimAccount
+	if err := rlp.DecodeBytes(slim, &acc); err != nil {
+		return nil, err
+	}
+	root, codeHash := trie.EmptyRoot, trie.EmptyCodeHash
+	if len(acc.Root) > 0 {
+		root = libcommon.BytesToHash(acc.Root)
+	}
+	if len(acc.CodeHash) > 0 {
+		codeHash = libcommon.BytesToHash(acc.CodeHash)
+	}
+	return rlp.EncodeToBytes([]any{acc.Nonce, acc.Balance, root, codeHash})
+}
@@ -0,0 +1,278 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package snap
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/erigontech/erigon-lib/commitment"
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/common/length"
+	"github.com/erigontech/erigon-lib/crypto"
+	"github.com/erigontech/erigon-lib/state"
+)
+
+// errLookupLimit is returned once a request has read as many commitment
+// branches as it is allowed to. Whatever was collected until then is still
+// a valid, if shorter, response.
+var errLookupLimit = errors.New("branch lookup limit reached")
+
+// branchReader reads branches of the commitment trie, charging every read
+// against the lookup budget of a single request.
+type branchReader struct {
+	domains *state.SharedDomains
+	budget  int
+}
+
+func newBranchReader(domains *state.SharedDomains) *branchReader {
+	return &branchReader{domains: domains, budget: maxBranchLookups}
+}
+
+// branch returns the cells of the branch at the given nibble path, or false
+// if there is no branch at that path.
+func (r *branchReader) branch(path []byte) (cells [16]*commitment.BranchCell, ok bool, err error) {
+	if r.budget <= 0 {
+		return cells, false, errLookupLimit
+	}
+	r.budget--
+	return r.read(path)
+}
+
+// read is like branch but not charged against the budget. It is only used for
+// walks bounded by the depth of the trie.
+func (r *branchReader) read(path []byte) (cells [16]*commitment.BranchCell, ok bool, err error) {
+	v, _, err := r.domains.LatestCommitment(commitment.BranchKey(path))
+	if err != nil || len(v) == 0 {
+		return cells, false, err
+	}
+	if cells, err = commitment.BranchData(v).Cells(); err != nil {
+		return cells, false, fmt.Errorf("branch %x: %w", path, err)
+	}
+	return cells, true, nil
+}
+
+// trieLeaf is an account or a storage slot held by a cell of the commitment trie.
+type trieLeaf struct {
+	hash libcommon.Hash
+	key  []byte // Plain key of the leaf in the Accounts or Storage domain
+	cell *commitment.BranchCell
+}
+
+// hasStorage reports whether the account held by the leaf has a non-empty
+// storage trie.
+func (l *trieLeaf) hasStorage() bool {
+	return len(l.cell.StorageAddr) > 0 || len(l.cell.Hash) > 0
+}
+
+// trieWalker walks the account trie, or the storage trie of a single account,
+// within the commitment trie. Leaves are visited in the order of their hashes,
+// which is the order snap ranges are served in.
+type trieWalker struct {
+	reader  *branchReader
+	root    []byte    // Nibble path of the trie root within the commitment trie
+	storage bool      // Whether the leaves are storage slots rather than accounts
+	single  *trieLeaf // Only leaf of a storage trie folded into its account cell
+}
+
+func newAccountTrie(reader *branchReader) *trieWalker {
+	return &trieWalker{reader: reader}
+}
+
+// storageTrie returns a walker over the storage trie of the given account, or
+// nil if the account has no storage.
+func (w *trieWalker) storageTrie(acc *trieLeaf) *trieWalker {
+	if !acc.hasStorage() {
+		return nil
+	}
+	st := &trieWalker{reader: w.reader, storage: true}
+	if len(acc.cell.StorageAddr) > 0 {
+		st.single = &trieLeaf{hash: crypto.Keccak256Hash(acc.cell.StorageAddr[length.Addr:]), key: acc.cell.StorageAddr, cell: acc.cell}
+		return st
+	}
+	st.root = append(keybytesToNibbles(acc.hash[:]), acc.cell.Extension...)
+	return st
+}
+
+func (w *trieWalker) leaf(c *commitment.BranchCell) (trieLeaf, bool) {
+	if w.storage {
+		if len(c.StorageAddr) <= length.Addr {
+			return trieLeaf{}, false
+		}
+		return trieLeaf{hash: crypto.Keccak256Hash(c.StorageAddr[length.Addr:]), key: c.StorageAddr, cell: c}, true
+	}
+	if len(c.AccountAddr) == 0 {
+		return trieLeaf{}, false
+	}
+	return trieLeaf{hash: crypto.Keccak256Hash(c.AccountAddr), key: c.AccountAddr, cell: c}, true
+}
+
+// find returns the leaf with the given hash, or nil if the trie does not hold it.
+// It reads one branch per level of the trie.
+func (w *trieWalker) find(hash libcommon.Hash) (*trieLeaf, error) {
+	if w.single != nil {
+		if w.single.hash != hash {
+			return nil, nil
+		}
+		return w.single, nil
+	}
+	nibbles := keybytesToNibbles(hash[:])
+	path := w.root
+	for depth := 0; depth < len(nibbles); {
+		cells, ok, err := w.reader.branch(path)
+		if err != nil || !ok {
+			return nil, err
+		}
+		c := cells[nibbles[depth]]
+		if c == nil {
+			return nil, nil
+		}
+		if leaf, ok := w.leaf(c); ok {
+			if leaf.hash != hash {
+				return nil, nil
+			}
+			return &leaf, nil
+		}
+		depth++
+		if !bytes.HasPrefix(nibbles[depth:], c.Extension) {
+			return nil, nil
+		}
+		depth += len(c.Extension)
+		path = append(libcommon.Copy(w.root), nibbles[:depth]...)
+	}
+	return nil, nil
+}
+
+// forEach calls f for every leaf at or after origin in ascending hash order,
+// until f returns false. Subtrees entirely before origin are skipped without
+// being read. It returns the leaf immediately preceding origin, if any, which
+// is needed to prove that nothing was left out between the two.
+func (w *trieWalker) forEach(origin libcommon.Hash, f func(leaf *trieLeaf) (bool, error)) (pred *trieLeaf, err error) {
+	if w.single != nil {
+		if bytes.Compare(w.single.hash[:], origin[:]) < 0 {
+			return w.single, nil
+		}
+		_, err := f(w.single)
+		return nil, err
+	}
+	originNibbles := keybytesToNibbles(origin[:])
+
+	// The last leaf or subtree skipped for preceding origin holds the predecessor
+	var (
+		skippedLeaf *trieLeaf
+		skippedPath []byte
+	)
+	var visit func(path []byte) (bool, error)
+	visit = func(path []byte) (bool, error) {
+		cells, ok, err := w.reader.branch(path)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			if len(path) == len(w.root) {
+				return true, nil // Empty trie
+			}
+			return false, fmt.Errorf("missing branch %x", path)
+		}
+		for n, c := range cells {
+			if c == nil {
+				continue
+			}
+			if leaf, ok := w.leaf(c); ok {
+				if bytes.Compare(leaf.hash[:], origin[:]) < 0 {
+					skippedLeaf, skippedPath = &leaf, nil
+					continue
+				}
+				if cont, err := f(&leaf); err != nil || !cont {
+					return false, err
+				}
+				continue
+			}
+			child := make([]byte, 0, len(path)+1+len(c.Extension))
+			child = append(append(append(child, path...), byte(n)), c.Extension...)
+			prefix := child[len(w.root):]
+			if len(prefix) <= len(originNibbles) && bytes.Compare(prefix, originNibbles[:len(prefix)]) < 0 {
+				skippedLeaf, skippedPath = nil, child
+				continue
+			}
+			if cont, err := visit(child); err != nil || !cont {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+	_, err = visit(w.root)
+	if err != nil && !errors.Is(err, errLookupLimit) {
+		return nil, err
+	}
+	if skippedPath != nil {
+		var lastErr error
+		if skippedLeaf, lastErr = w.last(skippedPath); lastErr != nil {
+			return nil, lastErr
+		}
+	}
+	return skippedLeaf, err
+}
+
+// last returns the leaf with the highest hash in the subtree at path.
+func (w *trieWalker) last(path []byte) (*trieLeaf, error) {
+	for {
+		cells, ok, err := w.reader.read(path)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("missing branch %x", path)
+		}
+		n := len(cells) - 1
+		for n >= 0 && cells[n] == nil {
+			n--
+		}
+		if n < 0 {
+			return nil, fmt.Errorf("empty branch %x", path)
+		}
+		if leaf, ok := w.leaf(cells[n]); ok {
+			return &leaf, nil
+		}
+		path = append(append(libcommon.Copy(path), byte(n)), cells[n].Extension...)
+	}
+}
+
+// first returns the leaf with the lowest hash starting with the given nibbles,
+// or nil if there is none.
+func (w *trieWalker) first(nibbles []byte) (*trieLeaf, error) {
+	if len(nibbles) > 2*length.Hash {
+		return nil, nil
+	}
+	var origin libcommon.Hash
+	for i, n := range nibbles {
+		if i%2 == 0 {
+			origin[i/2] |= n << 4
+		} else {
+			origin[i/2] |= n
+		}
+	}
+	var found *trieLeaf
+	_, err := w.forEach(origin, func(leaf *trieLeaf) (bool, error) {
+		if hasNibblePrefix(leaf.hash[:], nibbles) {
+			found = leaf
+		}
+		return false, nil
+	})
+	return found, err
+}
@@ -83,6 +83,7 @@ var (
 	errRecentlyDialed   = errors.New("recently dialed")
 	errNotWhitelisted   = errors.New("not contained in netrestrict whitelist")
 	errNoPort           = errors.New("node does not provide TCP port")
+	errLowReputation    = errors.New("low reputation")
 )
 
 // dialer creates outbound connections and submits them into Server.
@@ -94,6 +95,8 @@ var (
 //   - dynamic dials are created from node discovery results. The dialer
 //     continuously reads candidate nodes from its input iterator and attempts
 //     to create peer connections to nodes arriving through the iterator.
+//     Nodes with high reputation, known from previous runs, are dialed first,
+//     and nodes with low reputation are not dialed.
 type dialScheduler struct {
 	dialConfig
 	mutex       sync.Mutex
@@ -123,6 +126,9 @@ type dialScheduler struct {
 	static     map[enode.ID]*dialTask
 	staticPool []*dialTask
 
+	// Nodes with the highest reputation, dialed before the nodes from the iterator.
+	reputable []*enode.Node
+
 	// The dial history keeps recently dialed nodes. Members of history are not dialed.
 	history          expHeap
 	historyTimer     mclock.Timer
@@ -145,6 +151,7 @@ type dialConfig struct {
 	log            log.Logger
 	clock          mclock.Clock
 	rand           *mrand.Rand
+	reputation     *PeerReputation // nil - reputation of nodes is not taken into account
 }
 
 func (cfg dialConfig) withDefaults() dialConfig {
@@ -183,6 +190,10 @@ func newDialScheduler(config dialConfig, it enode.Iterator, setupFunc dialSetupF
 		errors:             map[string]uint{},
 	}
 
+	if d.reputation != nil {
+		d.reputable = d.reputation.ReputableNodes(d.maxDialPeers)
+	}
+
 	d.ctx, d.cancel = context.WithCancel(context.Background())
 	d.wg.Add(2)
 	go d.readNodes(it)
@@ -244,6 +255,7 @@ loop:
 		// Launch new dials if slots are available.
 		slots := d.freeDialSlots()
 		d.startStaticDials()
+		d.startReputableDials()
 		if slots > 0 {
 			nodesCh = d.nodesIn
 		} else {
@@ -260,7 +272,7 @@ loop:
 			d.logStats()
 
 		case node := <-nodesCh:
-			if err := d.checkDial(node); err != nil {
+			if err := d.checkDynDial(node); err != nil {
 				d.log.Trace("Discarding dial candidate", "id", node.ID(), "ip", node.IP(), "reason", err)
 			} else {
 				d.startDial(newDialTask(node, dynDialedConn))
@@ -426,6 +438,30 @@ func (d *dialScheduler) checkDial(n *enode.Node) error {
 	return nil
 }
 
+// checkDynDial returns an error if node n, not configured as static, should not be dialed.
+func (d *dialScheduler) checkDynDial(n *enode.Node) error {
+	if err := d.checkDial(n); err != nil {
+		return err
+	}
+	if d.reputation != nil && d.reputation.Score(n.ID()) <= LowReputation {
+		return errLowReputation
+	}
+	return nil
+}
+
+// startReputableDials dials the nodes with high reputation, while there are free dial slots.
+func (d *dialScheduler) startReputableDials() {
+	for len(d.reputable) > 0 && d.freeDialSlots() > 0 {
+		node := d.reputable[0]
+		d.reputable = d.reputable[1:]
+		if err := d.checkDynDial(node); err != nil {
+			d.log.Trace("Discarding reputable dial candidate", "id", node.ID(), "ip", node.IP(), "reason", err)
+			continue
+		}
+		d.startDial(newDialTask(node, dynDialedConn))
+	}
+}
+
 // startStaticDials starts n static dial tasks.
 func (d *dialScheduler) startStaticDials() {
 	for len(d.staticPool) > 0 {
@@ -60,6 +60,10 @@ type Config struct {
 	PrivateKeyGenerator func() (*ecdsa.PrivateKey, error)
 
 	TableRevalidateInterval time.Duration
+
+	// TopicDiscovery enables the experimental topic advertisement of the V5 discovery. Without
+	// it, REQUESTTICKET, REGTOPIC and TOPICQUERY requests are ignored.
+	TopicDiscovery bool
 }
 
 func (cfg Config) withDefaults(defaultReplyTimeout time.Duration) Config {
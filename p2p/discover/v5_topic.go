@@ -0,0 +1,412 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package discover
+
+import (
+	"context"
+	"crypto/hmac"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/erigontech/erigon-lib/common/debug"
+	"github.com/erigontech/erigon-lib/common/mclock"
+	"github.com/erigontech/erigon-lib/crypto"
+	"github.com/erigontech/erigon-lib/rlp"
+	"github.com/erigontech/erigon/p2p/discover/v5wire"
+	"github.com/erigontech/erigon/p2p/enode"
+)
+
+// Experimental topic advertisement, loosely following the draft of the discv5 topic
+// advertisement. Every node acts as a registrar: it keeps a limited queue of ads per
+// topic. Advertisers register with the registrars closest to the topic hash, searchers
+// ask the same registrars for advertised nodes with TOPICQUERY. Registration needs a
+// ticket, which makes the advertiser wait while the topic queue is full.
+
+const (
+	topicAdLifetime     = 15 * time.Minute // ads expire, advertisers refresh them every half of the lifetime
+	topicRegistrars     = 8                // advertisers register with this many nodes closest to the topic
+	maxAdsPerTopic      = 100
+	maxTopics           = 500
+	ticketValidity      = 30 * time.Second // registration window, starts after the wait time of the ticket
+	topicQueryLimit     = 16               // applies in TOPICQUERY handler
+	topicSearchInterval = 30 * time.Second // pause between search rounds, which found no nodes
+)
+
+var errTopicRegistrationRejected = errors.New("topic registration rejected")
+
+// Topic is the keccak256 hash of the topic name.
+type Topic [32]byte
+
+// NewTopic returns the topic of the given name.
+func NewTopic(name string) Topic {
+	return Topic(crypto.Keccak256Hash([]byte(name)))
+}
+
+func (t Topic) String() string {
+	return fmt.Sprintf("%x", t[:8])
+}
+
+type topicAd struct {
+	node    *enode.Node
+	expires mclock.AbsTime
+}
+
+// ticket is issued by the registrar, authenticated by its secret, so no state is kept for issued tickets.
+type ticket struct {
+	Topic  Topic
+	NodeID enode.ID
+	Issued uint64 // mclock.AbsTime of the registrar
+	Wait   uint64
+}
+
+// topicTable keeps the topic ads registered with the local node.
+type topicTable struct {
+	mu     sync.Mutex
+	clock  mclock.Clock
+	ads    map[Topic][]topicAd // oldest ad first
+	secret [32]byte
+}
+
+func newTopicTable(clock mclock.Clock) *topicTable {
+	tt := &topicTable{clock: clock, ads: make(map[Topic][]topicAd)}
+	crand.Read(tt.secret[:])
+	return tt
+}
+
+// issueTicket returns a ticket for the node and the time to wait before registering with it.
+func (tt *topicTable) issueTicket(topic Topic, id enode.ID) ([]byte, time.Duration) {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	now := tt.clock.Now()
+	wait := tt.waitTime(topic, id, now)
+	enc, _ := rlp.EncodeToBytes(&ticket{Topic: topic, NodeID: id, Issued: uint64(now), Wait: uint64(wait)})
+	return append(enc, tt.mac(enc)...), wait
+}
+
+// register adds the node to the topic queue, if the ticket is valid and the wait time is over.
+func (tt *topicTable) register(ticketEnc []byte, n *enode.Node) (bool, error) {
+	if len(ticketEnc) <= sha256.Size {
+		return false, errors.New("ticket too short")
+	}
+	enc, mac := ticketEnc[:len(ticketEnc)-sha256.Size], ticketEnc[len(ticketEnc)-sha256.Size:]
+	if !hmac.Equal(mac, tt.mac(enc)) {
+		return false, errors.New("invalid ticket")
+	}
+	var tk ticket
+	if err := rlp.DecodeBytes(enc, &tk); err != nil {
+		return false, err
+	}
+	if tk.NodeID != n.ID() {
+		return false, errors.New("ticket issued for another node")
+	}
+
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	now := tt.clock.Now()
+	validFrom := mclock.AbsTime(tk.Issued).Add(time.Duration(tk.Wait))
+	if now < validFrom {
+		return false, errors.New("ticket wait time is not over")
+	}
+	if now > validFrom.Add(ticketValidity) {
+		return false, errors.New("ticket expired")
+	}
+	if tt.waitTime(tk.Topic, n.ID(), now) > 0 {
+		return false, nil
+	}
+	ads := tt.ads[tk.Topic]
+	for i := range ads {
+		if ads[i].node.ID() == n.ID() {
+			ads = append(ads[:i], ads[i+1:]...)
+			break
+		}
+	}
+	tt.ads[tk.Topic] = append(ads, topicAd{node: n, expires: now.Add(topicAdLifetime)})
+	return true, nil
+}
+
+// nodes returns up to limit advertised nodes of the topic, newest ads first.
+func (tt *topicTable) nodes(topic Topic, limit int) []*enode.Node {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	tt.expire(topic, tt.clock.Now())
+	ads := tt.ads[topic]
+	nodes := make([]*enode.Node, 0, min(limit, len(ads)))
+	for i := len(ads) - 1; i >= 0 && len(nodes) < limit; i-- {
+		nodes = append(nodes, ads[i].node)
+	}
+	return nodes
+}
+
+// waitTime returns how long the node has to wait until there is space for its ad in the topic queue.
+func (tt *topicTable) waitTime(topic Topic, id enode.ID, now mclock.AbsTime) time.Duration {
+	tt.expire(topic, now)
+	ads, known := tt.ads[topic]
+	for _, ad := range ads {
+		if ad.node.ID() == id {
+			return 0 // refresh of the existing ad
+		}
+	}
+	if !known && len(tt.ads) >= maxTopics {
+		return topicAdLifetime
+	}
+	if len(ads) < maxAdsPerTopic {
+		return 0
+	}
+	return time.Duration(ads[0].expires - now)
+}
+
+func (tt *topicTable) expire(topic Topic, now mclock.AbsTime) {
+	ads := tt.ads[topic]
+	i := 0
+	for i < len(ads) && ads[i].expires <= now {
+		i++
+	}
+	if i == len(ads) {
+		delete(tt.ads, topic)
+	} else if i > 0 {
+		tt.ads[topic] = append(ads[:0], ads[i:]...)
+	}
+}
+
+func (tt *topicTable) mac(data []byte) []byte {
+	h := hmac.New(sha256.New, tt.secret[:])
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// RegisterTopic advertises the local node for the topic until the transport is closed (experimental).
+func (t *UDPv5) RegisterTopic(topic Topic) {
+	t.wg.Add(1)
+	go t.topicRegisterLoop(topic)
+}
+
+// TopicNodes returns an iterator of nodes which advertise the topic (experimental).
+func (t *UDPv5) TopicNodes(topic Topic) enode.Iterator {
+	ctx, cancel := context.WithCancel(t.closeCtx)
+	return &topicIterator{t: t, topic: topic, ctx: ctx, cancel: cancel}
+}
+
+func (t *UDPv5) topicRegisterLoop(topic Topic) {
+	defer debug.LogPanic()
+	defer t.wg.Done()
+
+	refresh := time.NewTimer(0)
+	defer refresh.Stop()
+	for {
+		select {
+		case <-refresh.C:
+		case <-t.closeCtx.Done():
+			return
+		}
+		var wg sync.WaitGroup
+		for _, n := range t.topicRegistrars(topic) {
+			wg.Add(1)
+			go func(n *enode.Node) {
+				defer debug.LogPanic()
+				defer wg.Done()
+				if err := t.registerTopic(n, topic); err != nil {
+					t.log.Trace("Topic registration failed", "topic", topic, "id", n.ID(), "err", err)
+				}
+			}(n)
+		}
+		wg.Wait()
+		refresh.Reset(topicAdLifetime / 2)
+	}
+}
+
+// topicRegistrars returns nodes closest to the topic hash, which keep the ads of the topic.
+func (t *UDPv5) topicRegistrars(topic Topic) []*enode.Node {
+	nodes := t.Lookup(enode.ID(topic))
+	if len(nodes) > topicRegistrars {
+		nodes = nodes[:topicRegistrars]
+	}
+	return nodes
+}
+
+func (t *UDPv5) registerTopic(n *enode.Node, topic Topic) error {
+	ticket, wait, err := t.requestTicket(n, topic)
+	if err != nil {
+		return err
+	}
+	if wait > topicAdLifetime {
+		return fmt.Errorf("ticket wait time too long: %v", wait)
+	}
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-t.closeCtx.Done():
+			return errClosed
+		}
+	}
+	registered, err := t.regtopic(n, ticket)
+	if err != nil {
+		return err
+	}
+	if !registered {
+		return errTopicRegistrationRejected
+	}
+	return nil
+}
+
+// requestTicket calls REQUESTTICKET on a node and waits for a TICKET response.
+func (t *UDPv5) requestTicket(n *enode.Node, topic Topic) ([]byte, time.Duration, error) {
+	resp := t.call(n, v5wire.TicketMsg, &v5wire.RequestTicket{Topic: topic[:]})
+	defer t.callDone(resp)
+	select {
+	case respMsg := <-resp.ch:
+		tk := respMsg.(*v5wire.Ticket)
+		return tk.Ticket, time.Duration(tk.WaitTime) * time.Second, nil
+	case err := <-resp.err:
+		return nil, 0, err
+	}
+}
+
+// regtopic calls REGTOPIC on a node and waits for a REGCONFIRMATION response.
+func (t *UDPv5) regtopic(n *enode.Node, ticket []byte) (bool, error) {
+	resp := t.call(n, v5wire.RegconfirmationMsg, &v5wire.Regtopic{Ticket: ticket, ENR: t.Self().Record()})
+	defer t.callDone(resp)
+	select {
+	case respMsg := <-resp.ch:
+		return respMsg.(*v5wire.Regconfirmation).Registered, nil
+	case err := <-resp.err:
+		return false, err
+	}
+}
+
+// topicQuery calls TOPICQUERY on a node and waits for NODES responses.
+func (t *UDPv5) topicQuery(n *enode.Node, topic Topic) ([]*enode.Node, error) {
+	resp := t.call(n, v5wire.NodesMsg, &v5wire.TopicQuery{Topic: topic[:]})
+	return t.waitForNodes(resp, nil)
+}
+
+// handleRequestTicket issues a ticket for the topic.
+func (t *UDPv5) handleRequestTicket(p *v5wire.RequestTicket, fromID enode.ID, fromAddr *net.UDPAddr) {
+	if len(p.Topic) != len(Topic{}) {
+		return
+	}
+	ticket, wait := t.topics.issueTicket(Topic(p.Topic), fromID)
+	waitSeconds := uint((wait + time.Second - 1) / time.Second)
+	t.sendResponse(fromID, fromAddr, &v5wire.Ticket{ReqID: p.ReqID, Ticket: ticket, WaitTime: waitSeconds}) //nolint:errcheck
+}
+
+// handleRegtopic adds the sender to the topic queue.
+func (t *UDPv5) handleRegtopic(p *v5wire.Regtopic, fromID enode.ID, fromAddr *net.UDPAddr) {
+	registered := false
+	n, err := enode.New(t.validSchemes, p.ENR)
+	switch {
+	case err != nil:
+	case n.ID() != fromID:
+		err = errors.New("record of another node")
+	case !n.IP().Equal(fromAddr.IP):
+		err = errors.New("record IP does not match the sender")
+	default:
+		registered, err = t.topics.register(p.Ticket, n)
+	}
+	if err != nil {
+		t.log.Trace("Invalid "+p.Name(), "id", fromID, "addr", fromAddr, "err", err)
+	}
+	t.sendResponse(fromID, fromAddr, &v5wire.Regconfirmation{ReqID: p.ReqID, Registered: registered}) //nolint:errcheck
+}
+
+// handleTopicQuery returns nodes advertising the topic to the requester.
+func (t *UDPv5) handleTopicQuery(p *v5wire.TopicQuery, fromID enode.ID, fromAddr *net.UDPAddr) {
+	var nodes []*enode.Node
+	if len(p.Topic) == len(Topic{}) {
+		nodes = t.topics.nodes(Topic(p.Topic), topicQueryLimit)
+	}
+	for _, resp := range packNodes(p.ReqID, nodes) {
+		t.sendResponse(fromID, fromAddr, resp) //nolint:errcheck
+	}
+}
+
+// topicIterator searches the registrars of the topic for advertised nodes.
+type topicIterator struct {
+	t      *UDPv5
+	topic  Topic
+	ctx    context.Context
+	cancel context.CancelFunc
+	buf    []*enode.Node
+	cur    *enode.Node
+	rounds int
+}
+
+func (it *topicIterator) Next() bool {
+	it.cur = nil
+	for len(it.buf) == 0 {
+		if it.rounds > 0 {
+			timer := time.NewTimer(topicSearchInterval)
+			select {
+			case <-timer.C:
+			case <-it.ctx.Done():
+				timer.Stop()
+				return false
+			}
+		}
+		if it.ctx.Err() != nil {
+			return false
+		}
+		it.rounds++
+		it.buf = it.search()
+	}
+	it.cur, it.buf = it.buf[0], it.buf[1:]
+	return true
+}
+
+func (it *topicIterator) Node() *enode.Node {
+	return it.cur
+}
+
+func (it *topicIterator) Close() {
+	it.cancel()
+}
+
+func (it *topicIterator) search() []*enode.Node {
+	var (
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		found []*enode.Node
+		seen  = map[enode.ID]struct{}{it.t.Self().ID(): {}}
+	)
+	for _, registrar := range it.t.topicRegistrars(it.topic) {
+		wg.Add(1)
+		go func(registrar *enode.Node) {
+			defer debug.LogPanic()
+			defer wg.Done()
+			nodes, err := it.t.topicQuery(registrar, it.topic)
+			if err != nil {
+				it.t.log.Trace("Topic query failed", "topic", it.topic, "id", registrar.ID(), "err", err)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, n := range nodes {
+				if _, ok := seen[n.ID()]; !ok {
+					seen[n.ID()] = struct{}{}
+					found = append(found, n)
+				}
+			}
+		}(registrar)
+	}
+	wg.Wait()
+	return found
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package discover
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/erigontech/erigon-lib/common/mclock"
+	"github.com/erigontech/erigon/p2p/enode"
+	"github.com/erigontech/erigon/p2p/enr"
+)
+
+func topicTestNode(i byte) *enode.Node {
+	return enode.SignNull(new(enr.Record), enode.ID{i})
+}
+
+func TestTopicTable(t *testing.T) {
+	clock := new(mclock.Simulated)
+	tt := newTopicTable(clock)
+	topic := NewTopic("test")
+
+	// empty queue: no wait
+	ticket, wait := tt.issueTicket(topic, enode.ID{1})
+	assert.Zero(t, wait)
+	_, err := tt.register(ticket, topicTestNode(2))
+	require.Error(t, err, "ticket of another node")
+	forged := append([]byte{}, ticket...)
+	forged[0]++
+	_, err = tt.register(forged, topicTestNode(1))
+	require.Error(t, err, "forged ticket")
+	registered, err := tt.register(ticket, topicTestNode(1))
+	require.NoError(t, err)
+	assert.True(t, registered)
+
+	// fill the queue
+	for i := 2; i <= maxAdsPerTopic; i++ {
+		clock.Run(time.Second)
+		ticket, _ := tt.issueTicket(topic, enode.ID{byte(i)})
+		registered, err := tt.register(ticket, topicTestNode(byte(i)))
+		require.NoError(t, err)
+		require.True(t, registered)
+	}
+	nodes := tt.nodes(topic, topicQueryLimit)
+	require.Len(t, nodes, topicQueryLimit)
+	assert.Equal(t, enode.ID{maxAdsPerTopic}, nodes[0].ID(), "newest ad first")
+
+	// full queue: wait until the oldest ad expires
+	ticket, wait = tt.issueTicket(topic, enode.ID{200})
+	assert.Equal(t, topicAdLifetime-time.Duration(maxAdsPerTopic-1)*time.Second, wait)
+	_, err = tt.register(ticket, topicTestNode(200))
+	require.Error(t, err, "wait time is not over")
+	clock.Run(wait)
+	registered, err = tt.register(ticket, topicTestNode(200))
+	require.NoError(t, err)
+	assert.True(t, registered)
+	assert.Equal(t, enode.ID{200}, tt.nodes(topic, 1)[0].ID())
+
+	// tickets expire
+	clock.Run(time.Second)
+	ticket, wait = tt.issueTicket(topic, enode.ID{201})
+	clock.Run(wait + ticketValidity + time.Second)
+	_, err = tt.register(ticket, topicTestNode(201))
+	require.Error(t, err, "ticket expired")
+
+	// all ads expire
+	clock.Run(topicAdLifetime)
+	assert.Empty(t, tt.nodes(topic, topicQueryLimit))
+	assert.Empty(t, tt.ads)
+}
@@ -82,6 +82,9 @@ type UDPv5 struct {
 	trlock     sync.Mutex
 	trhandlers map[string]TalkRequestHandler
 
+	// ads of the topics registered with this node, nil unless topic discovery is enabled
+	topics *topicTable
+
 	// channels into dispatch
 	packetInCh    chan ReadPacket
 	readNextCh    chan struct{}
@@ -181,6 +184,9 @@ func newUDPv5(ctx context.Context, protocol string, conn UDPConn, ln *enode.Loca
 		return nil, err
 	}
 	t.tab = tab
+	if cfg.TopicDiscovery {
+		t.topics = newTopicTable(cfg.Clock)
+	}
 	return t, nil
 }
 
@@ -737,6 +743,22 @@ func (t *UDPv5) handle(p v5wire.Packet, fromID enode.ID, fromAddr *net.UDPAddr)
 		t.handleTalkRequest(p, fromID, fromAddr)
 	case *v5wire.TalkResponse:
 		t.handleCallResponse(fromID, fromAddr, p)
+	case *v5wire.RequestTicket:
+		if t.topics != nil {
+			t.handleRequestTicket(p, fromID, fromAddr)
+		}
+	case *v5wire.Ticket:
+		t.handleCallResponse(fromID, fromAddr, p)
+	case *v5wire.Regtopic:
+		if t.topics != nil {
+			t.handleRegtopic(p, fromID, fromAddr)
+		}
+	case *v5wire.Regconfirmation:
+		t.handleCallResponse(fromID, fromAddr, p)
+	case *v5wire.TopicQuery:
+		if t.topics != nil {
+			t.handleTopicQuery(p, fromID, fromAddr)
+		}
 	}
 }
 
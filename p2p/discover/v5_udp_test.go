@@ -415,6 +415,45 @@ func TestUDPv5_talkHandling(t *testing.T) {
 	})
 }
 
+// This test checks that incoming topic registrations and queries are handled correctly.
+func TestUDPv5_topicHandling(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("fix me on win please")
+	}
+	t.Parallel()
+	logger := log.New()
+	test := newUDPV5Test(t, logger)
+	t.Cleanup(test.close)
+
+	topic := NewTopic("test")
+	remote := test.getNode(test.remotekey, test.remoteaddr, logger).Node()
+
+	// Topic requests are ignored unless topic discovery is enabled.
+	test.packetIn(&v5wire.RequestTicket{ReqID: []byte("0"), Topic: topic[:]}, logger)
+	test.packetIn(&v5wire.Ping{ReqID: []byte("0")}, logger)
+	test.waitPacketOut(func(p *v5wire.Pong, addr *net.UDPAddr, _ v5wire.Nonce) {})
+
+	test.udp.topics = newTopicTable(test.udp.clock)
+	var ticket []byte
+	test.packetIn(&v5wire.RequestTicket{ReqID: []byte("1"), Topic: topic[:]}, logger)
+	test.waitPacketOut(func(p *v5wire.Ticket, addr *net.UDPAddr, _ v5wire.Nonce) {
+		if p.WaitTime != 0 {
+			t.Errorf("wrong wait time: %d", p.WaitTime)
+		}
+		ticket = p.Ticket
+	})
+
+	test.packetIn(&v5wire.Regtopic{ReqID: []byte("2"), Ticket: ticket, ENR: remote.Record()}, logger)
+	test.waitPacketOut(func(p *v5wire.Regconfirmation, addr *net.UDPAddr, _ v5wire.Nonce) {
+		if !p.Registered {
+			t.Error("topic registration rejected")
+		}
+	})
+
+	test.packetIn(&v5wire.TopicQuery{ReqID: []byte("3"), Topic: topic[:]}, logger)
+	test.expectNodes([]byte("3"), 1, []*enode.Node{remote})
+}
+
 // This test checks that outgoing TALKREQ calls work.
 func TestUDPv5_talkRequest(t *testing.T) {
 	if runtime.GOOS != "linux" {
@@ -123,9 +123,14 @@ type (
 	}
 
 	// TICKET is the response to REQUESTTICKET.
+	//
+	// WaitTime is an experimental extension of the draft message, only sent by nodes running
+	// with topic discovery enabled (--v5disc.topics). It is optional, so a TICKET without it
+	// still decodes and a zero wait time encodes as the draft message.
 	Ticket struct {
-		ReqID  []byte
-		Ticket []byte
+		ReqID    []byte
+		Ticket   []byte
+		WaitTime uint `rlp:"optional"` // seconds to wait before registering with the ticket
 	}
 
 	// REGTOPIC registers the sender in a topic queue using a ticket.
@@ -28,6 +28,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"sort"
 	"sync"
 	"time"
 
@@ -46,6 +47,7 @@ const (
 	dbVersionKey   = "version" // Version of the database to flush if changes
 	dbNodePrefix   = "n:"      // Identifier to prefix node entries with
 	dbLocalPrefix  = "local:"
+	dbScorePrefix  = "score:" // Reputation of a node is keyed by ID only, the full key is "score:<ID>".
 	dbDiscoverRoot = "v4"
 	dbDiscv5Root   = "v5"
 
@@ -548,6 +550,113 @@ func (db *DB) UpdateFindFailsV5(id ID, ip net.IP, fails int) error {
 	return db.storeInt64(v5Key(id, ip, dbNodeFindFails), int64(fails))
 }
 
+// NodeScore is the reputation score of a node and the time it was last updated.
+type NodeScore struct {
+	Score   int64
+	Updated time.Time
+}
+
+// NodeScore retrieves the reputation score of a node.
+func (db *DB) NodeScore(id ID) (score NodeScore) {
+	if err := db.kv.View(db.ctx, func(tx kv.Tx) error {
+		blob, errGet := tx.GetOne(kv.Inodes, scoreKey(id))
+		if errGet != nil {
+			return errGet
+		}
+		score = decodeNodeScore(blob)
+		return nil
+	}); err != nil {
+		return NodeScore{}
+	}
+	return score
+}
+
+// UpdateNodeScores stores the reputation scores of nodes in a single transaction. Zero scores are removed.
+func (db *DB) UpdateNodeScores(scores map[ID]NodeScore) error {
+	return db.kv.Update(db.ctx, func(tx kv.RwTx) error {
+		for id, score := range scores {
+			if score.Score == 0 {
+				if err := tx.Delete(kv.Inodes, scoreKey(id)); err != nil {
+					return err
+				}
+				continue
+			}
+			blob := make([]byte, 0, 2*binary.MaxVarintLen64)
+			blob = binary.AppendVarint(blob, score.Score)
+			blob = binary.AppendVarint(blob, score.Updated.Unix())
+			if err := tx.Put(kv.Inodes, scoreKey(id), blob); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// QueryScoredNodes retrieves records of nodes with a positive reputation score, the highest scores first.
+// Nodes without a record in the database are skipped.
+func (db *DB) QueryScoredNodes(n int) []*Node {
+	type scoredNode struct {
+		node  *Node
+		score int64
+	}
+	var nodes []scoredNode
+	if err := db.kv.View(db.ctx, func(tx kv.Tx) error {
+		c, err := tx.Cursor(kv.Inodes)
+		if err != nil {
+			return err
+		}
+		defer c.Close()
+		p := []byte(dbScorePrefix)
+		for k, v, err := c.Seek(p); bytes.HasPrefix(k, p); k, v, err = c.Next() {
+			if err != nil {
+				return err
+			}
+			score := decodeNodeScore(v).Score
+			if score <= 0 || len(k) != len(p)+len(ID{}) {
+				continue
+			}
+			var id ID
+			copy(id[:], k[len(p):])
+			blob, err := tx.GetOne(kv.NodeRecords, nodeKey(id))
+			if err != nil {
+				return err
+			}
+			if blob == nil {
+				continue
+			}
+			if node := mustDecodeNode(id[:], bytes.Clone(blob)); node != nil {
+				nodes = append(nodes, scoredNode{node: node, score: score})
+			}
+		}
+		return nil
+	}); err != nil {
+		log.Warn("nodeDB.QueryScoredNodes failed", "err", err)
+	}
+	sort.SliceStable(nodes, func(i, j int) bool { return nodes[i].score > nodes[j].score })
+	res := make([]*Node, 0, min(n, len(nodes)))
+	for i := 0; i < len(nodes) && i < n; i++ {
+		res = append(res, nodes[i].node)
+	}
+	return res
+}
+
+// scoreKey returns the database key of the reputation score of a node.
+func scoreKey(id ID) []byte {
+	return append([]byte(dbScorePrefix), id[:]...)
+}
+
+func decodeNodeScore(blob []byte) NodeScore {
+	score, n := binary.Varint(blob)
+	if n <= 0 {
+		return NodeScore{}
+	}
+	unix, m := binary.Varint(blob[n:])
+	if m <= 0 {
+		return NodeScore{}
+	}
+	return NodeScore{Score: score, Updated: time.Unix(unix, 0)}
+}
+
 // LocalSeq retrieves the local record sequence counter.
 func (db *DB) localSeq(id ID) uint64 {
 	return db.fetchUint64(localItemKey(id, dbLocalSeq))
@@ -494,3 +494,49 @@ func TestDBExpireV5(t *testing.T) {
 	db.UpdateFindFailsV5(ID{}, ip, 4)
 	db.expireNodes()
 }
+
+func TestDBNodeScore(t *testing.T) {
+	db, err := OpenDB(context.Background(), "", t.TempDir(), log.Root())
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+
+	nodes := nodeDBSeedQueryNodes[:4]
+	inst := time.Now()
+	if score := db.NodeScore(nodes[0].node.ID()); score.Score != 0 || !score.Updated.IsZero() {
+		t.Errorf("score: non-existing object: %v", score)
+	}
+	scores := map[ID]NodeScore{}
+	for i, score := range []int64{10, -20, 30, 20} {
+		scores[nodes[i].node.ID()] = NodeScore{Score: score, Updated: inst}
+	}
+	if err := db.UpdateNodeScores(scores); err != nil {
+		t.Fatalf("score: failed to update: %v", err)
+	}
+	if score := db.NodeScore(nodes[1].node.ID()); score.Score != -20 || score.Updated.Unix() != inst.Unix() {
+		t.Errorf("score: value mismatch: have %v, want %d %v", score, -20, inst)
+	}
+
+	// nodes without records are not returned
+	for _, n := range nodes[:3] {
+		if err := db.UpdateNode(n.node); err != nil {
+			t.Fatalf("node: failed to update: %v", err)
+		}
+	}
+	scored := db.QueryScoredNodes(10)
+	if len(scored) != 2 || scored[0].ID() != nodes[2].node.ID() || scored[1].ID() != nodes[0].node.ID() {
+		t.Errorf("scored nodes mismatch: have %v", scored)
+	}
+	if scored := db.QueryScoredNodes(1); len(scored) != 1 || scored[0].ID() != nodes[2].node.ID() {
+		t.Errorf("scored nodes mismatch: have %v", scored)
+	}
+
+	// zero scores are deleted
+	if err := db.UpdateNodeScores(map[ID]NodeScore{nodes[2].node.ID(): {Updated: inst}}); err != nil {
+		t.Fatalf("score: failed to delete: %v", err)
+	}
+	if score := db.NodeScore(nodes[2].node.ID()); score.Score != 0 || !score.Updated.IsZero() {
+		t.Errorf("score: deleted object: %v", score)
+	}
+}
@@ -540,6 +540,7 @@ type PeerInfo struct {
 		Static        bool   `json:"static"`
 	} `json:"network"`
 	Protocols map[string]interface{} `json:"protocols"` // Sub-protocol specific metadata fields
+	Score     int64                  `json:"score"`     // Reputation of the peer, see PeerReputation
 }
 
 // Info gathers and returns a collection of metadata known about a peer.
@@ -0,0 +1,137 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/erigontech/erigon/p2p/enode"
+)
+
+// ReputationEvent - something a peer did, which makes it more or less useful to stay connected to
+type ReputationEvent uint8
+
+const (
+	ReputationUsefulHeaders  ReputationEvent = iota // delivered requested headers, which were accepted
+	ReputationUsefulBodies                          // delivered requested bodies
+	ReputationRequestTimeout                        // didn't answer a request in time
+	ReputationInvalidBlock                          // sent an invalid header or block
+	ReputationTxnSpam                               // sent malformed txns or txn announcements
+)
+
+var reputationEventScores = [...]int64{
+	ReputationUsefulHeaders:  1,
+	ReputationUsefulBodies:   1,
+	ReputationRequestTimeout: -5,
+	ReputationInvalidBlock:   -100,
+	ReputationTxnSpam:        -20,
+}
+
+const (
+	maxReputation = 1_000
+	minReputation = -1_000
+
+	// LowReputation - peers with the score at or below are disconnected and not dialed, until the score recovers
+	LowReputation = -100
+
+	// Scores decay toward zero: bad peers eventually get another chance, and good peers have to stay useful
+	reputationHalfLife = 6 * time.Hour
+
+	// Scores are accumulated in memory, and written to the node database this often
+	reputationFlushInterval = time.Minute
+)
+
+// PeerReputation scores peers by their usefulness. Scores are kept in the node database, so they survive restarts.
+// Updates are accumulated in memory and written to the database by Flush.
+type PeerReputation struct {
+	db  *enode.DB
+	now func() time.Time
+
+	mu     sync.Mutex                   // serializes read-modify-write of scores
+	scores map[enode.ID]enode.NodeScore // scores updated since the last flush
+}
+
+func NewPeerReputation(db *enode.DB) *PeerReputation {
+	return &PeerReputation{db: db, now: time.Now, scores: map[enode.ID]enode.NodeScore{}}
+}
+
+// Score returns the current score of the peer, 0 for unknown peers
+func (r *PeerReputation) Score(id enode.ID) int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.score(id)
+}
+
+func (r *PeerReputation) score(id enode.ID) int64 {
+	score, ok := r.scores[id]
+	if !ok {
+		score = r.db.NodeScore(id)
+	}
+	return r.decay(score)
+}
+
+// Update applies the event to the score of the peer and returns the new score
+func (r *PeerReputation) Update(id enode.ID, event ReputationEvent) int64 {
+	if int(event) >= len(reputationEventScores) {
+		return 0
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	score := r.score(id) + reputationEventScores[event]
+	score = min(max(score, minReputation), maxReputation)
+	r.scores[id] = enode.NodeScore{Score: score, Updated: r.now()}
+	return score
+}
+
+// Flush writes the scores updated since the last flush to the node database
+func (r *PeerReputation) Flush() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.scores) == 0 {
+		return nil
+	}
+	if err := r.db.UpdateNodeScores(r.scores); err != nil {
+		return err
+	}
+	clear(r.scores)
+	return nil
+}
+
+// ReputableNodes returns up to n known nodes with the highest positive scores
+func (r *PeerReputation) ReputableNodes(n int) []*enode.Node {
+	nodes := r.db.QueryScoredNodes(n)
+	res := nodes[:0]
+	for _, node := range nodes {
+		if r.Score(node.ID()) > 0 {
+			res = append(res, node)
+		}
+	}
+	return res
+}
+
+func (r *PeerReputation) decay(score enode.NodeScore) int64 {
+	if score.Score == 0 || score.Updated.IsZero() {
+		return score.Score
+	}
+	elapsed := r.now().Sub(score.Updated)
+	if elapsed <= 0 {
+		return score.Score
+	}
+	return int64(math.Round(float64(score.Score) * math.Exp2(-float64(elapsed)/float64(reputationHalfLife))))
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon/p2p/enode"
+)
+
+func TestPeerReputation(t *testing.T) {
+	db, err := enode.OpenDB(context.Background(), "", t.TempDir(), log.Root())
+	require.NoError(t, err)
+	defer db.Close()
+
+	now := time.Now()
+	reputation := NewPeerReputation(db)
+	reputation.now = func() time.Time { return now }
+
+	good := newNode(uintID(0x01), "127.0.0.1:30303")
+	bad := newNode(uintID(0x02), "127.0.0.2:30303")
+	for _, n := range []*enode.Node{good, bad} {
+		require.NoError(t, db.UpdateNode(n))
+	}
+
+	for i := 0; i < 10; i++ {
+		reputation.Update(good.ID(), ReputationUsefulHeaders)
+	}
+	score := reputation.Update(bad.ID(), ReputationInvalidBlock)
+	assert.Equal(t, int64(LowReputation), score)
+	assert.Equal(t, int64(10), reputation.Score(good.ID()))
+	assert.Equal(t, int64(0), reputation.Score(uintID(0x03)))
+
+	// scores are clamped
+	for i := 0; i < 20; i++ {
+		score = reputation.Update(bad.ID(), ReputationInvalidBlock)
+	}
+	assert.Equal(t, int64(minReputation), score)
+
+	// scores are kept in memory until flushed
+	assert.Equal(t, int64(0), db.NodeScore(good.ID()).Score)
+	require.NoError(t, reputation.Flush())
+	assert.Equal(t, int64(10), db.NodeScore(good.ID()).Score)
+	reputation = NewPeerReputation(db)
+	reputation.now = func() time.Time { return now }
+	assert.Equal(t, int64(minReputation), reputation.Score(bad.ID()))
+
+	reputable := reputation.ReputableNodes(10)
+	require.Len(t, reputable, 1)
+	assert.Equal(t, good.ID(), reputable[0].ID())
+
+	// scores decay toward zero
+	now = now.Add(reputationHalfLife)
+	assert.Equal(t, int64(5), reputation.Score(good.ID()))
+	assert.Equal(t, int64(minReputation/2), reputation.Score(bad.ID()))
+	now = now.Add(10 * reputationHalfLife)
+	assert.Equal(t, int64(0), reputation.Score(good.ID()))
+	assert.Empty(t, reputation.ReputableNodes(10))
+}
@@ -62,6 +62,12 @@ type Protocol struct {
 	// attempts to create connections to them.
 	DialCandidates enode.Iterator
 
+	// DialFilter, if non-nil, rejects discovered nodes which should not be dialed, for example
+	// by the protocol entry of their node record. The records of nodes found by the v4 discovery
+	// are mostly not resolved, so the ones without an entry keyed by the protocol name are
+	// not passed to the filter, and are checked by the handshake instead.
+	DialFilter func(*enode.Node) bool
+
 	// Attributes contains protocol specific information for the node record.
 	Attributes []enr.Entry
 }
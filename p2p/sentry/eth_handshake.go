@@ -19,6 +19,8 @@ package sentry
 import (
 	"fmt"
 
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/direct"
 	"github.com/erigontech/erigon-lib/gointerfaces"
 	proto_sentry "github.com/erigontech/erigon-lib/gointerfaces/sentryproto"
 	"github.com/erigontech/erigon/core/forkid"
@@ -26,17 +28,38 @@ import (
 	"github.com/erigontech/erigon/p2p"
 )
 
+// peerHead is the head of the chain announced by a peer in its status message.
+type peerHead struct {
+	hash   libcommon.Hash
+	height uint64 // only announced since eth/69, zero for older versions
+}
+
 func readAndValidatePeerStatusMessage(
 	rw p2p.MsgReadWriter,
 	status *proto_sentry.StatusData,
 	version uint,
 	minVersion uint,
-) (*eth.StatusPacket, *p2p.PeerError) {
+) (*peerHead, *p2p.PeerError) {
 	msg, err := rw.ReadMsg()
 	if err != nil {
 		return nil, p2p.NewPeerError(p2p.PeerErrorStatusReceive, p2p.DiscNetworkError, err, "readAndValidatePeerStatusMessage rw.ReadMsg error")
 	}
 
+	if version >= direct.ETH69 {
+		reply, err := tryDecodeStatus69Message(&msg)
+		msg.Discard()
+		if err != nil {
+			return nil, p2p.NewPeerError(p2p.PeerErrorStatusDecode, p2p.DiscProtocolError, err, "readAndValidatePeerStatusMessage tryDecodeStatus69Message error")
+		}
+
+		err = checkPeerStatus69Compatibility(reply, status, version, minVersion)
+		if err != nil {
+			return nil, p2p.NewPeerError(p2p.PeerErrorStatusIncompatible, p2p.DiscUselessPeer, err, "readAndValidatePeerStatusMessage checkPeerStatus69Compatibility error")
+		}
+
+		return &peerHead{hash: reply.LatestBlockHash, height: reply.LatestBlock}, nil
+	}
+
 	reply, err := tryDecodeStatusMessage(&msg)
 	msg.Discard()
 	if err != nil {
@@ -48,16 +71,23 @@ func readAndValidatePeerStatusMessage(
 		return nil, p2p.NewPeerError(p2p.PeerErrorStatusIncompatible, p2p.DiscUselessPeer, err, "readAndValidatePeerStatusMessage checkPeerStatusCompatibility error")
 	}
 
-	return reply, nil
+	return &peerHead{hash: reply.Head}, nil
 }
 
-func tryDecodeStatusMessage(msg *p2p.Msg) (*eth.StatusPacket, error) {
+func checkStatusMessageHeader(msg *p2p.Msg) error {
 	if msg.Code != eth.StatusMsg {
-		return nil, fmt.Errorf("first msg has code %x (!= %x)", msg.Code, eth.StatusMsg)
+		return fmt.Errorf("first msg has code %x (!= %x)", msg.Code, eth.StatusMsg)
 	}
 
 	if msg.Size > eth.ProtocolMaxMsgSize {
-		return nil, fmt.Errorf("message is too large %d, limit %d", msg.Size, eth.ProtocolMaxMsgSize)
+		return fmt.Errorf("message is too large %d, limit %d", msg.Size, eth.ProtocolMaxMsgSize)
+	}
+	return nil
+}
+
+func tryDecodeStatusMessage(msg *p2p.Msg) (*eth.StatusPacket, error) {
+	if err := checkStatusMessageHeader(msg); err != nil {
+		return nil, err
 	}
 
 	var reply eth.StatusPacket
@@ -68,6 +98,37 @@ func tryDecodeStatusMessage(msg *p2p.Msg) (*eth.StatusPacket, error) {
 	return &reply, nil
 }
 
+func tryDecodeStatus69Message(msg *p2p.Msg) (*eth.StatusPacket69, error) {
+	if err := checkStatusMessageHeader(msg); err != nil {
+		return nil, err
+	}
+
+	var reply eth.StatusPacket69
+	if err := msg.Decode(&reply); err != nil {
+		return nil, fmt.Errorf("decode message %v: %w", msg, err)
+	}
+
+	return &reply, nil
+}
+
+func checkPeerStatus69Compatibility(
+	reply *eth.StatusPacket69,
+	status *proto_sentry.StatusData,
+	version uint,
+	minVersion uint,
+) error {
+	if reply.EarliestBlock > reply.LatestBlock {
+		return fmt.Errorf("invalid block range: earliest %d, latest %d", reply.EarliestBlock, reply.LatestBlock)
+	}
+	return checkPeerStatusCompatibility(&eth.StatusPacket{
+		ProtocolVersion: reply.ProtocolVersion,
+		NetworkID:       reply.NetworkID,
+		Head:            reply.LatestBlockHash,
+		Genesis:         reply.Genesis,
+		ForkID:          reply.ForkID,
+	}, status, version, minVersion)
+}
+
 func checkPeerStatusCompatibility(
 	reply *eth.StatusPacket,
 	status *proto_sentry.StatusData,
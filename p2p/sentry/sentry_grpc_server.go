@@ -55,6 +55,7 @@ import (
 	"github.com/erigontech/erigon/cmd/utils"
 	"github.com/erigontech/erigon/core/forkid"
 	"github.com/erigontech/erigon/eth/protocols/eth"
+	"github.com/erigontech/erigon/eth/protocols/snap"
 	"github.com/erigontech/erigon/p2p"
 	"github.com/erigontech/erigon/p2p/dnsdisc"
 	"github.com/erigontech/erigon/p2p/enode"
@@ -66,6 +67,8 @@ const (
 	// complete before dropping the connection.= as malicious.
 	handshakeTimeout  = 5 * time.Second
 	maxPermitsPerPeer = 4 // How many outstanding requests per peer we may have
+	// blockRangeUpdateInterval is how many new blocks trigger a BlockRangeUpdate announcement to eth/69 peers
+	blockRangeUpdateInterval = 32
 )
 
 // PeerInfo collects various extra bits of information about the peer,
@@ -301,10 +304,10 @@ func handShake(
 	rw p2p.MsgReadWriter,
 	version uint,
 	minVersion uint,
-) (*libcommon.Hash, *p2p.PeerError) {
+) (*peerHead, *p2p.PeerError) {
 	// Send out own handshake in a new thread
 	errChan := make(chan *p2p.PeerError, 2)
-	resultChan := make(chan *eth.StatusPacket, 1)
+	resultChan := make(chan *peerHead, 1)
 
 	ourTD := gointerfaces.ConvertH256ToUint256Int(status.TotalDifficulty)
 	// Convert proto status data into the one required by devp2p
@@ -312,15 +315,29 @@ func handShake(
 
 	go func() {
 		defer debug.LogPanic()
-		status := &eth.StatusPacket{
-			ProtocolVersion: uint32(version),
-			NetworkID:       status.NetworkId,
-			TD:              ourTD.ToBig(),
-			Head:            gointerfaces.ConvertH256ToHash(status.BestHash),
-			Genesis:         genesisHash,
-			ForkID:          forkid.NewIDFromForks(status.ForkData.HeightForks, status.ForkData.TimeForks, genesisHash, status.MaxBlockHeight, status.MaxBlockTime),
+		forkID := forkid.NewIDFromForks(status.ForkData.HeightForks, status.ForkData.TimeForks, genesisHash, status.MaxBlockHeight, status.MaxBlockTime)
+		var packet eth.Packet
+		if version >= direct.ETH69 {
+			packet = &eth.StatusPacket69{
+				ProtocolVersion: uint32(version),
+				NetworkID:       status.NetworkId,
+				Genesis:         genesisHash,
+				ForkID:          forkID,
+				EarliestBlock:   status.MinimumBlockHeight,
+				LatestBlock:     status.MaxBlockHeight,
+				LatestBlockHash: gointerfaces.ConvertH256ToHash(status.BestHash),
+			}
+		} else {
+			packet = &eth.StatusPacket{
+				ProtocolVersion: uint32(version),
+				NetworkID:       status.NetworkId,
+				TD:              ourTD.ToBig(),
+				Head:            gointerfaces.ConvertH256ToHash(status.BestHash),
+				Genesis:         genesisHash,
+				ForkID:          forkID,
+			}
 		}
-		err := p2p.Send(rw, eth.StatusMsg, status)
+		err := p2p.Send(rw, eth.StatusMsg, packet)
 
 		if err == nil {
 			errChan <- nil
@@ -331,10 +348,10 @@ func handShake(
 
 	go func() {
 		defer debug.LogPanic()
-		status, err := readAndValidatePeerStatusMessage(rw, status, version, minVersion)
+		head, err := readAndValidatePeerStatusMessage(rw, status, version, minVersion)
 
 		if err == nil {
-			resultChan <- status
+			resultChan <- head
 			errChan <- nil
 		} else {
 			errChan <- err
@@ -356,8 +373,7 @@ func handShake(
 		}
 	}
 
-	peerStatus := <-resultChan
-	return &peerStatus.Head, nil
+	return <-resultChan, nil
 }
 
 func runPeer(
@@ -533,6 +549,28 @@ func runPeer(
 				logger.Error(fmt.Sprintf("%s: reading msg into bytes: %v", peerID, err))
 			}
 			send(eth.ToProto[protocol][msg.Code], peerID, b)
+		case eth.BlockRangeUpdateMsg:
+			if protocol < direct.ETH69 {
+				logger.Error(fmt.Sprintf("[p2p] Unknown message code: %d, peerID=%x", msg.Code, peerID))
+				break
+			}
+			b := make([]byte, msg.Size)
+			if _, err := io.ReadFull(msg.Payload, b); err != nil {
+				logger.Error(fmt.Sprintf("%s: reading msg into bytes: %v", peerID, err))
+			}
+			var update eth.BlockRangeUpdatePacket
+			if err := rlp.DecodeBytes(b, &update); err != nil {
+				msg.Discard()
+				return p2p.NewPeerError(p2p.PeerErrorInvalidMessage, p2p.DiscProtocolError, err, "sentry.runPeer: invalid BlockRangeUpdate message")
+			}
+			if update.EarliestBlock > update.LatestBlock || update.LatestBlockHash == (libcommon.Hash{}) {
+				msg.Discard()
+				return p2p.NewPeerError(p2p.PeerErrorInvalidMessage, p2p.DiscProtocolError, nil, fmt.Sprintf("sentry.runPeer: invalid block range %d-%d", update.EarliestBlock, update.LatestBlock))
+			}
+			peerInfo.SetIncreasedHeight(update.LatestBlock)
+			if hasSubscribers(eth.ToProto[protocol][msg.Code]) {
+				send(eth.ToProto[protocol][msg.Code], peerID, b)
+			}
 		case 11:
 			// Ignore
 			// TODO: Investigate why BSC peers for eth/67 send these messages
@@ -614,11 +652,17 @@ func NewGrpcServer(ctx context.Context, dialCandidates func() enode.Iterator, re
 		disc, _ = setupDiscovery(ss.p2p.DiscoveryDNS)
 	}
 
+	length := uint64(17)
+	if protocol >= direct.ETH69 {
+		length = 18 // BlockRangeUpdateMsg
+	}
+
 	ss.Protocols = append(ss.Protocols, p2p.Protocol{
 		Name:           eth.ProtocolName,
 		Version:        protocol,
-		Length:         17,
+		Length:         length,
 		DialCandidates: disc,
+		DialFilter:     eth.NewNodeFilter(ss.forkFilter),
 		Run: func(peer *p2p.Peer, rw p2p.MsgReadWriter) *p2p.PeerError {
 			peerID := peer.Pubkey()
 			printablePeerID := hex.EncodeToString(peerID[:])[:20]
@@ -639,10 +683,11 @@ func NewGrpcServer(ctx context.Context, dialCandidates func() enode.Iterator, re
 				return p2p.NewPeerError(p2p.PeerErrorLocalStatusNeeded, p2p.DiscProtocolError, nil, "could not get status message from core")
 			}
 
-			peerBestHash, err := handShake(ctx, status, rw, protocol, protocol)
+			peerHead, err := handShake(ctx, status, rw, protocol, protocol)
 			if err != nil {
 				return err
 			}
+			peerInfo.SetIncreasedHeight(peerHead.height)
 
 			// handshake is successful
 			logger.Trace("[p2p] Received status message OK", "peerId", printablePeerID, "name", peer.Name())
@@ -650,7 +695,7 @@ func NewGrpcServer(ctx context.Context, dialCandidates func() enode.Iterator, re
 			ss.GoodPeers.Store(peerID, peerInfo)
 			ss.sendNewPeerToClients(gointerfaces.ConvertHashToH512(peerID))
 			defer ss.sendGonePeerToClients(gointerfaces.ConvertHashToH512(peerID))
-			getBlockHeadersErr := ss.getBlockHeaders(ctx, *peerBestHash, peerID)
+			getBlockHeadersErr := ss.getBlockHeaders(ctx, peerHead.hash, peerID)
 			if getBlockHeadersErr != nil {
 				return p2p.NewPeerError(p2p.PeerErrorFirstMessageSend, p2p.DiscNetworkError, getBlockHeadersErr, "p2p.Protocol.Run getBlockHeaders failure")
 			}
@@ -681,6 +726,34 @@ func NewGrpcServer(ctx context.Context, dialCandidates func() enode.Iterator, re
 	return ss
 }
 
+// EnableSnap advertises the snap/1 protocol next to eth, answering requests with
+// the given server. It must be called before the p2p server is started. Only
+// peers which also run the eth protocol are served.
+func (ss *GrpcServer) EnableSnap(server *snap.Server) {
+	ss.Protocols = append(ss.Protocols, p2p.Protocol{
+		Name:    snap.ProtocolName,
+		Version: snap.SNAP1,
+		Length:  snap.ProtocolLength,
+		Run: func(peer *p2p.Peer, rw p2p.MsgReadWriter) *p2p.PeerError {
+			if !peer.RunningCap(eth.ProtocolName, []uint{ss.Protocols[0].Version}) {
+				return p2p.NewPeerError(p2p.PeerErrorDiscReason, p2p.DiscUselessPeer, nil, "snap peer without eth")
+			}
+			for {
+				if err := libcommon.Stopped(ss.ctx.Done()); err != nil {
+					return p2p.NewPeerError(p2p.PeerErrorDiscReason, p2p.DiscQuitting, ss.ctx.Err(), "sentry.snap: context stopped")
+				}
+				msg, err := rw.ReadMsg()
+				if err != nil {
+					return p2p.NewPeerError(p2p.PeerErrorMessageReceive, p2p.DiscNetworkError, err, "sentry.snap: ReadMsg error")
+				}
+				if err := server.HandleMessage(ss.ctx, msg, rw); err != nil {
+					return p2p.NewPeerError(p2p.PeerErrorInvalidMessage, p2p.DiscSubprotocolError, err, "sentry.snap: invalid request")
+				}
+			}
+		},
+	})
+}
+
 // Sentry creates and runs standalone sentry
 func Sentry(ctx context.Context, dirs datadir.Dirs, sentryAddr string, discoveryDNS []string, cfg *p2p.Config, protocolVersion uint, healthCheck bool, logger log.Logger) error {
 	dir.MustExist(dirs.DataDir)
@@ -716,6 +789,7 @@ type GrpcServer struct {
 	p2pServerLock        sync.RWMutex
 	statusData           *proto_sentry.StatusData
 	statusDataLock       sync.RWMutex
+	lastBlockRangeUpdate uint64 // latest block announced to eth/69 peers, guarded by statusDataLock
 	messageStreams       map[proto_sentry.MessageId]map[uint64]chan *proto_sentry.InboundMessage
 	messagesSubscriberID uint64
 	messageStreamsLock   sync.RWMutex
@@ -806,6 +880,24 @@ func (ss *GrpcServer) PenalizePeer(_ context.Context, req *proto_sentry.Penalize
 	return &emptypb.Empty{}, nil
 }
 
+func (ss *GrpcServer) ScorePeer(_ context.Context, req *proto_sentry.ScorePeerRequest) (*emptypb.Empty, error) {
+	p2pServer := ss.getP2PServer()
+	if p2pServer == nil || p2pServer.Reputation() == nil {
+		return &emptypb.Empty{}, nil
+	}
+	peerID := ConvertH512ToPeerID(req.PeerId)
+	score := p2pServer.Reputation().Update(enode.PubkeyEncoded(peerID).ID(), p2p.ReputationEvent(req.Event))
+	if score > p2p.LowReputation {
+		return &emptypb.Empty{}, nil
+	}
+	peerInfo := ss.getPeer(peerID)
+	if peerInfo != nil && !peerInfo.peer.Info().Network.Static && !peerInfo.peer.Info().Network.Trusted {
+		ss.logger.Debug("[sentry] disconnecting peer with low reputation", "peer", peerInfo.peer.ID(), "score", score)
+		ss.removePeer(peerID, p2p.NewPeerError(p2p.PeerErrorDiscReason, p2p.DiscRequested, nil, "low reputation"))
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (ss *GrpcServer) PeerMinBlock(_ context.Context, req *proto_sentry.PeerMinBlockRequest) (*emptypb.Empty, error) {
 	peerID := ConvertH512ToPeerID(req.PeerId)
 	if peerInfo := ss.getPeer(peerID); peerInfo != nil {
@@ -999,6 +1091,8 @@ func (ss *GrpcServer) HandShake(context.Context, *emptypb.Empty) (*proto_sentry.
 		reply.Protocol = proto_sentry.Protocol_ETH67
 	case direct.ETH68:
 		reply.Protocol = proto_sentry.Protocol_ETH68
+	case direct.ETH69:
+		reply.Protocol = proto_sentry.Protocol_ETH69
 	}
 	return reply, nil
 }
@@ -1033,6 +1127,16 @@ func (ss *GrpcServer) startP2PServer(genesisHash libcommon.Hash) (*p2p.Server, e
 	return srv, nil
 }
 
+// forkFilter checks fork IDs against the current chain, nil until the status is known
+func (ss *GrpcServer) forkFilter() forkid.Filter {
+	status := ss.GetStatus()
+	if status == nil {
+		return nil
+	}
+	genesisHash := gointerfaces.ConvertH256ToHash(status.ForkData.Genesis)
+	return forkid.NewFilterFromForks(status.ForkData.HeightForks, status.ForkData.TimeForks, genesisHash, status.MaxBlockHeight, status.MaxBlockTime)
+}
+
 func (ss *GrpcServer) getP2PServer() *p2p.Server {
 	ss.p2pServerLock.RLock()
 	defer ss.p2pServerLock.RUnlock()
@@ -1040,16 +1144,28 @@ func (ss *GrpcServer) getP2PServer() *p2p.Server {
 }
 
 func (ss *GrpcServer) SetStatus(ctx context.Context, statusData *proto_sentry.StatusData) (*proto_sentry.SetStatusReply, error) {
-	genesisHash := gointerfaces.ConvertH256ToHash(statusData.ForkData.Genesis)
-
 	reply := &proto_sentry.SetStatusReply{}
+	rangeUpdate, err := ss.setStatus(statusData)
+	if err != nil {
+		return reply, err
+	}
+	if rangeUpdate != nil {
+		// sent without holding the locks, for a slow peer not to block status readers
+		ss.sendBlockRangeUpdate(rangeUpdate)
+	}
+	return reply, nil
+}
+
+// setStatus stores the status, and returns the BlockRangeUpdate to announce to the eth/69 peers if it's time to
+func (ss *GrpcServer) setStatus(statusData *proto_sentry.StatusData) (*eth.BlockRangeUpdatePacket, error) {
+	genesisHash := gointerfaces.ConvertH256ToHash(statusData.ForkData.Genesis)
 
 	ss.p2pServerLock.Lock()
 	defer ss.p2pServerLock.Unlock()
 	if ss.p2pServer == nil {
 		srv, err := ss.startP2PServer(genesisHash)
 		if err != nil {
-			return reply, err
+			return nil, err
 		}
 		ss.p2pServer = srv
 	}
@@ -1062,7 +1178,30 @@ func (ss *GrpcServer) SetStatus(ctx context.Context, statusData *proto_sentry.St
 		// Not overwrite statusData if the message contains zero MaxBlock (comes from standalone transaction pool)
 		ss.statusData = statusData
 	}
-	return reply, nil
+	if statusData.MaxBlockHeight < ss.lastBlockRangeUpdate+blockRangeUpdateInterval {
+		return nil, nil
+	}
+	ss.lastBlockRangeUpdate = statusData.MaxBlockHeight
+	return &eth.BlockRangeUpdatePacket{
+		EarliestBlock:   statusData.MinimumBlockHeight,
+		LatestBlock:     statusData.MaxBlockHeight,
+		LatestBlockHash: gointerfaces.ConvertH256ToHash(statusData.BestHash),
+	}, nil
+}
+
+// sendBlockRangeUpdate announces the range of blocks we can serve to the eth/69 peers
+func (ss *GrpcServer) sendBlockRangeUpdate(rangeUpdate *eth.BlockRangeUpdatePacket) {
+	data, err := rlp.EncodeToBytes(rangeUpdate)
+	if err != nil {
+		ss.logger.Error("[sentry] could not encode BlockRangeUpdate", "err", err)
+		return
+	}
+	ss.rangePeers(func(peerInfo *PeerInfo) bool {
+		if peerInfo.protocol >= direct.ETH69 {
+			ss.writePeer("[sentry] sendBlockRangeUpdate", peerInfo, eth.BlockRangeUpdateMsg, data, 0)
+		}
+		return true
+	})
 }
 
 func (ss *GrpcServer) Peers(_ context.Context, _ *emptypb.Empty) (*proto_sentry.PeersReply, error) {
@@ -1088,6 +1227,7 @@ func (ss *GrpcServer) Peers(_ context.Context, _ *emptypb.Empty) (*proto_sentry.
 			ConnIsInbound:  peer.Network.Inbound,
 			ConnIsTrusted:  peer.Network.Trusted,
 			ConnIsStatic:   peer.Network.Static,
+			Score:          peer.Score,
 		}
 		reply.Peers = append(reply.Peers, &rpcPeer)
 	}
@@ -1134,6 +1274,9 @@ func (ss *GrpcServer) PeerById(_ context.Context, req *proto_sentry.PeerByIdRequ
 			ConnIsTrusted:  peer.Network.Trusted,
 			ConnIsStatic:   peer.Network.Static,
 		}
+		if p2pServer := ss.getP2PServer(); p2pServer != nil && p2pServer.Reputation() != nil {
+			rpcPeer.Score = p2pServer.Reputation().Score(sentryPeer.peer.ID())
+		}
 	}
 
 	return &proto_sentry.PeerByIdReply{Peer: rpcPeer}, nil
@@ -168,5 +168,35 @@ func (cs *MultiClient) Penalize(ctx context.Context, penalties []headerdownload.
 				cs.logger.Error("Could not send penalty", "err", err1)
 			}
 		}
+		if event, ok := penaltyReputationEvent(penalties[i].Penalty); ok {
+			cs.ScorePeer(ctx, penalties[i].PeerID, event)
+		}
+	}
+}
+
+// ScorePeer reports the event to all sentries, so the reputation of the peer is kept by the sentry it is connected to
+func (cs *MultiClient) ScorePeer(ctx context.Context, peerID [64]byte, event proto_sentry.ReputationEvent) {
+	outreq := proto_sentry.ScorePeerRequest{
+		PeerId: gointerfaces.ConvertHashToH512(peerID),
+		Event:  event,
+	}
+	for _, sentry := range cs.sentries {
+		if ready, ok := sentry.(interface{ Ready() bool }); ok && !ready.Ready() {
+			continue
+		}
+		if _, err := sentry.ScorePeer(ctx, &outreq, &grpc.EmptyCallOption{}); err != nil {
+			cs.logger.Debug("Could not send peer score", "event", event, "err", err)
+		}
+	}
+}
+
+func penaltyReputationEvent(penalty headerdownload.Penalty) (proto_sentry.ReputationEvent, bool) {
+	switch penalty {
+	case headerdownload.NoPenalty:
+		return 0, false
+	case headerdownload.AbandonedAnchorPenalty:
+		return proto_sentry.ReputationEvent_RequestTimeout, true
+	default:
+		return proto_sentry.ReputationEvent_InvalidBlock, true
 	}
 }
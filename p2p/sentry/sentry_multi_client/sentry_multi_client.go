@@ -80,6 +80,7 @@ func (cs *MultiClient) RecvUploadMessageLoop(
 	ids := []proto_sentry.MessageId{
 		eth.ToProto[direct.ETH67][eth.GetBlockBodiesMsg],
 		eth.ToProto[direct.ETH67][eth.GetReceiptsMsg],
+		eth.ToProto[direct.ETH69][eth.GetReceiptsMsg],
 	}
 	streamFactory := func(streamCtx context.Context, sentry proto_sentry.SentryClient) (grpc.ClientStream, error) {
 		return sentry.Messages(streamCtx, &proto_sentry.MessagesRequest{Ids: ids}, grpc.WaitForReady(true))
@@ -227,6 +228,9 @@ func NewMultiClient(
 		getReceiptsActiveGoroutineNumber:  semaphore.NewWeighted(1),
 		ethApiWrapper:                     receipts.NewGenerator(blockReader, engine),
 	}
+	bd.OnRequestTimeout = func(peerID [64]byte) {
+		cs.ScorePeer(context.Background(), peerID, proto_sentry.ReputationEvent_RequestTimeout)
+	}
 
 	return cs, nil
 }
@@ -346,16 +350,21 @@ func (cs *MultiClient) blockHeaders(ctx context.Context, pkt eth.BlockHeadersPac
 			return err
 		}
 		defer tx.Rollback()
-		penalties, err := cs.Hd.ProcessHeadersPOS(csHeaders, tx, sentry.ConvertH512ToPeerID(peerID))
+		penalties, accepted, err := cs.Hd.ProcessHeadersPOS(csHeaders, tx, sentry.ConvertH512ToPeerID(peerID))
 		if err != nil {
 			return err
 		}
 		if len(penalties) > 0 {
 			cs.Penalize(ctx, penalties)
+		} else if accepted {
+			cs.scorePeer(ctx, sentryClient, peerID, proto_sentry.ReputationEvent_UsefulHeaders)
 		}
 	} else {
 		sort.Sort(headerdownload.HeadersSort(csHeaders)) // Sorting by order of block heights
-		canRequestMore := cs.Hd.ProcessHeaders(csHeaders, false /* newBlock */, sentry.ConvertH512ToPeerID(peerID))
+		canRequestMore, accepted := cs.Hd.ProcessHeaders(csHeaders, false /* newBlock */, sentry.ConvertH512ToPeerID(peerID))
+		if accepted {
+			cs.scorePeer(ctx, sentryClient, peerID, proto_sentry.ReputationEvent_UsefulHeaders)
+		}
 
 		if canRequestMore {
 			currentTime := time.Now()
@@ -475,13 +484,36 @@ func (cs *MultiClient) blockBodies66(ctx context.Context, inreq *proto_sentry.In
 		return nil
 	}
 	cs.Bd.DeliverBodies(txs, uncles, withdrawals, uint64(len(inreq.Data)), sentry.ConvertH512ToPeerID(inreq.PeerId))
+	cs.scorePeer(ctx, sentryClient, inreq.PeerId, proto_sentry.ReputationEvent_UsefulBodies)
 	return nil
 }
 
+// scorePeer reports the event to the sentry which delivered the message from the peer
+func (cs *MultiClient) scorePeer(ctx context.Context, sentryClient proto_sentry.SentryClient, peerID *proto_types.H512, event proto_sentry.ReputationEvent) {
+	outreq := proto_sentry.ScorePeerRequest{
+		PeerId: peerID,
+		Event:  event,
+	}
+	if _, err := sentryClient.ScorePeer(ctx, &outreq, &grpc.EmptyCallOption{}); err != nil {
+		cs.logger.Debug("Could not send peer score", "event", event, "err", err)
+	}
+}
+
 func (cs *MultiClient) receipts66(_ context.Context, _ *proto_sentry.InboundMessage, _ proto_sentry.SentryClient) error {
 	return nil
 }
 
+// receipts69 - receipts are not requested from peers, but an eth/69 response must still decode to
+// receipts with re-computed blooms, otherwise the peer is penalized.
+func (cs *MultiClient) receipts69(_ context.Context, inreq *proto_sentry.InboundMessage, _ proto_sentry.SentryClient) error {
+	var query eth.ReceiptsPacket69
+	if err := rlp.DecodeBytes(inreq.Data, &query); err != nil {
+		return fmt.Errorf("decoding receipts69: %w, data: %x", err, inreq.Data)
+	}
+	cs.logger.Trace("[p2p] receipts69", "requestId", query.RequestId, "blocks", len(query.Receipts), "peer", sentry.ConvertH512ToPeerID(inreq.PeerId))
+	return nil
+}
+
 func (cs *MultiClient) getBlockHeaders66(ctx context.Context, inreq *proto_sentry.InboundMessage, sentry proto_sentry.SentryClient) error {
 	var query eth.GetBlockHeadersPacket66
 	if err := rlp.DecodeBytes(inreq.Data, &query); err != nil {
@@ -572,14 +604,23 @@ var (
 )
 
 func (cs *MultiClient) getReceipts66(ctx context.Context, inreq *proto_sentry.InboundMessage, sentryClient proto_sentry.SentryClient) error {
+	return cs.getReceipts(ctx, inreq, sentryClient, direct.ETH68)
+}
+
+// getReceipts69 - same as getReceipts66, but the receipts are served without blooms
+func (cs *MultiClient) getReceipts69(ctx context.Context, inreq *proto_sentry.InboundMessage, sentryClient proto_sentry.SentryClient) error {
+	return cs.getReceipts(ctx, inreq, sentryClient, direct.ETH69)
+}
+
+func (cs *MultiClient) getReceipts(ctx context.Context, inreq *proto_sentry.InboundMessage, sentryClient proto_sentry.SentryClient, version uint) error {
 	if !EnableP2PReceipts {
 		return nil
 	}
 	var query eth.GetReceiptsPacket66
 	if err := rlp.DecodeBytes(inreq.Data, &query); err != nil {
-		return fmt.Errorf("decoding getReceipts66: %w, data: %x", err, inreq.Data)
+		return fmt.Errorf("decoding getReceipts%d: %w, data: %x", version, err, inreq.Data)
 	}
-	cachedReceipts, needMore, err := eth.AnswerGetReceiptsQueryCacheOnly(ctx, cs.ethApiWrapper, query.GetReceiptsPacket)
+	cachedReceipts, needMore, err := eth.AnswerGetReceiptsQueryCacheOnly(ctx, cs.ethApiWrapper, query.GetReceiptsPacket, version)
 	if err != nil {
 		return err
 	}
@@ -599,7 +640,7 @@ func (cs *MultiClient) getReceipts66(ctx context.Context, inreq *proto_sentry.In
 			return err
 		}
 		defer tx.Rollback()
-		receiptsList, err = eth.AnswerGetReceiptsQuery(ctx, cs.ChainConfig, cs.ethApiWrapper, cs.blockReader, tx, query.GetReceiptsPacket, cachedReceipts)
+		receiptsList, err = eth.AnswerGetReceiptsQuery(ctx, cs.ChainConfig, cs.ethApiWrapper, cs.blockReader, tx, query.GetReceiptsPacket, cachedReceipts, version)
 		if err != nil {
 			return err
 		}
@@ -615,7 +656,7 @@ func (cs *MultiClient) getReceipts66(ctx context.Context, inreq *proto_sentry.In
 	outreq := proto_sentry.SendMessageByIdRequest{
 		PeerId: inreq.PeerId,
 		Data: &proto_sentry.OutboundMessageData{
-			Id:   proto_sentry.MessageId_RECEIPTS_66,
+			Id:   eth.ToProto[version][eth.ReceiptsMsg],
 			Data: b,
 		},
 	}
@@ -676,6 +717,12 @@ func (cs *MultiClient) handleInboundMessage(ctx context.Context, inreq *proto_se
 		return cs.receipts66(ctx, inreq, sentry)
 	case proto_sentry.MessageId_GET_RECEIPTS_66:
 		return cs.getReceipts66(ctx, inreq, sentry)
+	// ========= eth 69 ==========
+
+	case proto_sentry.MessageId_RECEIPTS_69:
+		return cs.receipts69(ctx, inreq, sentry)
+	case proto_sentry.MessageId_GET_RECEIPTS_69:
+		return cs.getReceipts69(ctx, inreq, sentry)
 	default:
 		return fmt.Errorf("not implemented for message Id: %s", inreq.Id)
 	}
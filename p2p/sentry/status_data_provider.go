@@ -31,9 +31,13 @@ import (
 	"github.com/erigontech/erigon-lib/gointerfaces"
 	proto_sentry "github.com/erigontech/erigon-lib/gointerfaces/sentryproto"
 	"github.com/erigontech/erigon-lib/kv"
+	"github.com/erigontech/erigon-lib/kv/rawdbv3"
 	"github.com/erigontech/erigon/core/forkid"
 	"github.com/erigontech/erigon/core/rawdb"
+	"github.com/erigontech/erigon/core/state"
 	"github.com/erigontech/erigon/core/types"
+	"github.com/erigontech/erigon/turbo/services"
+	"github.com/erigontech/erigon/turbo/snapshotsync/freezeblocks"
 )
 
 var ErrNoHead = errors.New("ReadChainHead: ReadCurrentHeader error")
@@ -46,7 +50,8 @@ type ChainHead struct {
 }
 
 type StatusDataProvider struct {
-	db kv.RoDB
+	db          kv.RoDB
+	blockReader services.FullBlockReader
 
 	networkId   uint64
 	genesisHash libcommon.Hash
@@ -59,6 +64,7 @@ type StatusDataProvider struct {
 
 func NewStatusDataProvider(
 	db kv.RoDB,
+	blockReader services.FullBlockReader,
 	chainConfig *chain.Config,
 	genesis *types.Block,
 	networkId uint64,
@@ -66,6 +72,7 @@ func NewStatusDataProvider(
 ) *StatusDataProvider {
 	s := &StatusDataProvider{
 		db:          db,
+		blockReader: blockReader,
 		networkId:   networkId,
 		genesisHash: genesis.Hash(),
 		genesisHead: makeGenesisChainHead(genesis),
@@ -103,13 +110,14 @@ func makeGenesisChainHead(genesis *types.Block) ChainHead {
 	}
 }
 
-func (s *StatusDataProvider) makeStatusData(head ChainHead) *proto_sentry.StatusData {
+func (s *StatusDataProvider) makeStatusData(head ChainHead, earliestBlock uint64) *proto_sentry.StatusData {
 	return &proto_sentry.StatusData{
-		NetworkId:       s.networkId,
-		TotalDifficulty: gointerfaces.ConvertUint256IntToH256(head.HeadTd),
-		BestHash:        gointerfaces.ConvertHashToH256(head.HeadHash),
-		MaxBlockHeight:  head.HeadHeight,
-		MaxBlockTime:    head.HeadTime,
+		NetworkId:          s.networkId,
+		TotalDifficulty:    gointerfaces.ConvertUint256IntToH256(head.HeadTd),
+		BestHash:           gointerfaces.ConvertHashToH256(head.HeadHash),
+		MaxBlockHeight:     head.HeadHeight,
+		MaxBlockTime:       head.HeadTime,
+		MinimumBlockHeight: earliestBlock,
 		ForkData: &proto_sentry.Forks{
 			Genesis:     gointerfaces.ConvertHashToH256(s.genesisHash),
 			HeightForks: s.heightForks,
@@ -119,15 +127,52 @@ func (s *StatusDataProvider) makeStatusData(head ChainHead) *proto_sentry.Status
 }
 
 func (s *StatusDataProvider) GetStatusData(ctx context.Context) (*proto_sentry.StatusData, error) {
-	chainHead, err := ReadChainHead(ctx, s.db)
+	var chainHead ChainHead
+	var earliestBlock uint64
+	err := s.db.View(ctx, func(tx kv.Tx) (err error) {
+		if chainHead, err = ReadChainHeadWithTx(tx); err != nil {
+			return err
+		}
+		earliestBlock, err = s.readEarliestBlock(ctx, tx)
+		return err
+	})
 	if err != nil {
 		if errors.Is(err, ErrNoHead) {
 			s.logger.Warn("sentry.StatusDataProvider: The canonical chain current header not found in the database. Check the database consistency. Using genesis as a fallback.")
-			return s.makeStatusData(s.genesisHead), nil
+			return s.makeStatusData(s.genesisHead, 0), nil
 		}
 		return nil, err
 	}
-	return s.makeStatusData(chainHead), err
+	return s.makeStatusData(chainHead, min(earliestBlock, chainHead.HeadHeight)), nil
+}
+
+// readEarliestBlock returns the first block whose receipts can be served. Receipts are re-created by
+// executing the block, so it is the first block whose state is still in the history.
+func (s *StatusDataProvider) readEarliestBlock(ctx context.Context, tx kv.Tx) (uint64, error) {
+	ttx, ok := tx.(kv.TemporalTx)
+	if !ok || s.blockReader == nil {
+		return 0, nil
+	}
+	historyReader := state.NewHistoryReaderV3()
+	historyReader.SetTx(ttx)
+	historyStart := historyReader.StateHistoryStartFrom()
+	if historyStart == 0 {
+		return 0, nil
+	}
+	txNumsReader := rawdbv3.TxNums.WithCustomReadTxNumFunc(freezeblocks.ReadTxNumFuncFromBlockReader(ctx, s.blockReader))
+	ok, blockNum, err := txNumsReader.FindBlockNum(tx, historyStart)
+	if err != nil || !ok {
+		return 0, err
+	}
+	minTxNum, err := txNumsReader.Min(tx, blockNum)
+	if err != nil {
+		return 0, err
+	}
+	// The history starts in the middle of the block, only the next one can be re-executed.
+	if minTxNum < historyStart {
+		blockNum++
+	}
+	return blockNum, nil
 }
 
 func ReadChainHeadWithTx(tx kv.Tx) (ChainHead, error) {
@@ -41,6 +41,7 @@ import (
 	"github.com/erigontech/erigon-lib/common/mclock"
 	"github.com/erigontech/erigon-lib/crypto"
 	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon-lib/rlp"
 	"github.com/erigontech/erigon/event"
 	"github.com/erigontech/erigon/p2p/discover"
 	"github.com/erigontech/erigon/p2p/enode"
@@ -102,6 +103,10 @@ type Config struct {
 	// protocol should be started or not.
 	DiscoveryV5 bool `toml:",omitempty"`
 
+	// DiscoveryV5Topics are advertised and searched with the V5 discovery (experimental),
+	// so nodes sharing a topic find each other quickly, e.g. over public bootnodes.
+	DiscoveryV5Topics []string `toml:",omitempty"`
+
 	// Name sets the node name of this server.
 	// Use common.MakeName to create a name that follows existing conventions.
 	Name string `toml:"-"`
@@ -216,6 +221,7 @@ type Server struct {
 	logger       log.Logger
 
 	nodedb             *enode.DB
+	reputation         *PeerReputation
 	localnode          *enode.LocalNode
 	localnodeAddrCache atomic.Pointer[string]
 	ntab               *discover.UDPv4
@@ -422,6 +428,11 @@ func (srv *Server) SubscribeEvents(ch chan *PeerEvent) event.Subscription {
 	return srv.peerFeed.Subscribe(ch)
 }
 
+// Reputation returns scores of peers, nil if the server is not started.
+func (srv *Server) Reputation() *PeerReputation {
+	return srv.reputation
+}
+
 // Self returns the local node's endpoint information.
 func (srv *Server) Self() (ln *enode.Node) {
 	if srv.localnode != nil {
@@ -447,6 +458,11 @@ func (srv *Server) Stop() {
 		// this unblocks listener Accept
 		_ = srv.listener.Close()
 	}
+	if srv.reputation != nil {
+		if err := srv.reputation.Flush(); err != nil {
+			srv.logger.Warn("[p2p] Failed to store peer reputation", "err", err)
+		}
+	}
 	if srv.nodedb != nil {
 		srv.nodedb.Close()
 	}
@@ -558,6 +574,7 @@ func (srv *Server) setupLocalNode() error {
 		return err
 	}
 	srv.nodedb = db
+	srv.reputation = NewPeerReputation(db)
 
 	srv.localnode = enode.NewLocalNode(db, srv.PrivateKey, srv.logger)
 	srv.localnode.SetFallbackIP(net.IP{127, 0, 0, 1})
@@ -605,7 +622,7 @@ func (srv *Server) setupDiscovery(ctx context.Context) error {
 	added := make(map[string]bool)
 	for _, proto := range srv.Protocols {
 		if proto.DialCandidates != nil && !added[proto.Name] {
-			srv.discmix.AddSource(proto.DialCandidates)
+			srv.discmix.AddSource(srv.filterDialCandidates(proto.DialCandidates, false))
 			added[proto.Name] = true
 		}
 	}
@@ -658,7 +675,7 @@ func (srv *Server) setupDiscovery(ctx context.Context) error {
 			return err
 		}
 		srv.ntab = ntab
-		srv.discmix.AddSource(ntab.RandomNodes())
+		srv.discmix.AddSource(srv.filterDialCandidates(ntab.RandomNodes(), true))
 	}
 
 	// Discovery V5
@@ -668,6 +685,8 @@ func (srv *Server) setupDiscovery(ctx context.Context) error {
 			NetRestrict: srv.NetRestrict,
 			Bootnodes:   srv.BootstrapNodesV5,
 			Log:         srv.logger,
+
+			TopicDiscovery: len(srv.DiscoveryV5Topics) > 0,
 		}
 		version := uint64(srv.Config.Protocols[0].Version)
 		var err error
@@ -679,10 +698,51 @@ func (srv *Server) setupDiscovery(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		srv.discmix.AddSource(srv.filterDialCandidates(srv.DiscV5.RandomNodes(), false))
+		for _, name := range srv.DiscoveryV5Topics {
+			topic := discover.NewTopic(name)
+			srv.DiscV5.RegisterTopic(topic)
+			srv.discmix.AddSource(srv.filterDialCandidates(srv.DiscV5.TopicNodes(topic), false))
+		}
 	}
 	return nil
 }
 
+// filterDialCandidates drops discovered nodes rejected by the dial filter of any protocol. If
+// unresolved is set, the nodes come with unresolved records, so nodes without the entry of a
+// protocol are let through its filter.
+func (srv *Server) filterDialCandidates(it enode.Iterator, unresolved bool) enode.Iterator {
+	var filters []func(*enode.Node) bool
+	for _, proto := range srv.Protocols {
+		if proto.DialFilter == nil {
+			continue
+		}
+		filter := proto.DialFilter
+		if unresolved {
+			dialFilter, key := proto.DialFilter, proto.Name
+			filter = func(n *enode.Node) bool {
+				var entry rlp.RawValue
+				if err := n.Load(enr.WithEntry(key, &entry)); enr.IsNotFound(err) {
+					return true
+				}
+				return dialFilter(n)
+			}
+		}
+		filters = append(filters, filter)
+	}
+	if len(filters) == 0 {
+		return it
+	}
+	return enode.Filter(it, func(n *enode.Node) bool {
+		for _, filter := range filters {
+			if !filter(n) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
 func (srv *Server) setupDialScheduler() {
 	config := dialConfig{
 		self:           srv.localnode.ID(),
@@ -692,6 +752,7 @@ func (srv *Server) setupDialScheduler() {
 		netRestrict:    srv.NetRestrict,
 		dialer:         srv.Dialer,
 		clock:          srv.clock,
+		reputation:     srv.reputation,
 	}
 	if srv.ntab != nil {
 		config.resolver = srv.ntab
@@ -794,6 +855,8 @@ func (srv *Server) run() {
 
 	logTimer := time.NewTicker(serverStatsLogInterval)
 	defer logTimer.Stop()
+	reputationTimer := time.NewTicker(reputationFlushInterval)
+	defer reputationTimer.Stop()
 
 running:
 	for {
@@ -863,6 +926,10 @@ running:
 			vals = append(vals, srv.listErrors()...)
 
 			srv.logger.Debug("[p2p] Server", vals...)
+		case <-reputationTimer.C:
+			if err := srv.reputation.Flush(); err != nil {
+				srv.logger.Warn("[p2p] Failed to store peer reputation", "err", err)
+			}
 		}
 	}
 
@@ -1192,7 +1259,11 @@ func (srv *Server) PeersInfo() []*PeerInfo {
 	infos := make([]*PeerInfo, 0, srv.PeerCount())
 	for _, peer := range srv.Peers() {
 		if peer != nil {
-			infos = append(infos, peer.Info())
+			info := peer.Info()
+			if srv.reputation != nil {
+				info.Score = srv.reputation.Score(peer.ID())
+			}
+			infos = append(infos, info)
 		}
 	}
 	// Sort the result array alphabetically by node identifier
@@ -539,6 +539,33 @@ func randomID() (id enode.ID) {
 	return id
 }
 
+// This test checks that discovered nodes are dialed only if all protocols accept them.
+func TestServerFilterDialCandidates(t *testing.T) {
+	srv := &Server{Config: Config{Protocols: []Protocol{
+		{Name: "a", DialFilter: func(n *enode.Node) bool { return n.ID()[0] != 1 }},
+		{Name: "b"},
+		{Name: "c", DialFilter: func(n *enode.Node) bool { return n.ID()[0] != 2 }},
+	}}}
+	nodes := []*enode.Node{
+		newNode(uintID(0x01), ""),
+		newNode(enode.ID{1}, ""),
+		newNode(enode.ID{2}, ""),
+	}
+	filtered := enode.ReadNodes(srv.filterDialCandidates(enode.IterNodes(nodes), false), len(nodes))
+	if len(filtered) != 1 || filtered[0].ID() != uintID(0x01) {
+		t.Fatalf("wrong dial candidates: %v", filtered)
+	}
+
+	// nodes with unresolved records are only filtered by the protocols they have an entry of
+	var r enr.Record
+	r.Set(enr.WithEntry("c", uint(1)))
+	nodes = append(nodes, enode.SignNull(&r, enode.ID{2, 1}))
+	filtered = enode.ReadNodes(srv.filterDialCandidates(enode.IterNodes(nodes), true), len(nodes))
+	if len(filtered) != 3 || filtered[2].ID() != (enode.ID{2}) {
+		t.Fatalf("wrong dial candidates: %v", filtered)
+	}
+}
+
 // This test checks that inbound connections are throttled by IP.
 func TestServerInboundThrottle(t *testing.T) {
 	logger := log.New()
@@ -0,0 +1,334 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon/core/types"
+	"github.com/erigontech/erigon/polygon/bor/borcfg"
+	bortypes "github.com/erigontech/erigon/polygon/bor/types"
+	"github.com/erigontech/erigon/polygon/heimdall"
+)
+
+type EventDiscrepancyKind string
+
+const (
+	// EventMissing is an event which Heimdall assigns to the block but the store does not.
+	EventMissing EventDiscrepancyKind = "missing"
+	// EventExtra is an event which the store assigns to the block but Heimdall does not.
+	EventExtra EventDiscrepancyKind = "extra"
+	// EventMismatched is an event which the store has with different contents than Heimdall.
+	EventMismatched EventDiscrepancyKind = "mismatched"
+)
+
+type EventDiscrepancy struct {
+	Kind     EventDiscrepancyKind
+	BlockNum uint64
+	EventId  uint64
+	Expected *heimdall.EventRecordWithTime // nil for extra events
+	Actual   *heimdall.EventRecordWithTime // nil for missing events
+}
+
+func (d EventDiscrepancy) String() string {
+	return fmt.Sprintf("block %d: %s event %d: expected=%v, actual=%v", d.BlockNum, d.Kind, d.EventId, d.Expected, d.Actual)
+}
+
+type ReconcileReport struct {
+	FromBlock     uint64
+	ToBlock       uint64
+	Discrepancies []EventDiscrepancy
+	blocks        []reconciledBlock
+}
+
+// FirstBadBlock returns the first block with discrepancies.
+func (r *ReconcileReport) FirstBadBlock() (uint64, bool) {
+	if len(r.Discrepancies) == 0 {
+		return 0, false
+	}
+	return r.Discrepancies[0].BlockNum, true
+}
+
+type reconciledBlock struct {
+	header *types.Header
+	events []*heimdall.EventRecordWithTime // as assigned by Heimdall
+}
+
+type headerReader interface {
+	HeaderByNumber(ctx context.Context, blockNum uint64) (*types.Header, error)
+}
+
+// Reconciler re-fetches the state sync events of a block range from Heimdall, assigns them to
+// blocks the same way the Service does and compares the result with the store.
+type Reconciler struct {
+	store        Store
+	eventFetcher eventFetcher
+	headers      headerReader
+	borConfig    *borcfg.BorConfig
+	logger       log.Logger
+}
+
+func NewReconciler(store Store, eventFetcher eventFetcher, headers headerReader, borConfig *borcfg.BorConfig, logger log.Logger) *Reconciler {
+	return &Reconciler{
+		store:        store,
+		eventFetcher: eventFetcher,
+		headers:      headers,
+		borConfig:    borConfig,
+		logger:       logger,
+	}
+}
+
+// Reconcile compares the events of the sprint start blocks in [fromBlock, toBlock]. The events
+// of the range are expected to follow the last event which the store assigns before the range.
+func (r *Reconciler) Reconcile(ctx context.Context, fromBlock, toBlock uint64) (*ReconcileReport, error) {
+	report := &ReconcileReport{FromBlock: fromBlock, ToBlock: toBlock}
+
+	blockNum := r.firstSprintStart(fromBlock)
+	lastEventId, err := r.lastEventIdBefore(ctx, blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	for ; blockNum <= toBlock; blockNum += r.borConfig.CalculateSprintLength(blockNum) {
+		header, err := r.headers.HeaderByNumber(ctx, blockNum)
+		if err != nil {
+			return nil, err
+		}
+		if header == nil {
+			return nil, fmt.Errorf("header %d not found", blockNum)
+		}
+
+		expected, err := r.expectedEvents(ctx, header, lastEventId+1)
+		if err != nil {
+			return nil, err
+		}
+		if len(expected) > 0 {
+			lastEventId = expected[len(expected)-1].ID
+		}
+
+		discrepancies, err := r.compare(ctx, blockNum, expected)
+		if err != nil {
+			return nil, err
+		}
+
+		report.Discrepancies = append(report.Discrepancies, discrepancies...)
+		report.blocks = append(report.blocks, reconciledBlock{header: header, events: expected})
+
+		r.logger.Debug(
+			bridgeLogPrefix("reconciled block events"),
+			"blockNum", blockNum,
+			"events", len(expected),
+			"discrepancies", len(discrepancies),
+		)
+	}
+
+	return report, nil
+}
+
+func (r *Reconciler) firstSprintStart(blockNum uint64) uint64 {
+	for blockNum == 0 || !r.borConfig.IsSprintStart(blockNum) {
+		blockNum++
+	}
+	return blockNum
+}
+
+func (r *Reconciler) lastEventIdBefore(ctx context.Context, blockNum uint64) (uint64, error) {
+	for blockNum > r.borConfig.CalculateSprintLength(blockNum) {
+		blockNum -= r.borConfig.CalculateSprintLength(blockNum)
+
+		_, end, ok, err := r.store.BlockEventIdsRange(ctx, blockNum)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			return end, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// expectedEvents mirrors Service.ProcessNewBlocks with the events fetched from Heimdall.
+func (r *Reconciler) expectedEvents(ctx context.Context, header *types.Header, fromId uint64) ([]*heimdall.EventRecordWithTime, error) {
+	blockNum := header.Number.Uint64()
+	toTime := header.Time - r.borConfig.CalculateStateSyncDelay(blockNum)
+	if !r.borConfig.IsIndore(blockNum) {
+		prevHeader, err := r.headers.HeaderByNumber(ctx, blockNum-r.borConfig.CalculateSprintLength(blockNum))
+		if err != nil {
+			return nil, err
+		}
+		if prevHeader == nil {
+			return nil, fmt.Errorf("header %d not found", blockNum-r.borConfig.CalculateSprintLength(blockNum))
+		}
+		toTime = prevHeader.Time
+	}
+
+	fetched, err := r.eventFetcher.FetchStateSyncEvents(ctx, fromId, time.Unix(int64(toTime), 0), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*heimdall.EventRecordWithTime
+	for _, event := range fetched {
+		// the store only assigns consecutive events from the start of the window
+		if event.ID != fromId+uint64(len(events)) || !event.Time.Before(time.Unix(int64(toTime), 0)) {
+			break
+		}
+		events = append(events, event)
+	}
+
+	if r.borConfig.OverrideStateSyncRecords != nil {
+		if eventLimit, ok := r.borConfig.OverrideStateSyncRecords[strconv.FormatUint(blockNum, 10)]; ok {
+			events = events[:min(len(events), eventLimit)]
+		}
+	}
+
+	return events, nil
+}
+
+func (r *Reconciler) compare(ctx context.Context, blockNum uint64, expected []*heimdall.EventRecordWithTime) ([]EventDiscrepancy, error) {
+	actual := map[uint64]*heimdall.EventRecordWithTime{}
+	actualData := map[uint64][]byte{}
+	start, end, ok, err := r.store.BlockEventIdsRange(ctx, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		rawEvents, err := r.store.Events(ctx, start, end+1)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, data := range rawEvents {
+			var event heimdall.EventRecordWithTime
+			if err := event.UnmarshallBytes(data); err != nil {
+				return nil, err
+			}
+			// the first block in the store may report 0 as start
+			if event.ID < start || event.ID > end {
+				continue
+			}
+			actual[event.ID] = &event
+			actualData[event.ID] = data
+		}
+	}
+
+	var discrepancies []EventDiscrepancy
+	expectedIds := map[uint64]struct{}{}
+	for _, event := range expected {
+		expectedIds[event.ID] = struct{}{}
+
+		actualEvent, ok := actual[event.ID]
+		if !ok {
+			discrepancies = append(discrepancies, EventDiscrepancy{Kind: EventMissing, BlockNum: blockNum, EventId: event.ID, Expected: event})
+			continue
+		}
+
+		data, err := event.MarshallBytes()
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(data, actualData[event.ID]) {
+			discrepancies = append(discrepancies, EventDiscrepancy{Kind: EventMismatched, BlockNum: blockNum, EventId: event.ID, Expected: event, Actual: actualEvent})
+		}
+	}
+
+	if ok {
+		for id := max(start, 1); id <= end; id++ {
+			if _, ok := expectedIds[id]; ok {
+				continue
+			}
+			discrepancies = append(discrepancies, EventDiscrepancy{Kind: EventExtra, BlockNum: blockNum, EventId: id, Actual: actual[id]})
+		}
+	}
+
+	return discrepancies, nil
+}
+
+// Repair unwinds the bridge to the block before the first bad block, stores the events fetched
+// from Heimdall and assigns them again to the blocks of the report from there on.
+// The report has to cover the last processed block, as the blocks after it would be unwound
+// and not assigned again.
+func (r *Reconciler) Repair(ctx context.Context, service *Service, report *ReconcileReport) error {
+	firstBadBlock, ok := report.FirstBadBlock()
+	if !ok {
+		return nil
+	}
+
+	if lastFrozen := r.store.LastFrozenEventBlockNum(); firstBadBlock <= lastFrozen {
+		return fmt.Errorf("first bad block %d is in the snapshots, which end at block %d", firstBadBlock, lastFrozen)
+	}
+
+	lastProcessed, ok, err := r.store.LastProcessedBlockInfo(ctx)
+	if err != nil {
+		return err
+	}
+	if ok && report.ToBlock < lastProcessed.BlockNum {
+		return fmt.Errorf("report ends at block %d before the last processed block %d", report.ToBlock, lastProcessed.BlockNum)
+	}
+
+	var events []*heimdall.EventRecordWithTime
+	blockNumToEventId := map[uint64]uint64{}
+	eventTxnToBlockNum := map[libcommon.Hash]uint64{}
+	var processedBlocks []ProcessedBlockInfo
+	for _, block := range report.blocks {
+		blockNum := block.header.Number.Uint64()
+		if blockNum < firstBadBlock {
+			continue
+		}
+
+		if len(block.events) > 0 {
+			events = append(events, block.events...)
+			blockNumToEventId[blockNum] = block.events[len(block.events)-1].ID
+			eventTxnToBlockNum[bortypes.ComputeBorTxHash(blockNum, block.header.Hash())] = blockNum
+		}
+		processedBlocks = append(processedBlocks, ProcessedBlockInfo{BlockNum: blockNum, BlockTime: block.header.Time})
+	}
+
+	if len(processedBlocks) == 0 {
+		return errors.New("no blocks to repair")
+	}
+
+	r.logger.Info(bridgeLogPrefix("repairing events"), "fromBlock", firstBadBlock, "toBlock", report.ToBlock, "events", len(events))
+
+	// the unwind removes the events after the last event of the unwind block, so they are
+	// stored again afterwards
+	if err := service.Unwind(ctx, firstBadBlock-1); err != nil {
+		return err
+	}
+
+	if err := r.store.PutEvents(ctx, events); err != nil {
+		return err
+	}
+
+	if err := r.store.PutBlockNumToEventId(ctx, blockNumToEventId); err != nil {
+		return err
+	}
+
+	if err := r.store.PutEventTxnToBlockNum(ctx, eventTxnToBlockNum); err != nil {
+		return err
+	}
+
+	return r.store.PutProcessedBlockInfo(ctx, processedBlocks)
+}
@@ -0,0 +1,146 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package bridge
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/erigontech/erigon-lib/common/hexutil"
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon/core/types"
+	"github.com/erigontech/erigon/polygon/heimdall"
+	"github.com/erigontech/erigon/turbo/testlog"
+)
+
+type testEventFetcher []*heimdall.EventRecordWithTime
+
+func (events testEventFetcher) FetchStateSyncEvents(ctx context.Context, fromId uint64, to time.Time, limit int) ([]*heimdall.EventRecordWithTime, error) {
+	var res []*heimdall.EventRecordWithTime
+	for _, event := range events {
+		if event.ID >= fromId && event.Time.Before(to) {
+			res = append(res, event)
+		}
+	}
+	return res, nil
+}
+
+type testHeaderReader map[uint64]*types.Header
+
+func (headers testHeaderReader) HeaderByNumber(ctx context.Context, blockNum uint64) (*types.Header, error) {
+	return headers[blockNum], nil
+}
+
+func newTestEvent(id uint64, data string, timestamp int64) *heimdall.EventRecordWithTime {
+	return &heimdall.EventRecordWithTime{
+		EventRecord: heimdall.EventRecord{
+			ID:      id,
+			ChainID: "80002",
+			Data:    hexutil.MustDecode(data),
+		},
+		Time: time.Unix(timestamp, 0),
+	}
+}
+
+func TestReconciler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	// same windows as in TestService: events 1,2 fall in block 4, event 3 in block 6, event 4 in block 10
+	events := []*heimdall.EventRecordWithTime{
+		newTestEvent(1, "0x01", 50),
+		newTestEvent(2, "0x02", 99),
+		newTestEvent(3, "0x03", 199),
+		newTestEvent(4, "0x04", 498),
+	}
+	// the store receives a corrupted event 3
+	storedEvents := []*heimdall.EventRecordWithTime{events[0], events[1], newTestEvent(3, "0x33", 199), events[3]}
+
+	heimdallClient, b := setup(t, defaultBorConfig)
+	heimdallClient.EXPECT().FetchStateSyncEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(storedEvents, nil).Times(1)
+	heimdallClient.EXPECT().FetchStateSyncEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*heimdall.EventRecordWithTime{}, nil).AnyTimes()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := b.Run(ctx); err != nil && !errors.Is(err, ctx.Err()) {
+			t.Error(err)
+		}
+	}()
+
+	require.NoError(t, b.store.Prepare(ctx))
+
+	genesis := types.NewBlockWithHeader(&types.Header{Time: 1, Number: big.NewInt(0)})
+	require.NoError(t, b.ReplayInitialBlock(ctx, genesis))
+
+	blocks := getBlocks(t, 10)
+	require.NoError(t, b.ProcessNewBlocks(ctx, blocks))
+
+	headers := testHeaderReader{0: genesis.Header()}
+	for _, block := range blocks {
+		headers[block.NumberU64()] = block.Header()
+	}
+
+	reconciler := NewReconciler(b.store, testEventFetcher(events), headers, &defaultBorConfig, testlog.Logger(t, log.LvlDebug))
+
+	report, err := reconciler.Reconcile(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, report.Discrepancies, 1)
+	require.Equal(t, EventMismatched, report.Discrepancies[0].Kind)
+	require.Equal(t, uint64(6), report.Discrepancies[0].BlockNum)
+	require.Equal(t, uint64(3), report.Discrepancies[0].EventId)
+	firstBadBlock, ok := report.FirstBadBlock()
+	require.True(t, ok)
+	require.Equal(t, uint64(6), firstBadBlock)
+
+	// continues from the last event assigned before the range
+	report, err = reconciler.Reconcile(ctx, 5, 8)
+	require.NoError(t, err)
+	require.Len(t, report.Discrepancies, 1)
+	// the repair would unwind block 10 without assigning its events again
+	require.Error(t, reconciler.Repair(ctx, b, report))
+
+	report, err = reconciler.Reconcile(ctx, 1, 10)
+	require.NoError(t, err)
+	require.NoError(t, reconciler.Repair(ctx, b, report))
+
+	report, err = reconciler.Reconcile(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Empty(t, report.Discrepancies)
+
+	res, err := b.Events(ctx, 6)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	event3Data, err := events[2].MarshallBytes()
+	require.NoError(t, err)
+	require.Equal(t, event3Data, res[0].Data())
+
+	res, err = b.Events(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+
+	cancel()
+	wg.Wait()
+}
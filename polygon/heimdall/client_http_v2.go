@@ -0,0 +1,597 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package heimdall
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon/polygon/bor/valset"
+)
+
+var _ Client = &HttpClientV2{}
+
+// HttpClientV2 is a client of the Heimdall v2 REST API (cosmos-sdk gRPC gateway).
+// It converts the v2 responses into the same types as the legacy HttpClient.
+type HttpClientV2 struct {
+	client *HttpClient
+}
+
+func NewHttpClientV2(urlString string, logger log.Logger, opts ...HttpClientOption) *HttpClientV2 {
+	return &HttpClientV2{client: NewHttpClient(urlString, logger, opts...)}
+}
+
+const (
+	fetchStateSyncEventsV2Format = "from_id=%d&to_time=%s&pagination.limit=%d"
+	fetchStateSyncEventsV2Path   = "clerk/event-records/list"
+	fetchStateSyncEventV2        = "clerk/event-records/%d"
+
+	fetchNodeInfoV2    = "cosmos/base/tendermint/v1beta1/node_info"
+	fetchSyncingV2     = "cosmos/base/tendermint/v1beta1/syncing"
+	fetchLatestBlockV2 = "cosmos/base/tendermint/v1beta1/blocks/latest"
+
+	fetchCheckpointV2      = "checkpoints/%s"
+	fetchCheckpointCountV2 = "checkpoints/count"
+	fetchCheckpointListV2  = "checkpoints/list"
+
+	fetchMilestoneV2          = "milestones/%s"
+	fetchMilestoneCountV2     = "milestones/count"
+	fetchLastNoAckMilestoneV2 = "milestones/last-no-ack"
+	fetchNoAckMilestoneV2     = "milestones/no-ack/%s"
+	fetchMilestoneIDV2        = "milestones/id/%s"
+
+	fetchSpanV2     = "bor/spans/%s"
+	fetchSpanListV2 = "bor/spans/list"
+
+	fetchListV2QueryFormat = "pagination.offset=%d&pagination.limit=%d"
+)
+
+// isNotFoundError reports whether v2 answered with 404, which is how the gRPC gateway
+// reports missing entities.
+func isNotFoundError(err error) bool {
+	return errors.Is(err, ErrNotSuccessfulResponse) && strings.Contains(err.Error(), "status=404")
+}
+
+func (c *HttpClientV2) FetchStateSyncEvents(ctx context.Context, fromID uint64, to time.Time, limit int) ([]*EventRecordWithTime, error) {
+	eventRecords := make([]*EventRecordWithTime, 0)
+
+	for {
+		url, err := makeURL(c.client.urlString, fetchStateSyncEventsV2Path,
+			fmt.Sprintf(fetchStateSyncEventsV2Format, fromID, url.QueryEscape(to.UTC().Format(time.RFC3339)), StateEventsFetchLimit))
+		if err != nil {
+			return nil, err
+		}
+
+		c.client.logger.Trace(heimdallLogPrefix("Fetching state sync events"), "queryParams", url.RawQuery)
+
+		reqCtx := withRequestType(ctx, stateSyncRequest)
+
+		response, err := FetchWithRetry[stateSyncEventsResponseV2](reqCtx, c.client, url, c.client.logger)
+		if err != nil {
+			return nil, err
+		}
+
+		if response == nil || len(response.EventRecords) == 0 {
+			break
+		}
+
+		for _, record := range response.EventRecords {
+			eventRecords = append(eventRecords, record.toEventRecord())
+		}
+
+		if len(response.EventRecords) < StateEventsFetchLimit || (limit > 0 && len(eventRecords) >= limit) {
+			break
+		}
+
+		fromID += uint64(StateEventsFetchLimit)
+	}
+
+	sort.SliceStable(eventRecords, func(i, j int) bool {
+		return eventRecords[i].ID < eventRecords[j].ID
+	})
+
+	return eventRecords, nil
+}
+
+func (c *HttpClientV2) FetchStateSyncEvent(ctx context.Context, id uint64) (*EventRecordWithTime, error) {
+	url, err := makeURL(c.client.urlString, fmt.Sprintf(fetchStateSyncEventV2, id), "")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withRequestType(ctx, stateSyncRequest)
+
+	isRecoverableError := func(err error) bool {
+		return !isNotFoundError(err)
+	}
+
+	response, err := FetchWithRetryEx[stateSyncEventResponseV2](ctx, c.client, url, isRecoverableError, c.client.logger)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, ErrEventRecordNotFound
+		}
+
+		return nil, err
+	}
+
+	return response.Record.toEventRecord(), nil
+}
+
+func (c *HttpClientV2) FetchLatestSpan(ctx context.Context) (*Span, error) {
+	return c.fetchSpan(ctx, "latest")
+}
+
+func (c *HttpClientV2) FetchSpan(ctx context.Context, spanID uint64) (*Span, error) {
+	span, err := c.fetchSpan(ctx, strconv.FormatUint(spanID, 10))
+	if err != nil {
+		return nil, fmt.Errorf("%w, spanID=%d", err, spanID)
+	}
+
+	return span, nil
+}
+
+func (c *HttpClientV2) fetchSpan(ctx context.Context, id string) (*Span, error) {
+	url, err := makeURL(c.client.urlString, fmt.Sprintf(fetchSpanV2, id), "")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withRequestType(ctx, spanRequest)
+
+	response, err := FetchWithRetry[spanResponseV2](ctx, c.client, url, c.client.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Span.toSpan(), nil
+}
+
+func (c *HttpClientV2) FetchSpans(ctx context.Context, page uint64, limit uint64) ([]*Span, error) {
+	url, err := makeURL(c.client.urlString, fetchSpanListV2, listV2Query(page, limit))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withRequestType(ctx, checkpointListRequest)
+
+	response, err := FetchWithRetry[spanListResponseV2](ctx, c.client, url, c.client.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*Span, len(response.SpanList))
+	for i, span := range response.SpanList {
+		result[i] = span.toSpan()
+	}
+
+	return result, nil
+}
+
+// FetchStatus combines the sync status and the latest block of the Heimdall node,
+// as v2 has no single status endpoint.
+func (c *HttpClientV2) FetchStatus(ctx context.Context) (*Status, error) {
+	ctx = withRequestType(ctx, statusRequest)
+
+	url, err := makeURL(c.client.urlString, fetchSyncingV2, "")
+	if err != nil {
+		return nil, err
+	}
+
+	syncing, err := FetchWithRetry[syncingResponseV2](ctx, c.client, url, c.client.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err = makeURL(c.client.urlString, fetchLatestBlockV2, "")
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := FetchWithRetry[latestBlockResponseV2](ctx, c.client, url, c.client.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Status{
+		LatestBlockHash:   strings.ToUpper(hex.EncodeToString(latest.BlockId.Hash)),
+		LatestAppHash:     strings.ToUpper(hex.EncodeToString(latest.Block.Header.AppHash)),
+		LatestBlockHeight: latest.Block.Header.Height,
+		LatestBlockTime:   latest.Block.Header.Time,
+		CatchingUp:        syncing.Syncing,
+	}, nil
+}
+
+func (c *HttpClientV2) FetchCheckpoint(ctx context.Context, number int64) (*Checkpoint, error) {
+	id := "latest"
+	if number != -1 {
+		id = strconv.FormatInt(number, 10)
+	}
+
+	url, err := makeURL(c.client.urlString, fmt.Sprintf(fetchCheckpointV2, id), "")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withRequestType(ctx, checkpointRequest)
+
+	response, err := FetchWithRetry[checkpointResponseV2](ctx, c.client, url, c.client.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Checkpoint.toCheckpoint(), nil
+}
+
+func (c *HttpClientV2) FetchCheckpointCount(ctx context.Context) (int64, error) {
+	url, err := makeURL(c.client.urlString, fetchCheckpointCountV2, "")
+	if err != nil {
+		return 0, err
+	}
+
+	ctx = withRequestType(ctx, checkpointCountRequest)
+
+	response, err := FetchWithRetry[checkpointCountResponseV2](ctx, c.client, url, c.client.logger)
+	if err != nil {
+		return 0, err
+	}
+
+	return response.AckCount, nil
+}
+
+func (c *HttpClientV2) FetchCheckpoints(ctx context.Context, page uint64, limit uint64) ([]*Checkpoint, error) {
+	url, err := makeURL(c.client.urlString, fetchCheckpointListV2, listV2Query(page, limit))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withRequestType(ctx, checkpointListRequest)
+
+	response, err := FetchWithRetry[checkpointListResponseV2](ctx, c.client, url, c.client.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*Checkpoint, len(response.CheckpointList))
+	for i, checkpoint := range response.CheckpointList {
+		result[i] = checkpoint.toCheckpoint()
+	}
+
+	return result, nil
+}
+
+func (c *HttpClientV2) FetchMilestone(ctx context.Context, number int64) (*Milestone, error) {
+	id := "latest"
+	if number != -1 {
+		id = strconv.FormatInt(number, 10)
+	}
+
+	url, err := makeURL(c.client.urlString, fmt.Sprintf(fetchMilestoneV2, id), "")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withRequestType(ctx, milestoneRequest)
+
+	isRecoverableError := func(err error) bool {
+		// -1 means fetch latest, which should be retried
+		return number == -1 || !isNotFoundError(err)
+	}
+
+	response, err := FetchWithRetryEx[milestoneResponseV2](ctx, c.client, url, isRecoverableError, c.client.logger)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, fmt.Errorf("%w: number %d", ErrNotInMilestoneList, number)
+		}
+		return nil, err
+	}
+
+	milestone := response.Milestone.toMilestone()
+	milestone.Id = MilestoneId(number)
+
+	return milestone, nil
+}
+
+func (c *HttpClientV2) FetchMilestoneCount(ctx context.Context) (int64, error) {
+	url, err := makeURL(c.client.urlString, fetchMilestoneCountV2, "")
+	if err != nil {
+		return 0, err
+	}
+
+	ctx = withRequestType(ctx, milestoneCountRequest)
+
+	response, err := FetchWithRetry[milestoneCountResponseV2](ctx, c.client, url, c.client.logger)
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Count, nil
+}
+
+func (c *HttpClientV2) FetchFirstMilestoneNum(ctx context.Context) (int64, error) {
+	count, err := c.FetchMilestoneCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if count < milestonePruneNumber {
+		return 1, nil
+	}
+
+	return count - milestonePruneNumber + 1, nil
+}
+
+func (c *HttpClientV2) FetchLastNoAckMilestone(ctx context.Context) (string, error) {
+	url, err := makeURL(c.client.urlString, fetchLastNoAckMilestoneV2, "")
+	if err != nil {
+		return "", err
+	}
+
+	ctx = withRequestType(ctx, milestoneLastNoAckRequest)
+
+	response, err := FetchWithRetry[stringResponseV2](ctx, c.client, url, c.client.logger)
+	if err != nil {
+		return "", err
+	}
+
+	return response.Result, nil
+}
+
+func (c *HttpClientV2) FetchNoAckMilestone(ctx context.Context, milestoneID string) error {
+	url, err := makeURL(c.client.urlString, fmt.Sprintf(fetchNoAckMilestoneV2, url.PathEscape(milestoneID)), "")
+	if err != nil {
+		return err
+	}
+
+	ctx = withRequestType(ctx, milestoneNoAckRequest)
+
+	response, err := FetchWithRetry[boolResponseV2](ctx, c.client, url, c.client.logger)
+	if err != nil {
+		return err
+	}
+
+	if !response.Result {
+		return fmt.Errorf("%w: milestoneID %q", ErrNotInRejectedList, milestoneID)
+	}
+
+	return nil
+}
+
+func (c *HttpClientV2) FetchMilestoneID(ctx context.Context, milestoneID string) error {
+	url, err := makeURL(c.client.urlString, fmt.Sprintf(fetchMilestoneIDV2, url.PathEscape(milestoneID)), "")
+	if err != nil {
+		return err
+	}
+
+	ctx = withRequestType(ctx, milestoneIDRequest)
+
+	response, err := FetchWithRetry[boolResponseV2](ctx, c.client, url, c.client.logger)
+	if err != nil {
+		return err
+	}
+
+	if !response.Result {
+		return fmt.Errorf("%w: milestoneID %q", ErrNotInMilestoneList, milestoneID)
+	}
+
+	return nil
+}
+
+func (c *HttpClientV2) Close() {
+	c.client.Close()
+}
+
+// listV2Query converts the 1-based page of the legacy API into a cosmos-sdk offset.
+func listV2Query(page, limit uint64) string {
+	var offset uint64
+	if page > 0 {
+		offset = (page - 1) * limit
+	}
+	return fmt.Sprintf(fetchListV2QueryFormat, offset, limit)
+}
+
+// The v2 responses use the proto3 JSON mapping: 64-bit integers are strings
+// and byte arrays are base64 encoded.
+
+type validatorV2 struct {
+	ValID            uint64            `json:"val_id,string"`
+	Signer           libcommon.Address `json:"signer"`
+	VotingPower      int64             `json:"voting_power,string"`
+	ProposerPriority int64             `json:"proposer_priority,string"`
+}
+
+func (v *validatorV2) toValidator() *valset.Validator {
+	return &valset.Validator{
+		ID:               v.ValID,
+		Address:          v.Signer,
+		VotingPower:      v.VotingPower,
+		ProposerPriority: v.ProposerPriority,
+	}
+}
+
+type spanV2 struct {
+	Id           uint64 `json:"id,string"`
+	StartBlock   uint64 `json:"start_block,string"`
+	EndBlock     uint64 `json:"end_block,string"`
+	ValidatorSet struct {
+		Validators []*validatorV2 `json:"validators"`
+		Proposer   *validatorV2   `json:"proposer"`
+	} `json:"validator_set"`
+	SelectedProducers []validatorV2 `json:"selected_producers"`
+	ChainID           string        `json:"bor_chain_id"`
+}
+
+func (s *spanV2) toSpan() *Span {
+	span := &Span{
+		Id:         SpanId(s.Id),
+		StartBlock: s.StartBlock,
+		EndBlock:   s.EndBlock,
+		ChainID:    s.ChainID,
+	}
+	for _, v := range s.ValidatorSet.Validators {
+		span.ValidatorSet.Validators = append(span.ValidatorSet.Validators, v.toValidator())
+	}
+	if s.ValidatorSet.Proposer != nil {
+		span.ValidatorSet.Proposer = s.ValidatorSet.Proposer.toValidator()
+	}
+	for _, v := range s.SelectedProducers {
+		span.SelectedProducers = append(span.SelectedProducers, *v.toValidator())
+	}
+	return span
+}
+
+type spanResponseV2 struct {
+	Span spanV2 `json:"span"`
+}
+
+type spanListResponseV2 struct {
+	SpanList []spanV2 `json:"span_list"`
+}
+
+type checkpointV2 struct {
+	Id         uint64            `json:"id,string"`
+	Proposer   libcommon.Address `json:"proposer"`
+	StartBlock uint64            `json:"start_block,string"`
+	EndBlock   uint64            `json:"end_block,string"`
+	RootHash   []byte            `json:"root_hash"`
+	ChainID    string            `json:"bor_chain_id"`
+	Timestamp  uint64            `json:"timestamp,string"`
+}
+
+func (c *checkpointV2) toCheckpoint() *Checkpoint {
+	return &Checkpoint{
+		Id: CheckpointId(c.Id),
+		Fields: WaypointFields{
+			Proposer:   c.Proposer,
+			StartBlock: new(big.Int).SetUint64(c.StartBlock),
+			EndBlock:   new(big.Int).SetUint64(c.EndBlock),
+			RootHash:   libcommon.BytesToHash(c.RootHash),
+			ChainID:    c.ChainID,
+			Timestamp:  c.Timestamp,
+		},
+	}
+}
+
+type checkpointResponseV2 struct {
+	Checkpoint checkpointV2 `json:"checkpoint"`
+}
+
+type checkpointCountResponseV2 struct {
+	AckCount int64 `json:"ack_count,string"`
+}
+
+type checkpointListResponseV2 struct {
+	CheckpointList []checkpointV2 `json:"checkpoint_list"`
+}
+
+type milestoneV2 struct {
+	Proposer    libcommon.Address `json:"proposer"`
+	StartBlock  uint64            `json:"start_block,string"`
+	EndBlock    uint64            `json:"end_block,string"`
+	Hash        []byte            `json:"hash"`
+	ChainID     string            `json:"bor_chain_id"`
+	MilestoneID string            `json:"milestone_id"`
+	Timestamp   uint64            `json:"timestamp,string"`
+}
+
+func (m *milestoneV2) toMilestone() *Milestone {
+	return &Milestone{
+		MilestoneId: m.MilestoneID,
+		Fields: WaypointFields{
+			Proposer:   m.Proposer,
+			StartBlock: new(big.Int).SetUint64(m.StartBlock),
+			EndBlock:   new(big.Int).SetUint64(m.EndBlock),
+			RootHash:   libcommon.BytesToHash(m.Hash),
+			ChainID:    m.ChainID,
+			Timestamp:  m.Timestamp,
+		},
+	}
+}
+
+type milestoneResponseV2 struct {
+	Milestone milestoneV2 `json:"milestone"`
+}
+
+type milestoneCountResponseV2 struct {
+	Count int64 `json:"count,string"`
+}
+
+type eventRecordV2 struct {
+	ID       uint64            `json:"id,string"`
+	Contract libcommon.Address `json:"contract"`
+	Data     []byte            `json:"data"`
+	TxHash   libcommon.Hash    `json:"tx_hash"`
+	LogIndex uint64            `json:"log_index,string"`
+	ChainID  string            `json:"bor_chain_id"`
+	Time     time.Time         `json:"record_time"`
+}
+
+func (e *eventRecordV2) toEventRecord() *EventRecordWithTime {
+	return &EventRecordWithTime{
+		EventRecord: EventRecord{
+			ID:       e.ID,
+			Contract: e.Contract,
+			Data:     e.Data,
+			TxHash:   e.TxHash,
+			LogIndex: e.LogIndex,
+			ChainID:  e.ChainID,
+		},
+		Time: e.Time,
+	}
+}
+
+type stateSyncEventsResponseV2 struct {
+	EventRecords []eventRecordV2 `json:"event_records"`
+}
+
+type stateSyncEventResponseV2 struct {
+	Record eventRecordV2 `json:"record"`
+}
+
+type syncingResponseV2 struct {
+	Syncing bool `json:"syncing"`
+}
+
+type latestBlockResponseV2 struct {
+	BlockId struct {
+		Hash []byte `json:"hash"`
+	} `json:"block_id"`
+	Block struct {
+		Header struct {
+			Height  string `json:"height"`
+			Time    string `json:"time"`
+			AppHash []byte `json:"app_hash"`
+		} `json:"header"`
+	} `json:"block"`
+}
+
+type stringResponseV2 struct {
+	Result string `json:"result"`
+}
+
+type boolResponseV2 struct {
+	Result bool `json:"result"`
+}
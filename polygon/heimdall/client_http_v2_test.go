@@ -0,0 +1,194 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package heimdall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon/turbo/testlog"
+)
+
+// fixtureServer serves the files of the given directory at their path with the
+// .json extension removed, and answers 404 for everything else.
+func fixtureServer(t *testing.T, dir string) (*httptest.Server, func() []string) {
+	var lock sync.Mutex
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		requests = append(requests, r.URL.RequestURI())
+		lock.Unlock()
+		data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(r.URL.Path)+".json"))
+		if err != nil {
+			http.Error(w, `{"code":5,"message":"not found"}`, http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]string{}, requests...)
+	}
+}
+
+// requireSameJSON checks that the v2 entity converts to the same legacy JSON as the
+// recorded legacy fixture.
+func requireSameJSON(t *testing.T, fixture string, entity any) {
+	expected, err := os.ReadFile(fixture)
+	require.NoError(t, err)
+	actual, err := json.Marshal(entity)
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected), string(actual))
+}
+
+func newTestClient(t *testing.T, url string) *VersionedClient {
+	client := NewClient(url, testlog.Logger(t, log.LvlDebug), WithHttpRetryBackOff(10*time.Millisecond), WithHttpMaxRetries(2))
+	t.Cleanup(client.Close)
+	return client
+}
+
+func TestHeimdallClientV2(t *testing.T) {
+	ctx := context.Background()
+	server, requests := fixtureServer(t, "testdata/v2")
+	client := newTestClient(t, server.URL)
+
+	span, err := client.FetchSpan(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, client.v2, client.client)
+	requireSameJSON(t, "testdata/amoy/spans/span_0.json", span)
+
+	span, err = client.FetchLatestSpan(ctx)
+	require.NoError(t, err)
+	requireSameJSON(t, "testdata/amoy/spans/span_1.json", span)
+
+	spans, err := client.FetchSpans(ctx, 2, 150)
+	require.NoError(t, err)
+	require.Len(t, spans, 2)
+	require.Contains(t, requests(), "/bor/spans/list?pagination.offset=150&pagination.limit=150")
+
+	checkpoint, err := client.FetchCheckpoint(ctx, 1)
+	require.NoError(t, err)
+	requireSameJSON(t, "testdata/amoy/checkpoints/checkpoint_1.json", checkpoint)
+
+	checkpoint, err = client.FetchCheckpoint(ctx, -1)
+	require.NoError(t, err)
+	requireSameJSON(t, "testdata/amoy/checkpoints/checkpoint_2.json", checkpoint)
+
+	checkpoints, err := client.FetchCheckpoints(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, checkpoints, 2)
+	requireSameJSON(t, "testdata/amoy/checkpoints/checkpoint_2.json", checkpoints[1])
+
+	count, err := client.FetchCheckpointCount(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	milestone, err := client.FetchMilestone(ctx, 285542)
+	require.NoError(t, err)
+	requireSameJSON(t, "testdata/amoy/milestones/milestone_285542.json", milestone)
+
+	_, err = client.FetchMilestone(ctx, 285543)
+	require.ErrorIs(t, err, ErrNotInMilestoneList)
+
+	first, err := client.FetchFirstMilestoneNum(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(285542-milestonePruneNumber+1), first)
+
+	to := time.Date(2023, 11, 21, 0, 0, 0, 0, time.UTC)
+	events, err := client.FetchStateSyncEvents(ctx, 1, to, 0)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, uint64(1), events[0].ID, "events are sorted")
+	require.Equal(t, time.Date(2023, 11, 20, 12, 14, 51, 0, time.UTC), events[0].Time)
+	require.Len(t, events[0].Data, 64)
+	require.Contains(t, requests(), fmt.Sprintf("/clerk/event-records/list?from_id=1&to_time=2023-11-21T00%%3A00%%3A00Z&pagination.limit=%d", StateEventsFetchLimit))
+
+	event, err := client.FetchStateSyncEvent(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, events[0], event)
+
+	_, err = client.FetchStateSyncEvent(ctx, 3)
+	require.ErrorIs(t, err, ErrEventRecordNotFound)
+
+	status, err := client.FetchStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &Status{
+		LatestBlockHash:   "ABABABABABABABABABABABABABABABABABABABABABABABABABABABABABABABAB",
+		LatestAppHash:     "CDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCDCD",
+		LatestBlockHeight: "2390145",
+		LatestBlockTime:   "2025-06-12T09:20:11.481923Z",
+		CatchingUp:        false,
+	}, status)
+}
+
+func TestHeimdallClientDetectsLegacyAPI(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	span, err := os.ReadFile("testdata/amoy/spans/span_1.json")
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "bor", "span"), 0755))
+	response := fmt.Sprintf(`{"height":"1","result":%s}`, span)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bor", "span", "1.json"), []byte(response), 0644))
+
+	server, _ := fixtureServer(t, dir)
+	client := newTestClient(t, server.URL)
+
+	fetched, err := client.FetchSpan(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, client.v1, client.client)
+	requireSameJSON(t, "testdata/amoy/spans/span_1.json", fetched)
+}
+
+func TestHeimdallClientRetriesDetection(t *testing.T) {
+	ctx := context.Background()
+	fixtures, _ := fixtureServer(t, "testdata/v2")
+	var failures atomic.Int32
+	failures.Store(1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/"+fetchNodeInfoV2 && failures.Add(-1) >= 0 {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		fixtures.Config.Handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	client := newTestClient(t, server.URL)
+
+	// a transient failure doesn't select the legacy API
+	_, err := client.FetchSpan(ctx, 0)
+	require.ErrorIs(t, err, ErrNotSuccessfulResponse)
+	require.Nil(t, client.client)
+
+	span, err := client.FetchSpan(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, client.v2, client.client)
+	requireSameJSON(t, "testdata/amoy/spans/span_0.json", span)
+}
@@ -0,0 +1,225 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package heimdall
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/erigontech/erigon-lib/log/v3"
+)
+
+var _ Client = &VersionedClient{}
+
+// VersionedClient detects the API version of the Heimdall node on first use and
+// delegates to either the legacy HttpClient or HttpClientV2.
+type VersionedClient struct {
+	v1     *HttpClient
+	v2     *HttpClientV2
+	logger log.Logger
+
+	lock   sync.Mutex
+	client Client
+}
+
+func NewClient(urlString string, logger log.Logger, opts ...HttpClientOption) *VersionedClient {
+	return &VersionedClient{
+		v1:     NewHttpClient(urlString, logger, opts...),
+		v2:     NewHttpClientV2(urlString, logger, opts...),
+		logger: logger,
+	}
+}
+
+// detect probes the cosmos-sdk node info endpoint, which only Heimdall v2 serves. Only 404 and 501 mean
+// a legacy node, any other failure is returned, so that the detection is retried on the next call.
+func (c *VersionedClient) detect(ctx context.Context) (Client, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.client != nil {
+		return c.client, nil
+	}
+
+	url, err := makeURL(c.v1.urlString, fetchNodeInfoV2, "")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, apiHeimdallTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.v1.handler.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, err
+		}
+		if len(body) == 0 {
+			return nil, fmt.Errorf("%w: url='%s', empty node info", ErrNotSuccessfulResponse, url.String())
+		}
+		c.client = c.v2
+		c.logger.Info(heimdallLogPrefix("detected Heimdall v2 API"), "url", c.v1.urlString)
+	case http.StatusNotFound, http.StatusNotImplemented:
+		c.client = c.v1
+		c.logger.Info(heimdallLogPrefix("detected legacy Heimdall API"), "url", c.v1.urlString)
+	default:
+		return nil, fmt.Errorf("%w: url='%s', status=%d", ErrNotSuccessfulResponse, url.String(), res.StatusCode)
+	}
+
+	return c.client, nil
+}
+
+func (c *VersionedClient) FetchStateSyncEvents(ctx context.Context, fromId uint64, to time.Time, limit int) ([]*EventRecordWithTime, error) {
+	client, err := c.detect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.FetchStateSyncEvents(ctx, fromId, to, limit)
+}
+
+func (c *VersionedClient) FetchStateSyncEvent(ctx context.Context, id uint64) (*EventRecordWithTime, error) {
+	client, err := c.detect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.FetchStateSyncEvent(ctx, id)
+}
+
+func (c *VersionedClient) FetchLatestSpan(ctx context.Context) (*Span, error) {
+	client, err := c.detect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.FetchLatestSpan(ctx)
+}
+
+func (c *VersionedClient) FetchSpan(ctx context.Context, spanID uint64) (*Span, error) {
+	client, err := c.detect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.FetchSpan(ctx, spanID)
+}
+
+func (c *VersionedClient) FetchSpans(ctx context.Context, page uint64, limit uint64) ([]*Span, error) {
+	client, err := c.detect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.FetchSpans(ctx, page, limit)
+}
+
+func (c *VersionedClient) FetchStatus(ctx context.Context) (*Status, error) {
+	client, err := c.detect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.FetchStatus(ctx)
+}
+
+func (c *VersionedClient) FetchCheckpoint(ctx context.Context, number int64) (*Checkpoint, error) {
+	client, err := c.detect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.FetchCheckpoint(ctx, number)
+}
+
+func (c *VersionedClient) FetchCheckpointCount(ctx context.Context) (int64, error) {
+	client, err := c.detect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return client.FetchCheckpointCount(ctx)
+}
+
+func (c *VersionedClient) FetchCheckpoints(ctx context.Context, page uint64, limit uint64) ([]*Checkpoint, error) {
+	client, err := c.detect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.FetchCheckpoints(ctx, page, limit)
+}
+
+func (c *VersionedClient) FetchMilestone(ctx context.Context, number int64) (*Milestone, error) {
+	client, err := c.detect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.FetchMilestone(ctx, number)
+}
+
+func (c *VersionedClient) FetchMilestoneCount(ctx context.Context) (int64, error) {
+	client, err := c.detect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return client.FetchMilestoneCount(ctx)
+}
+
+func (c *VersionedClient) FetchFirstMilestoneNum(ctx context.Context) (int64, error) {
+	client, err := c.detect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return client.FetchFirstMilestoneNum(ctx)
+}
+
+func (c *VersionedClient) FetchNoAckMilestone(ctx context.Context, milestoneID string) error {
+	client, err := c.detect(ctx)
+	if err != nil {
+		return err
+	}
+	return client.FetchNoAckMilestone(ctx, milestoneID)
+}
+
+func (c *VersionedClient) FetchLastNoAckMilestone(ctx context.Context) (string, error) {
+	client, err := c.detect(ctx)
+	if err != nil {
+		return "", err
+	}
+	return client.FetchLastNoAckMilestone(ctx)
+}
+
+func (c *VersionedClient) FetchMilestoneID(ctx context.Context, milestoneID string) error {
+	client, err := c.detect(ctx)
+	if err != nil {
+		return err
+	}
+	return client.FetchMilestoneID(ctx, milestoneID)
+}
+
+// Close closes both clients, they share no state.
+func (c *VersionedClient) Close() {
+	c.v1.Close()
+	c.v2.Close()
+}
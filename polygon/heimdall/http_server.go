@@ -0,0 +1,359 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package heimdall
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/erigontech/erigon-lib/log/v3"
+)
+
+type eventReader interface {
+	LastEventId(ctx context.Context) (uint64, error)
+	Events(ctx context.Context, start, end uint64) ([][]byte, error)
+}
+
+type statusFetcher interface {
+	FetchStatus(ctx context.Context) (*Status, error)
+}
+
+// HttpServer serves the legacy Heimdall REST API from the local span, checkpoint, milestone
+// and state sync event stores, so that other nodes can use it as their Heimdall URL.
+// Only the endpoints used by HttpClient are served; the no-ack milestone endpoints are not.
+type HttpServer struct {
+	addr   string
+	store  Store
+	events eventReader
+	status statusFetcher
+	logger log.Logger
+}
+
+func NewHttpServer(addr string, store Store, events eventReader, status statusFetcher, logger log.Logger) *HttpServer {
+	return &HttpServer{
+		addr:   addr,
+		store:  store,
+		events: events,
+		status: status,
+		logger: logger,
+	}
+}
+
+// Run serves requests until the context is cancelled.
+func (s *HttpServer) Run(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{Handler: s.Handler(), ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+
+	s.logger.Info(heimdallLogPrefix("serving Heimdall API"), "addr", listener.Addr())
+
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+func (s *HttpServer) Handler() http.Handler {
+	router := chi.NewRouter()
+
+	router.Get("/"+fetchSpanListPath, s.handleSpans)
+	router.Get("/"+fetchSpanLatest, s.handleLatestSpan)
+	router.Get("/bor/span/{id}", s.handleSpan)
+
+	router.Get(fetchCheckpointList, s.handleCheckpoints)
+	router.Get(fetchCheckpointCount, s.handleCheckpointCount)
+	router.Get("/checkpoints/latest", s.handleLatestCheckpoint)
+	router.Get("/checkpoints/{number}", s.handleCheckpoint)
+
+	router.Get(fetchMilestoneCount, s.handleMilestoneCount)
+	router.Get(fetchMilestoneLatest, s.handleLatestMilestone)
+	router.Get("/milestone/{number}", s.handleMilestone)
+
+	router.Get("/"+fetchStateSyncEventsPath, s.handleStateSyncEvents)
+	router.Get("/clerk/event-record/{id}", s.handleStateSyncEvent)
+
+	router.Get(fetchStatus, s.handleStatus)
+
+	return router
+}
+
+func (s *HttpServer) handleSpan(w http.ResponseWriter, r *http.Request) {
+	id, ok := uintParam(w, chi.URLParam(r, "id"))
+	if !ok {
+		return
+	}
+
+	span, ok, err := s.store.Spans().Entity(r.Context(), id)
+	writeEntity(w, span, ok, err, "span not found")
+}
+
+func (s *HttpServer) handleLatestSpan(w http.ResponseWriter, r *http.Request) {
+	span, ok, err := s.store.Spans().LastEntity(r.Context())
+	writeEntity(w, span, ok, err, "span not found")
+}
+
+func (s *HttpServer) handleSpans(w http.ResponseWriter, r *http.Request) {
+	page, limit, ok := pageParams(w, r, SpansFetchLimit)
+	if !ok {
+		return
+	}
+
+	spans, err := entityPage(r.Context(), s.store.Spans(), 0, page, limit)
+	writeResult(w, spans, err)
+}
+
+func (s *HttpServer) handleCheckpoint(w http.ResponseWriter, r *http.Request) {
+	number, ok := uintParam(w, chi.URLParam(r, "number"))
+	if !ok {
+		return
+	}
+
+	checkpoint, ok, err := s.store.Checkpoints().Entity(r.Context(), number)
+	writeEntity(w, checkpoint, ok, err, "checkpoint not found")
+}
+
+func (s *HttpServer) handleLatestCheckpoint(w http.ResponseWriter, r *http.Request) {
+	checkpoint, ok, err := s.store.Checkpoints().LastEntity(r.Context())
+	writeEntity(w, checkpoint, ok, err, "checkpoint not found")
+}
+
+func (s *HttpServer) handleCheckpointCount(w http.ResponseWriter, r *http.Request) {
+	count, err := lastEntityId(r.Context(), s.store.Checkpoints())
+	writeResult(w, CheckpointCount{Result: int64(count)}, err)
+}
+
+func (s *HttpServer) handleCheckpoints(w http.ResponseWriter, r *http.Request) {
+	page, limit, ok := pageParams(w, r, CheckpointsFetchLimit)
+	if !ok {
+		return
+	}
+
+	checkpoints, err := entityPage(r.Context(), s.store.Checkpoints(), 1, page, limit)
+	writeResult(w, checkpoints, err)
+}
+
+func (s *HttpServer) handleMilestone(w http.ResponseWriter, r *http.Request) {
+	number, ok := uintParam(w, chi.URLParam(r, "number"))
+	if !ok {
+		return
+	}
+
+	// the client maps this message to ErrNotInMilestoneList
+	milestone, ok, err := s.store.Milestones().Entity(r.Context(), number)
+	writeEntity(w, milestone, ok, err, "Invalid milestone index")
+}
+
+func (s *HttpServer) handleLatestMilestone(w http.ResponseWriter, r *http.Request) {
+	milestone, ok, err := s.store.Milestones().LastEntity(r.Context())
+	writeEntity(w, milestone, ok, err, "milestone not found")
+}
+
+func (s *HttpServer) handleMilestoneCount(w http.ResponseWriter, r *http.Request) {
+	count, err := lastEntityId(r.Context(), s.store.Milestones())
+	writeResult(w, MilestoneCount{Count: int64(count)}, err)
+}
+
+func (s *HttpServer) handleStateSyncEvent(w http.ResponseWriter, r *http.Request) {
+	id, ok := uintParam(w, chi.URLParam(r, "id"))
+	if !ok {
+		return
+	}
+
+	events, err := s.stateSyncEvents(r.Context(), id, id+1, time.Time{})
+	if len(events) == 0 && err == nil {
+		// the client maps this message to ErrEventRecordNotFound
+		http.Error(w, "could not get state record; No record found", http.StatusInternalServerError)
+		return
+	}
+
+	var event *EventRecordWithTime
+	if len(events) > 0 {
+		event = events[0]
+	}
+	writeResult(w, event, err)
+}
+
+func (s *HttpServer) handleStateSyncEvents(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	fromId, ok := uintParam(w, query.Get("from-id"))
+	if !ok {
+		return
+	}
+
+	toTime, err := strconv.ParseInt(query.Get("to-time"), 10, 64)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	limit := uint64(StateEventsFetchLimit)
+	if query.Has("limit") {
+		if limit, ok = uintParam(w, query.Get("limit")); !ok {
+			return
+		}
+		limit = min(max(limit, 1), StateEventsFetchLimit)
+	}
+
+	events, err := s.stateSyncEvents(r.Context(), fromId, fromId+limit, time.Unix(toTime, 0))
+	writeResult(w, events, err)
+}
+
+// stateSyncEvents returns the stored events in the [start, end) id range which were
+// recorded before the given time, or all of them if the time is zero.
+func (s *HttpServer) stateSyncEvents(ctx context.Context, start, end uint64, to time.Time) ([]*EventRecordWithTime, error) {
+	last, err := s.events.LastEventId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*EventRecordWithTime, 0)
+	if start > last {
+		return events, nil
+	}
+
+	raw, err := s.events.Events(ctx, start, min(end, last+1))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, data := range raw {
+		var event EventRecordWithTime
+		if err := event.UnmarshallBytes(data); err != nil {
+			return nil, err
+		}
+		if !to.IsZero() && !event.Time.Before(to) {
+			break
+		}
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
+
+func (s *HttpServer) handleStatus(w http.ResponseWriter, r *http.Request) {
+	if s.status == nil {
+		http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+		return
+	}
+
+	status, err := s.status.FetchStatus(r.Context())
+	writeResult(w, status, err)
+}
+
+func uintParam(w http.ResponseWriter, value string) (uint64, bool) {
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return 0, false
+	}
+	return n, true
+}
+
+func pageParams(w http.ResponseWriter, r *http.Request, maxLimit uint64) (uint64, uint64, bool) {
+	query := r.URL.Query()
+	page, ok := uintParam(w, query.Get("page"))
+	if !ok {
+		return 0, 0, false
+	}
+
+	limit, ok := uintParam(w, query.Get("limit"))
+	if !ok {
+		return 0, 0, false
+	}
+
+	if page == 0 || limit == 0 {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return 0, 0, false
+	}
+
+	return page, min(limit, maxLimit), true
+}
+
+func lastEntityId[TEntity Entity](ctx context.Context, store EntityStore[TEntity]) (uint64, error) {
+	id, _, err := store.LastEntityId(ctx)
+	return id, err
+}
+
+// entityPage returns the stored entities of the 1-based page, where the first page
+// starts at firstId. Missing entities are skipped.
+func entityPage[TEntity Entity](ctx context.Context, store EntityStore[TEntity], firstId, page, limit uint64) ([]TEntity, error) {
+	entities := make([]TEntity, 0, limit)
+
+	last, ok, err := store.LastEntityId(ctx)
+	if err != nil || !ok {
+		return entities, err
+	}
+
+	start := firstId + (page-1)*limit
+	for id := start; id < start+limit && id <= last; id++ {
+		entity, ok, err := store.Entity(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			entities = append(entities, entity)
+		}
+	}
+
+	return entities, nil
+}
+
+func writeEntity(w http.ResponseWriter, entity any, ok bool, err error, notFound string) {
+	if err == nil && !ok {
+		http.Error(w, notFound, http.StatusNotFound)
+		return
+	}
+	writeResult(w, entity, err)
+}
+
+// writeResult writes the result in the legacy Heimdall response envelope.
+func writeResult(w http.ResponseWriter, result any, err error) {
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response, err := json.Marshal(struct {
+		Height string `json:"height"`
+		Result any    `json:"result"`
+	}{
+		Height: "0",
+		Result: result,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(response)
+}
@@ -0,0 +1,170 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package heimdall
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon/turbo/testlog"
+)
+
+type testEventReader []*EventRecordWithTime
+
+func (events testEventReader) LastEventId(ctx context.Context) (uint64, error) {
+	return uint64(len(events)), nil
+}
+
+func (events testEventReader) Events(ctx context.Context, start, end uint64) ([][]byte, error) {
+	var result [][]byte
+	for _, event := range events {
+		if start <= event.ID && event.ID < end {
+			data, err := event.MarshallBytes()
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, data)
+		}
+	}
+	return result, nil
+}
+
+type testStatusFetcher Status
+
+func (status *testStatusFetcher) FetchStatus(ctx context.Context) (*Status, error) {
+	return (*Status)(status), nil
+}
+
+func TestHttpServer(t *testing.T) {
+	ctx := context.Background()
+	logger := testlog.Logger(t, log.LvlDebug)
+	store := NewMdbxStore(logger, t.TempDir(), false, 1)
+	require.NoError(t, store.Prepare(ctx))
+	t.Cleanup(store.Close)
+
+	for i := uint64(0); i < 3; i++ {
+		span := &Span{Id: SpanId(i), StartBlock: i * 100, EndBlock: i*100 + 99, ChainID: "80002"}
+		require.NoError(t, store.Spans().PutEntity(ctx, i, span))
+	}
+	for i := uint64(1); i <= 3; i++ {
+		checkpoint := &Checkpoint{Id: CheckpointId(i), Fields: WaypointFields{
+			StartBlock: big.NewInt(int64(i * 10)),
+			EndBlock:   big.NewInt(int64(i*10 + 9)),
+			RootHash:   libcommon.Hash{byte(i)},
+			ChainID:    "80002",
+			Timestamp:  i,
+		}}
+		require.NoError(t, store.Checkpoints().PutEntity(ctx, i, checkpoint))
+	}
+	for i := uint64(5); i <= 6; i++ {
+		milestone := &Milestone{Id: MilestoneId(i), Fields: WaypointFields{
+			StartBlock: big.NewInt(int64(i)),
+			EndBlock:   big.NewInt(int64(i)),
+			RootHash:   libcommon.Hash{byte(i)},
+			ChainID:    "80002",
+			Timestamp:  i,
+		}}
+		require.NoError(t, store.Milestones().PutEntity(ctx, i, milestone))
+	}
+
+	eventTime := time.Unix(1700000000, 0).UTC()
+	var events testEventReader
+	for i := uint64(1); i <= 60; i++ {
+		events = append(events, &EventRecordWithTime{
+			EventRecord: EventRecord{ID: i, Contract: libcommon.Address{1}, Data: []byte{byte(i)}, ChainID: "80002"},
+			Time:        eventTime.Add(time.Duration(i) * time.Second),
+		})
+	}
+
+	status := &testStatusFetcher{LatestBlockHeight: "10", LatestBlockTime: "2025-06-12T09:20:11Z"}
+	server := httptest.NewServer(NewHttpServer("", store, events, status, logger).Handler())
+	t.Cleanup(server.Close)
+	client := newTestClient(t, server.URL)
+
+	span, err := client.FetchLatestSpan(ctx)
+	require.NoError(t, err)
+	require.Equal(t, client.v1, client.client, "the server must look like a legacy Heimdall")
+	require.Equal(t, SpanId(2), span.Id)
+
+	spans, err := NewSpanFetcher(client, logger).FetchAllEntities(ctx)
+	require.NoError(t, err)
+	require.Len(t, spans, 3)
+
+	_, err = client.FetchSpan(ctx, 3)
+	require.ErrorIs(t, err, ErrNotSuccessfulResponse)
+
+	count, err := client.FetchCheckpointCount(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+
+	checkpoint, err := client.FetchCheckpoint(ctx, -1)
+	require.NoError(t, err)
+	require.Equal(t, CheckpointId(3), checkpoint.Id)
+
+	checkpoints, err := client.FetchCheckpoints(ctx, 2, 2)
+	require.NoError(t, err)
+	require.Len(t, checkpoints, 1)
+	require.Equal(t, libcommon.Hash{3}, checkpoints[0].RootHash())
+
+	checkpoints, err = NewCheckpointFetcher(client, logger).FetchAllEntities(ctx)
+	require.NoError(t, err)
+	require.Len(t, checkpoints, 3)
+
+	milestone, err := client.FetchMilestone(ctx, -1)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(6), milestone.EndBlock())
+
+	milestone, err = client.FetchMilestone(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, MilestoneId(5), milestone.Id)
+
+	_, err = client.FetchMilestone(ctx, 4)
+	require.ErrorIs(t, err, ErrNotInMilestoneList)
+
+	first, err := client.FetchFirstMilestoneNum(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+
+	fetched, err := client.FetchStateSyncEvents(ctx, 1, eventTime.Add(time.Hour), 0)
+	require.NoError(t, err)
+	require.Len(t, fetched, 60, "events are paged")
+	require.Equal(t, events[59].ID, fetched[59].ID)
+	require.Equal(t, events[59].Data, fetched[59].Data)
+	require.True(t, events[59].Time.Equal(fetched[59].Time))
+
+	fetched, err = client.FetchStateSyncEvents(ctx, 5, eventTime.Add(10*time.Second), 0)
+	require.NoError(t, err)
+	require.Len(t, fetched, 5, "events are limited by time")
+
+	event, err := client.FetchStateSyncEvent(ctx, 7)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), event.ID)
+
+	_, err = client.FetchStateSyncEvent(ctx, 61)
+	require.ErrorIs(t, err, ErrEventRecordNotFound)
+
+	fetchedStatus, err := client.FetchStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, (*Status)(status), fetchedStatus)
+}
@@ -2,6 +2,7 @@ package heimdall
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"google.golang.org/grpc"
@@ -63,6 +64,14 @@ func (r *Reader) MilestonesFromBlock(ctx context.Context, startBlock uint64) ([]
 	return r.store.Milestones().RangeFromBlockNum(ctx, startBlock)
 }
 
+func (r *Reader) CheckpointByBlock(ctx context.Context, blockNum uint64) (*Checkpoint, bool, error) {
+	return entityByBlockNum(ctx, r.store.Checkpoints(), blockNum)
+}
+
+func (r *Reader) MilestoneByBlock(ctx context.Context, blockNum uint64) (*Milestone, bool, error) {
+	return entityByBlockNum(ctx, r.store.Milestones(), blockNum)
+}
+
 func (r *Reader) Producers(ctx context.Context, blockNum uint64) (*valset.ValidatorSet, error) {
 	return r.spanBlockProducersTracker.Producers(ctx, blockNum)
 }
@@ -71,6 +80,31 @@ func (r *Reader) Close() {
 	r.store.Close()
 }
 
+// entityByBlockNum returns the entity whose block range contains the given block number.
+func entityByBlockNum[TEntity Entity](ctx context.Context, store EntityStore[TEntity], blockNum uint64) (TEntity, bool, error) {
+	var none TEntity
+
+	id, ok, err := store.EntityIdFromBlockNum(ctx, blockNum)
+	if err != nil || !ok {
+		return none, false, err
+	}
+
+	entity, ok, err := store.Entity(ctx, id)
+	if err != nil || !ok {
+		return none, false, err
+	}
+
+	// the index finds the first entity ending at or after the block number, which
+	// starts after it when the block is before the first stored entity
+	if entity.BlockNumRange().Start > blockNum {
+		return none, false, nil
+	}
+
+	return entity, true, nil
+}
+
+var errRemoteReaderUnsupported = errors.New("not supported by the remote heimdall reader, run with --datadir")
+
 type RemoteReader struct {
 	client  remote.HeimdallBackendClient
 	logger  log.Logger
@@ -110,6 +144,16 @@ func (r *RemoteReader) Producers(ctx context.Context, blockNum uint64) (*valset.
 	return &validatorSet, nil
 }
 
+// CheckpointByBlock is not supported, the heimdall backend server only serves producers.
+func (r *RemoteReader) CheckpointByBlock(ctx context.Context, blockNum uint64) (*Checkpoint, bool, error) {
+	return nil, false, errRemoteReaderUnsupported
+}
+
+// MilestoneByBlock is not supported, the heimdall backend server only serves producers.
+func (r *RemoteReader) MilestoneByBlock(ctx context.Context, blockNum uint64) (*Milestone, bool, error) {
+	return nil, false, errRemoteReaderUnsupported
+}
+
 // Close implements bridge.ReaderService. It's a noop as there is no attached store.
 func (r *RemoteReader) Close() {
 }
@@ -0,0 +1,63 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package heimdall
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon/polygon/bor/borcfg"
+	"github.com/erigontech/erigon/turbo/testlog"
+)
+
+func TestReaderWaypointByBlock(t *testing.T) {
+	ctx := context.Background()
+	logger := testlog.Logger(t, log.LvlDebug)
+	store := NewMdbxStore(logger, t.TempDir(), false, 1)
+	reader, err := AssembleReader(ctx, ReaderConfig{Store: store, BorConfig: &borcfg.BorConfig{}, Logger: logger})
+	require.NoError(t, err)
+	t.Cleanup(reader.Close)
+
+	for i := uint64(1); i <= 3; i++ {
+		fields := WaypointFields{StartBlock: big.NewInt(int64(i * 10)), EndBlock: big.NewInt(int64(i*10 + 9))}
+		require.NoError(t, store.Checkpoints().PutEntity(ctx, i, &Checkpoint{Id: CheckpointId(i), Fields: fields}))
+		require.NoError(t, store.Milestones().PutEntity(ctx, i, &Milestone{Id: MilestoneId(i), Fields: fields}))
+	}
+
+	checkpoint, ok, err := reader.CheckpointByBlock(ctx, 25)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, CheckpointId(2), checkpoint.Id)
+
+	milestone, ok, err := reader.MilestoneByBlock(ctx, 39)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, MilestoneId(3), milestone.Id)
+
+	for _, blockNum := range []uint64{5, 40} {
+		_, ok, err = reader.CheckpointByBlock(ctx, blockNum)
+		require.NoError(t, err)
+		require.False(t, ok, "block %d", blockNum)
+		_, ok, err = reader.MilestoneByBlock(ctx, blockNum)
+		require.NoError(t, err)
+		require.False(t, ok, "block %d", blockNum)
+	}
+}
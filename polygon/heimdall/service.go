@@ -228,6 +228,14 @@ func (s *Service) MilestonesFromBlock(ctx context.Context, startBlock uint64) ([
 	return s.reader.MilestonesFromBlock(ctx, startBlock)
 }
 
+func (s *Service) CheckpointByBlock(ctx context.Context, blockNum uint64) (*Checkpoint, bool, error) {
+	return s.reader.CheckpointByBlock(ctx, blockNum)
+}
+
+func (s *Service) MilestoneByBlock(ctx context.Context, blockNum uint64) (*Milestone, bool, error) {
+	return s.reader.MilestoneByBlock(ctx, blockNum)
+}
+
 func (s *Service) Producers(ctx context.Context, blockNum uint64) (*valset.ValidatorSet, error) {
 	return s.reader.Producers(ctx, blockNum)
 }
@@ -0,0 +1,242 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/erigontech/erigon-lib/chain"
+	"github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/kv"
+	"github.com/erigontech/erigon-lib/kv/membatchwithdb"
+	"github.com/erigontech/erigon-lib/kv/rawdbv3"
+	"github.com/erigontech/erigon-lib/log/v3"
+	libstate "github.com/erigontech/erigon-lib/state"
+	"github.com/erigontech/erigon/consensus"
+	"github.com/erigontech/erigon/consensus/misc"
+	"github.com/erigontech/erigon/core"
+	"github.com/erigontech/erigon/core/rawdb"
+	"github.com/erigontech/erigon/core/state"
+	"github.com/erigontech/erigon/core/types"
+	"github.com/erigontech/erigon/core/vm"
+	"github.com/erigontech/erigon/eth/consensuschain"
+	"github.com/erigontech/erigon/params"
+	"github.com/erigontech/erigon/turbo/services"
+	"github.com/erigontech/erigon/txnprovider"
+)
+
+var errParentNotExecuted = errors.New("parent is not the executed head")
+var errSealingStopped = errors.New("sealing stopped")
+
+type BlockBuilder interface {
+	// BuildBlock assembles and executes a block on top of the parent, which has to be the
+	// executed head, and returns it with its state root set but not sealed yet.
+	BuildBlock(ctx context.Context, parent *types.Header) (*types.BlockWithReceipts, error)
+	// SealBlock signs the block and waits until its slot has come.
+	SealBlock(ctx context.Context, block *types.BlockWithReceipts) (*types.Block, error)
+}
+
+func NewBlockBuilder(
+	logger log.Logger,
+	chainConfig *chain.Config,
+	engine consensus.Engine,
+	db kv.RoDB,
+	blockReader services.FullBlockReader,
+	txnProvider txnprovider.TxnProvider,
+	miningConfig *params.MiningConfig,
+	tmpDir string,
+) *blockBuilder {
+	return &blockBuilder{
+		logger:       logger,
+		chainConfig:  chainConfig,
+		engine:       engine,
+		db:           db,
+		blockReader:  blockReader,
+		txnProvider:  txnProvider,
+		miningConfig: miningConfig,
+		tmpDir:       tmpDir,
+	}
+}
+
+// blockBuilder executes the block against an in-memory batch on top of the latest state, so
+// nothing is written to the database until the sealed block is inserted and executed by Sync.
+type blockBuilder struct {
+	logger       log.Logger
+	chainConfig  *chain.Config
+	engine       consensus.Engine
+	db           kv.RoDB
+	blockReader  services.FullBlockReader
+	txnProvider  txnprovider.TxnProvider
+	miningConfig *params.MiningConfig
+	tmpDir       string
+}
+
+func (b *blockBuilder) BuildBlock(ctx context.Context, parent *types.Header) (*types.BlockWithReceipts, error) {
+	tx, err := b.db.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if head := rawdb.ReadHeadBlockHash(tx); head != parent.Hash() {
+		return nil, fmt.Errorf("%w: parent=%s, head=%s", errParentNotExecuted, parent.Hash(), head)
+	}
+
+	mb := membatchwithdb.NewMemoryBatch(tx, b.tmpDir, b.logger)
+	defer mb.Close()
+
+	domains, err := libstate.NewSharedDomains(mb, b.logger)
+	if err != nil {
+		return nil, err
+	}
+	defer domains.Close()
+
+	txNum, err := rawdbv3.TxNums.Max(tx, parent.Number.Uint64())
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp := uint64(time.Now().Unix())
+	if parent.Time >= timestamp {
+		timestamp = parent.Time + 1
+	}
+
+	header := core.MakeEmptyHeader(parent, b.chainConfig, timestamp, &b.miningConfig.GasLimit)
+	if err := misc.VerifyGaslimit(parent.GasLimit, header.GasLimit); err != nil {
+		b.logger.Warn(syncLogPrefix("failed to verify gas limit given by the validator, defaulting to parent gas limit"), "err", err)
+		header.GasLimit = parent.GasLimit
+	}
+
+	header.Coinbase = b.miningConfig.Etherbase
+	header.Extra = b.miningConfig.ExtraData
+
+	stateReader := state.NewReaderV3(domains)
+	stateWriter := state.NewWriterV4(domains)
+	ibs := state.New(stateReader)
+	chainReader := consensuschain.NewReader(b.chainConfig, mb, b.blockReader, b.logger)
+
+	// sets the block time, which accounts for the wiggle of out-of-turn producers
+	if err := b.engine.Prepare(chainReader, header, ibs); err != nil {
+		return nil, err
+	}
+
+	blockNum := header.Number.Uint64()
+	domains.SetBlockNum(blockNum)
+	txNum++
+	domains.SetTxNum(txNum)
+
+	if err := core.InitializeBlockExecution(b.engine, chainReader, header, b.chainConfig, ibs, stateWriter, b.logger, nil); err != nil {
+		return nil, err
+	}
+
+	txns, err := b.txnProvider.ProvideTxns(
+		ctx,
+		txnprovider.WithParentBlockNum(parent.Number.Uint64()),
+		txnprovider.WithBlockTime(header.Time),
+		txnprovider.WithGasTarget(header.GasLimit),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	getHeader := func(hash common.Hash, number uint64) *types.Header {
+		h, err := b.blockReader.Header(ctx, mb, hash, number)
+		if err != nil {
+			b.logger.Warn(syncLogPrefix("could not read header for block building"), "number", number, "err", err)
+		}
+		return h
+	}
+
+	var includedTxns types.Transactions
+	var receipts types.Receipts
+	gasPool := new(core.GasPool).AddGas(header.GasLimit)
+	noopWriter := state.NewNoopWriter()
+	for _, txn := range txns {
+		if gasPool.Gas() < params.TxGas {
+			break
+		}
+
+		txNum++
+		domains.SetTxNum(txNum)
+		ibs.SetTxContext(len(includedTxns))
+		gasSnap := gasPool.Gas()
+		snap := ibs.Snapshot()
+
+		receipt, _, err := core.ApplyTransaction(b.chainConfig, core.GetHashFn(header, getHeader), b.engine, &b.miningConfig.Etherbase, gasPool, ibs, noopWriter, header, txn, &header.GasUsed, header.BlobGasUsed, vm.Config{})
+		if err != nil {
+			ibs.RevertToSnapshot(snap)
+			gasPool = new(core.GasPool).AddGas(gasSnap)
+			txNum--
+			b.logger.Debug(syncLogPrefix("skipping transaction"), "hash", txn.Hash(), "err", err)
+			continue
+		}
+
+		includedTxns = append(includedTxns, txn)
+		receipts = append(receipts, receipt)
+	}
+
+	txNum++
+	domains.SetTxNum(txNum)
+
+	block, _, receipts, _, err := core.FinalizeBlockExecution(b.engine, stateReader, header, includedTxns, []*types.Header{}, stateWriter, b.chainConfig, ibs, receipts, nil, chainReader, true, b.logger)
+	if err != nil {
+		return nil, fmt.Errorf("cannot finalize block execution: %w", err)
+	}
+
+	root, err := domains.ComputeCommitment(ctx, true, blockNum, "")
+	if err != nil {
+		return nil, err
+	}
+
+	header = block.Header()
+	header.Root = common.BytesToHash(root)
+	return &types.BlockWithReceipts{Block: block.WithSeal(header), Receipts: receipts}, nil
+}
+
+func (b *blockBuilder) SealBlock(ctx context.Context, block *types.BlockWithReceipts) (*types.Block, error) {
+	results := make(chan *types.BlockWithReceipts, 1)
+	stop := make(chan struct{})
+	defer close(stop)
+
+	if err := b.seal(ctx, block, results, stop); err != nil {
+		return nil, err
+	}
+
+	select {
+	case sealed := <-results:
+		if sealed == nil {
+			return nil, errSealingStopped
+		}
+		return sealed.Block, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (b *blockBuilder) seal(ctx context.Context, block *types.BlockWithReceipts, results chan<- *types.BlockWithReceipts, stop <-chan struct{}) error {
+	tx, err := b.db.BeginRo(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	chainReader := consensuschain.NewReader(b.chainConfig, tx, b.blockReader, b.logger)
+	return b.engine.Seal(chainReader, block, results, stop)
+}
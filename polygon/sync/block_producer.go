@@ -0,0 +1,178 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon-lib/rlp"
+	"github.com/erigontech/erigon/core/types"
+	"github.com/erigontech/erigon/polygon/bor/valset"
+)
+
+// BlockProducerConfig enables block production when passed to NewService.
+type BlockProducerConfig struct {
+	Signer  common.Address
+	Builder BlockBuilder
+	// Output receives the sealed blocks, which are expected to come back to the service
+	// through the MinedBlockObserverRegistrar to get inserted and published.
+	Output chan<- *types.Block
+}
+
+type signerWiggleCalculator interface {
+	CalculateSignerWiggle(ctx context.Context, signer common.Address, blockNum uint64) (time.Duration, error)
+}
+
+type headerSubscriber interface {
+	AddHeaderSubscription() (chan [][]byte, func())
+}
+
+type blockProducerExecution interface {
+	Prepare(ctx context.Context) error
+	CurrentHeader(ctx context.Context) (*types.Header, error)
+}
+
+func NewBlockProducer(
+	logger log.Logger,
+	config BlockProducerConfig,
+	wiggleCalc signerWiggleCalculator,
+	headers headerSubscriber,
+	execution blockProducerExecution,
+) *BlockProducer {
+	return &BlockProducer{
+		logger:     logger,
+		signer:     config.Signer,
+		builder:    config.Builder,
+		output:     config.Output,
+		wiggleCalc: wiggleCalc,
+		headers:    headers,
+		execution:  execution,
+	}
+}
+
+// BlockProducer produces a block on top of every new tip for which the signer is in the
+// producer set. Out-of-turn producers wait for their wiggle before building, so that the
+// production is abandoned if a block of a producer before them in the succession arrives.
+type BlockProducer struct {
+	logger     log.Logger
+	signer     common.Address
+	builder    BlockBuilder
+	output     chan<- *types.Block
+	wiggleCalc signerWiggleCalculator
+	headers    headerSubscriber
+	execution  blockProducerExecution
+}
+
+func (p *BlockProducer) Run(ctx context.Context) error {
+	p.logger.Info(syncLogPrefix("running block producer component"), "signer", p.signer)
+
+	headers, unsubscribe := p.headers.AddHeaderSubscription()
+	defer unsubscribe()
+
+	if err := p.execution.Prepare(ctx); err != nil {
+		return err
+	}
+
+	// the tip may not change until we produce on it, e.g. when we are the only producer
+	tip, err := p.execution.CurrentHeader(ctx)
+	if err != nil {
+		return err
+	}
+
+	produceCtx, cancel := context.WithCancel(ctx)
+	go p.produce(produceCtx, tip)
+
+	for {
+		select {
+		case <-ctx.Done():
+			cancel()
+			return ctx.Err()
+		case headersRlp, ok := <-headers:
+			if !ok {
+				cancel()
+				return nil
+			}
+			if len(headersRlp) == 0 {
+				continue
+			}
+
+			var newTip types.Header
+			if err := rlp.DecodeBytes(headersRlp[len(headersRlp)-1], &newTip); err != nil {
+				p.logger.Warn(syncLogPrefix("could not decode new tip header"), "err", err)
+				continue
+			}
+
+			// abandon the block being produced on the previous tip
+			cancel()
+			produceCtx, cancel = context.WithCancel(ctx)
+			go p.produce(produceCtx, &newTip)
+		}
+	}
+}
+
+func (p *BlockProducer) produce(ctx context.Context, parent *types.Header) {
+	blockNum := parent.Number.Uint64() + 1
+	wiggle, err := p.wiggleCalc.CalculateSignerWiggle(ctx, p.signer, blockNum)
+	var unauthorizedErr *valset.UnauthorizedSignerError
+	if errors.As(err, &unauthorizedErr) {
+		p.logger.Debug(syncLogPrefix("not a producer of block"), "number", blockNum, "signer", p.signer)
+		return
+	}
+	if err != nil {
+		p.logger.Warn(syncLogPrefix("could not calculate wiggle"), "number", blockNum, "err", err)
+		return
+	}
+
+	// the block time is one period plus the wiggle after the parent, and we give ourselves a
+	// period to build the block, so only out-of-turn producers have to wait before building
+	if err := common.Sleep(ctx, time.Until(time.Unix(int64(parent.Time), 0).Add(wiggle))); err != nil {
+		return
+	}
+
+	block, err := p.builder.BuildBlock(ctx, parent)
+	if err != nil {
+		if ctx.Err() == nil {
+			p.logger.Warn(syncLogPrefix("could not build block"), "number", blockNum, "err", err)
+		}
+		return
+	}
+
+	sealed, err := p.builder.SealBlock(ctx, block)
+	if err != nil {
+		if ctx.Err() == nil {
+			p.logger.Warn(syncLogPrefix("could not seal block"), "number", blockNum, "err", err)
+		}
+		return
+	}
+
+	p.logger.Info(
+		syncLogPrefix("produced block"),
+		"number", blockNum,
+		"hash", sealed.Hash(),
+		"txns", sealed.Transactions().Len(),
+		"wiggle", wiggle,
+	)
+
+	select {
+	case p.output <- sealed:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package sync
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon-lib/rlp"
+	"github.com/erigontech/erigon/core/types"
+	"github.com/erigontech/erigon/polygon/bor/valset"
+	"github.com/erigontech/erigon/turbo/testlog"
+)
+
+type testBlockBuilder struct{}
+
+func (testBlockBuilder) BuildBlock(ctx context.Context, parent *types.Header) (*types.BlockWithReceipts, error) {
+	header := &types.Header{ParentHash: parent.Hash(), Number: new(big.Int).Add(parent.Number, common.Big1)}
+	return &types.BlockWithReceipts{Block: types.NewBlockWithHeader(header)}, nil
+}
+
+func (testBlockBuilder) SealBlock(ctx context.Context, block *types.BlockWithReceipts) (*types.Block, error) {
+	return block.Block, nil
+}
+
+// testWiggleCalc maps block numbers to wiggles, the signer is unauthorized for other blocks
+type testWiggleCalc map[uint64]time.Duration
+
+func (calc testWiggleCalc) CalculateSignerWiggle(ctx context.Context, signer common.Address, blockNum uint64) (time.Duration, error) {
+	wiggle, ok := calc[blockNum]
+	if !ok {
+		return 0, &valset.UnauthorizedSignerError{Number: blockNum, Signer: signer.Bytes()}
+	}
+	return wiggle, nil
+}
+
+type testHeaderSubscriber chan [][]byte
+
+func (headers testHeaderSubscriber) AddHeaderSubscription() (chan [][]byte, func()) {
+	return headers, func() {}
+}
+
+type testBlockProducerExecution types.Header
+
+func (tip *testBlockProducerExecution) Prepare(ctx context.Context) error {
+	return nil
+}
+
+func (tip *testBlockProducerExecution) CurrentHeader(ctx context.Context) (*types.Header, error) {
+	return (*types.Header)(tip), nil
+}
+
+func TestBlockProducer(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	now := uint64(time.Now().Unix())
+	newHeader := func(num uint64) *types.Header {
+		return &types.Header{Number: new(big.Int).SetUint64(num), Time: now}
+	}
+
+	headers := make(testHeaderSubscriber)
+	output := make(chan *types.Block)
+	wiggles := testWiggleCalc{11: 0, 13: time.Hour, 14: 0}
+	producer := NewBlockProducer(
+		testlog.Logger(t, log.LvlCrit),
+		BlockProducerConfig{Builder: testBlockBuilder{}, Output: output},
+		wiggles,
+		headers,
+		(*testBlockProducerExecution)(newHeader(10)),
+	)
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		return producer.Run(ctx)
+	})
+
+	newTip := func(header *types.Header) {
+		headerRlp, err := rlp.EncodeToBytes(header)
+		require.NoError(t, err)
+		headers <- [][]byte{headerRlp}
+	}
+
+	block := readBlock(ctx, t, output)
+	require.Equal(t, uint64(11), block.NumberU64(), "produces on the current tip")
+
+	// not a producer of block 12
+	newTip(newHeader(11))
+	// out-of-turn for block 13 which gets abandoned when the next tip arrives
+	newTip(newHeader(12))
+	newTip(newHeader(13))
+
+	block = readBlock(ctx, t, output)
+	require.Equal(t, uint64(14), block.NumberU64())
+
+	cancel()
+	require.ErrorIs(t, eg.Wait(), context.Canceled)
+}
+
+func readBlock(ctx context.Context, t *testing.T, ch <-chan *types.Block) *types.Block {
+	select {
+	case block := <-ch:
+		return block
+	case <-ctx.Done():
+		require.FailNow(t, "timed out waiting for block")
+		return nil
+	}
+}
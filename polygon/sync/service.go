@@ -52,7 +52,7 @@ func NewService(
 	notifications *shards.Notifications,
 	engineAPISwitcher EngineAPISwitcher,
 	minedBlockReg MinedBlockObserverRegistrar,
-
+	blockProducerConfig *BlockProducerConfig,
 ) *Service {
 	borConfig := chainConfig.Bor.(*borcfg.BorConfig)
 	checkpointVerifier := VerifyCheckpointHeaders
@@ -79,6 +79,7 @@ func NewService(
 	)
 	ccBuilderFactory := NewCanonicalChainBuilderFactory(chainConfig, borConfig, heimdallService, signaturesCache)
 	events := NewTipEvents(logger, p2pService, heimdallService, minedBlockReg)
+	wiggleCalc := NewWiggleCalculator(borConfig, signaturesCache, heimdallService)
 	sync := NewSync(
 		config,
 		logger,
@@ -93,9 +94,13 @@ func NewService(
 		bridgeService,
 		events.Events(),
 		notifications,
-		NewWiggleCalculator(borConfig, signaturesCache, heimdallService),
+		wiggleCalc,
 		engineAPISwitcher,
 	)
+	var blockProducer *BlockProducer
+	if blockProducerConfig != nil {
+		blockProducer = NewBlockProducer(logger, *blockProducerConfig, wiggleCalc, notifications.Events, execution)
+	}
 	return &Service{
 		logger:          logger,
 		sync:            sync,
@@ -104,6 +109,7 @@ func NewService(
 		events:          events,
 		heimdallService: heimdallService,
 		bridgeService:   bridgeService,
+		blockProducer:   blockProducer,
 	}
 }
 
@@ -115,6 +121,7 @@ type Service struct {
 	events          *TipEvents
 	heimdallService *heimdall.Service
 	bridgeService   *bridge.Service
+	blockProducer   *BlockProducer
 }
 
 func (s *Service) Run(parentCtx context.Context) error {
@@ -164,6 +171,23 @@ func (s *Service) Run(parentCtx context.Context) error {
 
 		return nil
 	})
+	if s.blockProducer != nil {
+		group.Go(func() error {
+			if err := <-s.heimdallService.Ready(ctx); err != nil {
+				return err
+			}
+
+			if err := <-s.bridgeService.Ready(ctx); err != nil {
+				return err
+			}
+
+			if err := s.blockProducer.Run(ctx); err != nil {
+				return fmt.Errorf("pos sync block producer failed: %w", err)
+			}
+
+			return nil
+		})
+	}
 
 	return group.Wait()
 }
@@ -47,16 +47,22 @@ func (calc *wiggleCalc) CalculateWiggle(ctx context.Context, header *types.Heade
 		return 0, err
 	}
 
-	producers, err := calc.blockProducersReader.Producers(ctx, header.Number.Uint64())
+	return calc.CalculateSignerWiggle(ctx, signer, header.Number.Uint64())
+}
+
+// CalculateSignerWiggle returns the delay of the given signer's slot for the block relative to the
+// in-turn producer's slot. It fails with valset.UnauthorizedSignerError if the signer is not a producer.
+func (calc *wiggleCalc) CalculateSignerWiggle(ctx context.Context, signer libcommon.Address, blockNum uint64) (time.Duration, error) {
+	producers, err := calc.blockProducersReader.Producers(ctx, blockNum)
 	if err != nil {
 		return 0, err
 	}
 
-	succession, err := producers.GetSignerSuccessionNumber(signer, header.Number.Uint64())
+	succession, err := producers.GetSignerSuccessionNumber(signer, blockNum)
 	if err != nil {
 		return 0, err
 	}
 
-	wiggle := time.Duration(succession) * time.Duration(calc.borConfig.CalculateBackupMultiplier(header.Number.Uint64())) * time.Second
+	wiggle := time.Duration(succession) * time.Duration(calc.borConfig.CalculateBackupMultiplier(blockNum)) * time.Second
 	return wiggle, nil
 }
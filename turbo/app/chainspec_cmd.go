@@ -0,0 +1,171 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon/core/chainspec"
+)
+
+var chainspecCommand = cli.Command{
+	Name:  "chainspec",
+	Usage: "Validate and compare chain specs",
+	Description: `
+The chainspec command checks chain specs given either by the name of a known chain
+or by the path of a genesis or chain config JSON file.`,
+	Subcommands: []*cli.Command{
+		{
+			Name:      "validate",
+			Usage:     "Check the fork ordering, system contracts, blob schedule and consensus sections of a chain spec",
+			ArgsUsage: "<chain|specPath>",
+			Action:    validateChainSpec,
+		},
+		{
+			Name:      "diff",
+			Usage:     "Print the differences and the fork ID timelines of two chain specs",
+			ArgsUsage: "<chain|specPath> <chain|specPath>",
+			Action:    diffChainSpecs,
+		},
+		{
+			Name:      "forkids",
+			Usage:     "Print the EIP-2124 fork ID timeline of a chain spec",
+			ArgsUsage: "<chain|specPath>",
+			Action:    printForkIDs,
+		},
+	},
+}
+
+func validateChainSpec(cliCtx *cli.Context) error {
+	if cliCtx.Args().Len() != 1 {
+		return errors.New("expected a chain name or a chain spec path")
+	}
+
+	spec, err := chainspec.Load(cliCtx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	w := cliCtx.App.Writer
+	issues := chainspec.Validate(spec)
+
+	if len(issues) == 0 {
+		fmt.Fprintf(w, "%s: no issues found\n", spec.Name)
+	}
+	for _, issue := range issues {
+		fmt.Fprintf(w, "%s: %s\n", spec.Name, issue)
+	}
+
+	if err := printTimeline(w, spec); err != nil {
+		return err
+	}
+
+	if chainspec.HasErrors(issues) {
+		return fmt.Errorf("chain spec %s is invalid", spec.Name)
+	}
+	return nil
+}
+
+func diffChainSpecs(cliCtx *cli.Context) error {
+	if cliCtx.Args().Len() != 2 {
+		return errors.New("expected two chain names or chain spec paths")
+	}
+
+	a, err := chainspec.Load(cliCtx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+
+	b, err := chainspec.Load(cliCtx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+
+	differences, err := chainspec.Diff(a.Config, b.Config)
+	if err != nil {
+		return err
+	}
+
+	w := cliCtx.App.Writer
+
+	if len(differences) == 0 {
+		fmt.Fprintln(w, "chain configs are identical")
+	} else {
+		fmt.Fprintf(w, "%-40s %-30s %s\n", "field", a.Name, b.Name)
+	}
+	for _, difference := range differences {
+		fmt.Fprintf(w, "%-40s %-30s %s\n", difference.Field, orUnset(difference.A), orUnset(difference.B))
+	}
+
+	for _, spec := range []*chainspec.Spec{a, b} {
+		fmt.Fprintln(w)
+		if err := printTimeline(w, spec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printForkIDs(cliCtx *cli.Context) error {
+	if cliCtx.Args().Len() != 1 {
+		return errors.New("expected a chain name or a chain spec path")
+	}
+
+	spec, err := chainspec.Load(cliCtx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	return printTimeline(cliCtx.App.Writer, spec)
+}
+
+func printTimeline(w io.Writer, spec *chainspec.Spec) error {
+	genesisHash, err := spec.GenesisHash(log.Root())
+	if err != nil {
+		return err
+	}
+
+	if genesisHash == nil {
+		fmt.Fprintf(w, "%s: no genesis, the fork ID timeline is unknown\n", spec.Name)
+		return nil
+	}
+
+	fmt.Fprintf(w, "%s: genesis %x\n", spec.Name, *genesisHash)
+	fmt.Fprintf(w, "%-12s %-8s %-12s %-22s %s\n", "activation", "kind", "fork hash", "next", "forks")
+
+	for _, fork := range chainspec.Timeline(spec.Config, *genesisHash, spec.GenesisTime()) {
+		kind := "block"
+		if fork.TimeBased {
+			kind = "time"
+		}
+		fmt.Fprintf(w, "%-12d %-8s %#-12x %-22d %s\n", fork.Activation, kind, fork.ID.Hash, fork.ID.Next, strings.Join(fork.Names, ", "))
+	}
+	return nil
+}
+
+func orUnset(value string) string {
+	if value == "" {
+		return "<unset>"
+	}
+	return value
+}
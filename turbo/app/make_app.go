@@ -82,6 +82,7 @@ func MakeApp(name string, action cli.ActionFunc, cliFlags []cli.Flag) *cli.App {
 		&importCommand,
 		&snapshotCommand,
 		&supportCommand,
+		&chainspecCommand,
 		//&backupCommand,
 	}
 	return app
@@ -39,6 +39,11 @@ var DefaultFlags = []cli.Flag{
 	&utils.TxPoolGlobalQueueFlag,
 	&utils.TxPoolTraceSendersFlag,
 	&utils.TxPoolCommitEveryFlag,
+	&utils.TxPoolLocalJournalLimitFlag,
+	&utils.TxPoolRebroadcastLocalsEveryFlag,
+	&utils.TxPoolOrderingFlag,
+	&utils.TxPoolAllowedSendersFlag,
+	&utils.TxPoolDeniedSendersFlag,
 	&PruneDistanceFlag,
 	&PruneBlocksDistanceFlag,
 	&PruneModeFlag,
@@ -121,10 +126,12 @@ var DefaultFlags = []cli.Flag{
 	&utils.TorrentVerbosityFlag,
 	&utils.ListenPortFlag,
 	&utils.P2pProtocolVersionFlag,
+	&utils.P2pSnapServerFlag,
 	&utils.P2pProtocolAllowedPorts,
 	&utils.NATFlag,
 	&utils.NoDiscoverFlag,
 	&utils.DiscoveryV5Flag,
+	&utils.DiscoveryV5TopicsFlag,
 	&utils.NetrestrictFlag,
 	&utils.NodeKeyFileFlag,
 	&utils.NodeKeyHexFlag,
@@ -164,6 +171,7 @@ var DefaultFlags = []cli.Flag{
 	&utils.DownloaderVerifyFlag,
 	&HealthCheckFlag,
 	&utils.HeimdallURLFlag,
+	&utils.HeimdallServeAddrFlag,
 	&utils.WebSeedsFlag,
 	&utils.WithoutHeimdallFlag,
 	&utils.BorBlockPeriodFlag,
@@ -225,6 +233,7 @@ var DefaultFlags = []cli.Flag{
 	&utils.CaplinEnableSnapshotGeneration,
 	&utils.CaplinMevRelayUrl,
 	&utils.CaplinValidatorMonitorFlag,
+	&utils.CaplinValidatorMonitorIdsFlag,
 	&utils.CaplinCustomConfigFlag,
 	&utils.CaplinCustomGenesisFlag,
 
@@ -3,6 +3,7 @@ package engineapi
 import (
 	"context"
 	"encoding/binary"
+	"slices"
 
 	libcommon "github.com/erigontech/erigon-lib/common"
 	"github.com/erigontech/erigon-lib/common/hexutil"
@@ -30,6 +31,11 @@ var ourCapabilities = []string{
 	"engine_getClientVersionV1",
 }
 
+// osakaCapabilities are only advertised once the chain schedules Osaka.
+var osakaCapabilities = []string{
+	"engine_getBlobsV2",
+}
+
 // Returns the most recent version of the payload(for the payloadID) at the time of receiving the call
 // See https://github.com/ethereum/execution-apis/blob/main/src/engine/paris.md#engine_getpayloadv1
 func (e *EngineServer) GetPayloadV1(ctx context.Context, payloadId hexutil.Bytes) (*engine_types.ExecutionPayload, error) {
@@ -158,14 +164,18 @@ func (e *EngineServer) GetClientVersionV1(ctx context.Context, callerVersion *en
 
 func (e *EngineServer) ExchangeCapabilities(fromCl []string) []string {
 	e.engineLogSpamer.RecordRequest()
-	missingOurs := compareCapabilities(fromCl, ourCapabilities)
-	missingCl := compareCapabilities(ourCapabilities, fromCl)
+	capabilities := ourCapabilities
+	if e.config.OsakaTime != nil {
+		capabilities = append(slices.Clone(ourCapabilities), osakaCapabilities...)
+	}
+	missingOurs := compareCapabilities(fromCl, capabilities)
+	missingCl := compareCapabilities(capabilities, fromCl)
 
 	if len(missingCl) > 0 || len(missingOurs) > 0 {
 		e.logger.Debug("ExchangeCapabilities mismatches", "cl_unsupported", missingCl, "erigon_unsupported", missingOurs)
 	}
 
-	return ourCapabilities
+	return capabilities
 }
 
 func (e *EngineServer) GetBlobsV1(ctx context.Context, blobHashes []libcommon.Hash) ([]*txpoolproto.BlobAndProofV1, error) {
@@ -173,3 +183,10 @@ func (e *EngineServer) GetBlobsV1(ctx context.Context, blobHashes []libcommon.Ha
 	return e.getBlobs(ctx, blobHashes)
 
 }
+
+// GetBlobsV2 returns blobs with their cell proofs, or null if any of the blobs is missing
+// See https://github.com/ethereum/execution-apis/blob/main/src/engine/osaka.md#engine_getblobsv2
+func (e *EngineServer) GetBlobsV2(ctx context.Context, blobHashes []libcommon.Hash) ([]*engine_types.BlobAndProofV2, error) {
+	e.logger.Debug("[engine_getBlobsV2] Received Request", "hashes", len(blobHashes))
+	return e.getBlobsV2(ctx, blobHashes)
+}
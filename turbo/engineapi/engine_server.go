@@ -902,6 +902,40 @@ func (e *EngineServer) getBlobs(ctx context.Context, blobHashes []libcommon.Hash
 	return res.BlobsAndProofs, nil
 }
 
+func (e *EngineServer) getBlobsV2(ctx context.Context, blobHashes []libcommon.Hash) ([]*engine_types.BlobAndProofV2, error) {
+	if head := e.chainRW.CurrentHeader(ctx); head == nil || !e.config.IsOsaka(head.Time) {
+		return nil, &rpc.UnsupportedForkError{Message: "Unsupported fork"}
+	}
+	if len(blobHashes) > 128 {
+		return nil, &engine_helpers.TooLargeRequestErr
+	}
+	req := &txpool.GetBlobsRequest{BlobHashes: make([]*typesproto.H256, len(blobHashes)), CellProofs: true}
+	for i := range blobHashes {
+		req.BlobHashes[i] = gointerfaces.ConvertHashToH256(blobHashes[i])
+	}
+	res, err := e.txpool.GetBlobs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.BlobsAndCellProofs) != len(blobHashes) {
+		return nil, nil
+	}
+
+	result := make([]*engine_types.BlobAndProofV2, len(res.BlobsAndCellProofs))
+	for i, blobAndProofs := range res.BlobsAndCellProofs {
+		// all or nothing: the CL can't reconstruct the data column sidecars from a part of the blobs
+		if blobAndProofs == nil || len(blobAndProofs.Blob) == 0 {
+			return nil, nil
+		}
+		proofs := make([]hexutil.Bytes, len(blobAndProofs.Proofs))
+		for j := range blobAndProofs.Proofs {
+			proofs[j] = blobAndProofs.Proofs[j]
+		}
+		result[i] = &engine_types.BlobAndProofV2{Blob: blobAndProofs.Blob, Proofs: proofs}
+	}
+	return result, nil
+}
+
 func waitForStuff(maxWait time.Duration, waitCondnF func() (bool, error)) (bool, error) {
 	shouldWait, err := waitCondnF()
 	if err != nil || !shouldWait {
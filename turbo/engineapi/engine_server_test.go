@@ -18,6 +18,7 @@ package engineapi
 
 import (
 	"bytes"
+	"context"
 	"math/big"
 	"testing"
 
@@ -41,6 +42,7 @@ import (
 	"github.com/erigontech/erigon/eth/ethconfig"
 	"github.com/erigontech/erigon/eth/protocols/eth"
 
+	"github.com/erigontech/erigon/rpc"
 	"github.com/erigontech/erigon/rpc/rpccfg"
 	"github.com/erigontech/erigon/turbo/jsonrpc"
 	"github.com/erigontech/erigon/turbo/rpchelper"
@@ -126,3 +128,22 @@ func TestGetBlobsV1(t *testing.T) {
 	require.Equal(blobsResp[0].Proof, wrappedTxn.Proofs[0][:])
 	require.Equal(blobsResp[1].Proof, wrappedTxn.Proofs[1][:])
 }
+
+func TestGetBlobsV2UnsupportedFork(t *testing.T) {
+	mockSentry, require := mock.MockWithTxPoolCancun(t), require.New(t)
+	oneBlockStep(mockSentry, require, t)
+	ctx := context.Background()
+	hashes := []common.Hash{{1}}
+
+	executionRpc := direct.NewExecutionClientDirect(mockSentry.Eth1ExecutionService)
+	engineServer := NewEngineServer(mockSentry.Log, mockSentry.ChainConfig, executionRpc, mockSentry.HeaderDownload(), nil, false, true, false, true)
+	_, err := engineServer.GetBlobsV2(ctx, hashes)
+	var unsupportedForkErr *rpc.UnsupportedForkError
+	require.ErrorAs(err, &unsupportedForkErr)
+	require.NotContains(engineServer.ExchangeCapabilities(nil), "engine_getBlobsV2")
+
+	osakaConfig := *mockSentry.ChainConfig
+	osakaConfig.OsakaTime = big.NewInt(0)
+	engineServer = NewEngineServer(mockSentry.Log, &osakaConfig, executionRpc, mockSentry.HeaderDownload(), nil, false, true, false, true)
+	require.Contains(engineServer.ExchangeCapabilities(nil), "engine_getBlobsV2")
+}
@@ -42,4 +42,5 @@ type EngineAPI interface {
 	GetPayloadBodiesByRangeV1(ctx context.Context, start, count hexutil.Uint64) ([]*engine_types.ExecutionPayloadBody, error)
 	GetClientVersionV1(ctx context.Context, callerVersion *engine_types.ClientVersionV1) ([]engine_types.ClientVersionV1, error)
 	GetBlobsV1(ctx context.Context, blobHashes []common.Hash) ([]*txpoolproto.BlobAndProofV1, error)
+	GetBlobsV2(ctx context.Context, blobHashes []common.Hash) ([]*engine_types.BlobAndProofV2, error)
 }
@@ -26,6 +26,7 @@ import (
 	"github.com/erigontech/erigon/consensus"
 	"github.com/erigontech/erigon/polygon/bor"
 	"github.com/erigontech/erigon/polygon/bor/valset"
+	"github.com/erigontech/erigon/polygon/heimdall"
 	"github.com/erigontech/erigon/rpc"
 )
 
@@ -42,27 +43,49 @@ type BorAPI interface {
 	GetSnapshotProposer(blockNrOrHash *rpc.BlockNumberOrHash) (common.Address, error)
 	GetSnapshotProposerSequence(blockNrOrHash *rpc.BlockNumberOrHash) (BlockSigners, error)
 	GetRootHash(start uint64, end uint64) (string, error)
+
+	// Bor bridge and heimdall related (see ./bor_heimdall.go)
+	GetStateSyncEvents(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) ([]*heimdall.EventRecordWithTime, error)
+	GetStateSyncTxnHash(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*common.Hash, error)
+	GetCheckpointByBlock(ctx context.Context, number rpc.BlockNumber) (*heimdall.Checkpoint, error)
+	GetMilestoneByBlock(ctx context.Context, number rpc.BlockNumber) (*heimdall.Milestone, error)
+	IsBlockFinalizedByMilestone(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (bool, error)
 }
 
 type spanProducersReader interface {
 	Producers(ctx context.Context, blockNum uint64) (*valset.ValidatorSet, error)
 }
 
+type waypointReader interface {
+	CheckpointByBlock(ctx context.Context, blockNum uint64) (*heimdall.Checkpoint, bool, error)
+	MilestoneByBlock(ctx context.Context, blockNum uint64) (*heimdall.Milestone, bool, error)
+}
+
+type heimdallReader interface {
+	spanProducersReader
+	waypointReader
+}
+
 // BorImpl is implementation of the BorAPI interface
 type BorImpl struct {
 	*BaseAPI
 	db                     kv.TemporalRoDB // the chain db
 	useSpanProducersReader bool
 	spanProducersReader    spanProducersReader
+	useWaypointReader      bool
+	waypointReader         waypointReader
 }
 
 // NewBorAPI returns BorImpl instance
-func NewBorAPI(base *BaseAPI, db kv.TemporalRoDB, spanProducersReader spanProducersReader) *BorImpl {
+func NewBorAPI(base *BaseAPI, db kv.TemporalRoDB, heimdallReader heimdallReader) *BorImpl {
+	useHeimdallReader := heimdallReader != nil && !reflect.ValueOf(heimdallReader).IsNil() // needed for interface nil caveat
 	return &BorImpl{
 		BaseAPI:                base,
 		db:                     db,
-		useSpanProducersReader: spanProducersReader != nil && !reflect.ValueOf(spanProducersReader).IsNil(), // needed for interface nil caveat
-		spanProducersReader:    spanProducersReader,
+		useSpanProducersReader: useHeimdallReader,
+		spanProducersReader:    heimdallReader,
+		useWaypointReader:      useHeimdallReader,
+		waypointReader:         heimdallReader,
 	}
 }
 
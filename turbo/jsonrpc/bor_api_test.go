@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/erigontech/erigon/polygon/bor/valset"
+	"github.com/erigontech/erigon/polygon/heimdall"
 )
 
 func TestUseSpanProducersReader(t *testing.T) {
@@ -35,10 +36,18 @@ func TestUseSpanProducersReader(t *testing.T) {
 	require.True(t, api.useSpanProducersReader)
 }
 
-var _ spanProducersReader = mockSpanProducersReader{}
+var _ heimdallReader = mockSpanProducersReader{}
 
 type mockSpanProducersReader struct{}
 
 func (m mockSpanProducersReader) Producers(context.Context, uint64) (*valset.ValidatorSet, error) {
 	panic("mock")
 }
+
+func (m mockSpanProducersReader) CheckpointByBlock(context.Context, uint64) (*heimdall.Checkpoint, bool, error) {
+	panic("mock")
+}
+
+func (m mockSpanProducersReader) MilestoneByBlock(context.Context, uint64) (*heimdall.Milestone, bool, error) {
+	panic("mock")
+}
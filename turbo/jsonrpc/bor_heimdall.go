@@ -0,0 +1,179 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package jsonrpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/kv"
+	"github.com/erigontech/erigon/core/types"
+	bortypes "github.com/erigontech/erigon/polygon/bor/types"
+	"github.com/erigontech/erigon/polygon/heimdall"
+	"github.com/erigontech/erigon/rpc"
+	"github.com/erigontech/erigon/turbo/rpchelper"
+)
+
+var errNoWaypointReader = errors.New("checkpoints and milestones are only available with --polygon.sync")
+
+// GetStateSyncEvents returns the state sync events committed in the given block.
+func (api *BorImpl) GetStateSyncEvents(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) ([]*heimdall.EventRecordWithTime, error) {
+	tx, err := api.db.BeginTemporalRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	_, _, messages, err := api.blockStateSyncEvents(ctx, tx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*heimdall.EventRecordWithTime, len(messages))
+	for i, msg := range messages {
+		events[i] = &heimdall.EventRecordWithTime{}
+		if err := events[i].UnmarshallBytes(msg.Data()); err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+// GetStateSyncTxnHash returns the hash of the bor transaction which carries the state sync
+// events of the given block, or nil if the block has no events.
+func (api *BorImpl) GetStateSyncTxnHash(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*common.Hash, error) {
+	tx, err := api.db.BeginTemporalRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	blockNum, blockHash, events, err := api.blockStateSyncEvents(ctx, tx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	txnHash := bortypes.ComputeBorTxHash(blockNum, blockHash)
+	return &txnHash, nil
+}
+
+func (api *BorImpl) blockStateSyncEvents(ctx context.Context, tx kv.Tx, blockNrOrHash rpc.BlockNumberOrHash) (uint64, common.Hash, []*types.Message, error) {
+	blockNum, blockHash, _, err := rpchelper.GetBlockNumber(ctx, blockNrOrHash, tx, api._blockReader, api.filters)
+	if err != nil {
+		return 0, common.Hash{}, nil, err
+	}
+
+	chainConfig, err := api.chainConfig(ctx, tx)
+	if err != nil {
+		return 0, common.Hash{}, nil, err
+	}
+	if chainConfig.Bor == nil {
+		return 0, common.Hash{}, nil, errors.New("not a bor chain")
+	}
+
+	events, err := api.stateSyncEvents(ctx, tx, blockHash, blockNum, chainConfig)
+	return blockNum, blockHash, events, err
+}
+
+// GetCheckpointByBlock returns the checkpoint whose block range contains the given block,
+// or nil if the block is not checkpointed yet.
+func (api *BorImpl) GetCheckpointByBlock(ctx context.Context, number rpc.BlockNumber) (*heimdall.Checkpoint, error) {
+	if !api.useWaypointReader {
+		return nil, errNoWaypointReader
+	}
+
+	blockNum, err := api.waypointBlockNum(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint, _, err := api.waypointReader.CheckpointByBlock(ctx, blockNum)
+	return checkpoint, err
+}
+
+// GetMilestoneByBlock returns the milestone whose block range contains the given block,
+// or nil if there is no such milestone.
+func (api *BorImpl) GetMilestoneByBlock(ctx context.Context, number rpc.BlockNumber) (*heimdall.Milestone, error) {
+	if !api.useWaypointReader {
+		return nil, errNoWaypointReader
+	}
+
+	blockNum, err := api.waypointBlockNum(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+
+	milestone, _, err := api.waypointReader.MilestoneByBlock(ctx, blockNum)
+	return milestone, err
+}
+
+func (api *BorImpl) waypointBlockNum(ctx context.Context, number rpc.BlockNumber) (uint64, error) {
+	if number >= 0 {
+		return uint64(number), nil
+	}
+
+	tx, err := api.db.BeginTemporalRo(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	blockNum, _, _, err := rpchelper.GetBlockNumber(ctx, rpc.BlockNumberOrHashWithNumber(number), tx, api._blockReader, api.filters)
+	return blockNum, err
+}
+
+// IsBlockFinalizedByMilestone checks that a milestone covers the given block and that the
+// canonical chain matches the milestone.
+func (api *BorImpl) IsBlockFinalizedByMilestone(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (bool, error) {
+	if !api.useWaypointReader {
+		return false, errNoWaypointReader
+	}
+
+	tx, err := api.db.BeginTemporalRo(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	blockNum, blockHash, _, err := rpchelper.GetBlockNumber(ctx, blockNrOrHash, tx, api._blockReader, api.filters)
+	if err != nil {
+		return false, err
+	}
+
+	milestone, ok, err := api.waypointReader.MilestoneByBlock(ctx, blockNum)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	// a milestone commits to the hash of its end block, which commits to the blocks before it
+	endHash, ok, err := api._blockReader.CanonicalHash(ctx, tx, milestone.EndBlock().Uint64())
+	if err != nil || !ok || endHash != milestone.RootHash() {
+		return false, err
+	}
+
+	canonicalHash, ok, err := api._blockReader.CanonicalHash(ctx, tx, blockNum)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	return canonicalHash == blockHash, nil
+}
@@ -0,0 +1,256 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package jsonrpc
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/kv/kvcache"
+	"github.com/erigontech/erigon-lib/rlp"
+	"github.com/erigontech/erigon/cmd/rpcdaemon/rpcdaemontest"
+	"github.com/erigontech/erigon/core"
+	"github.com/erigontech/erigon/core/types"
+	"github.com/erigontech/erigon/polygon/bor/borcfg"
+	bortypes "github.com/erigontech/erigon/polygon/bor/types"
+	"github.com/erigontech/erigon/polygon/bor/valset"
+	"github.com/erigontech/erigon/polygon/bridge"
+	"github.com/erigontech/erigon/polygon/heimdall"
+	"github.com/erigontech/erigon/rpc"
+	"github.com/erigontech/erigon/rpc/rpccfg"
+	"github.com/erigontech/erigon/turbo/stages/mock"
+)
+
+var _ bridgeReader = mockStateSyncEvents{}
+
+type mockStateSyncEvents map[uint64][]*types.Message
+
+func (m mockStateSyncEvents) Events(_ context.Context, blockNum uint64) ([]*types.Message, error) {
+	return m[blockNum], nil
+}
+
+func (m mockStateSyncEvents) EventTxnLookup(context.Context, common.Hash) (uint64, bool, error) {
+	panic("mock")
+}
+
+var _ heimdallReader = &mockWaypointReader{}
+
+type mockWaypointReader struct {
+	checkpoints []*heimdall.Checkpoint
+	milestones  []*heimdall.Milestone
+}
+
+func (m mockWaypointReader) Producers(context.Context, uint64) (*valset.ValidatorSet, error) {
+	panic("mock")
+}
+
+func (m mockWaypointReader) CheckpointByBlock(_ context.Context, blockNum uint64) (*heimdall.Checkpoint, bool, error) {
+	for _, checkpoint := range m.checkpoints {
+		if checkpoint.CmpRange(blockNum) == 0 {
+			return checkpoint, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+func (m mockWaypointReader) MilestoneByBlock(_ context.Context, blockNum uint64) (*heimdall.Milestone, bool, error) {
+	for _, milestone := range m.milestones {
+		if milestone.CmpRange(blockNum) == 0 {
+			return milestone, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+func waypointFields(start, end uint64, rootHash common.Hash) heimdall.WaypointFields {
+	return heimdall.WaypointFields{StartBlock: new(big.Int).SetUint64(start), EndBlock: new(big.Int).SetUint64(end), RootHash: rootHash}
+}
+
+func newBorHeimdallAPIForTest(t *testing.T, events mockStateSyncEvents, waypoints heimdallReader) (*BorImpl, *mock.MockSentry, *core.ChainPack) {
+	m, chain, _ := rpcdaemontest.CreateTestSentry(t)
+	base := NewBaseApi(nil, kvcache.New(kvcache.DefaultCoherentConfig), m.BlockReader, false, rpccfg.DefaultEvmCallTimeout, m.Engine, m.Dirs, events)
+	// the chain is not a bor chain, but the heimdall RPCs only check for the bor config
+	chainConfig := *m.ChainConfig
+	chainConfig.Bor = &borcfg.BorConfig{}
+	base._chainConfig.Store(&chainConfig)
+	base._genesis.Store(m.Genesis)
+	return NewBorAPI(base, m.DB, waypoints), m, chain
+}
+
+func stateSyncEventForTest(t *testing.T, id uint64) (*heimdall.EventRecordWithTime, *types.Message) {
+	event := &heimdall.EventRecordWithTime{
+		EventRecord: heimdall.EventRecord{ID: id, Contract: common.Address{1}, Data: []byte{byte(id)}, ChainID: "1337"},
+		Time:        time.Unix(int64(1000+id), 0),
+	}
+	data, err := event.MarshallBytes()
+	require.NoError(t, err)
+	stateReceiver := common.Address{2}
+	return event, bridge.NewStateSyncEventMessages([]rlp.RawValue{data}, &stateReceiver, core.SysCallGasLimit)[0]
+}
+
+func TestBorGetStateSyncEvents(t *testing.T) {
+	ctx := context.Background()
+	event1, msg1 := stateSyncEventForTest(t, 1)
+	event2, msg2 := stateSyncEventForTest(t, 2)
+	event3, msg3 := stateSyncEventForTest(t, 3)
+	api, _, chain := newBorHeimdallAPIForTest(t, mockStateSyncEvents{2: {msg1, msg2}, 4: {msg3}}, nil)
+
+	// found
+	events, err := api.GetStateSyncEvents(ctx, rpc.BlockNumberOrHashWithHash(chain.Blocks[1].Hash(), true))
+	require.NoError(t, err)
+	require.Equal(t, []*heimdall.EventRecordWithTime{event1, event2}, events)
+
+	// not found
+	events, err = api.GetStateSyncEvents(ctx, rpc.BlockNumberOrHashWithNumber(3))
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	// range
+	expected := map[uint64][]*heimdall.EventRecordWithTime{2: {event1, event2}, 4: {event3}}
+	for blockNum := uint64(1); blockNum <= uint64(chain.Length()); blockNum++ {
+		events, err := api.GetStateSyncEvents(ctx, rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(blockNum)))
+		require.NoError(t, err)
+		require.Len(t, events, len(expected[blockNum]), "block %d", blockNum)
+		for i := range events {
+			require.Equal(t, expected[blockNum][i], events[i])
+		}
+	}
+}
+
+func TestBorGetStateSyncTxnHash(t *testing.T) {
+	ctx := context.Background()
+	_, msg1 := stateSyncEventForTest(t, 1)
+	_, msg2 := stateSyncEventForTest(t, 2)
+	api, _, chain := newBorHeimdallAPIForTest(t, mockStateSyncEvents{2: {msg1}, 4: {msg2}}, nil)
+
+	// found
+	txnHash, err := api.GetStateSyncTxnHash(ctx, rpc.BlockNumberOrHashWithNumber(2))
+	require.NoError(t, err)
+	require.NotNil(t, txnHash)
+	require.Equal(t, bortypes.ComputeBorTxHash(2, chain.Blocks[1].Hash()), *txnHash)
+
+	// not found
+	txnHash, err = api.GetStateSyncTxnHash(ctx, rpc.BlockNumberOrHashWithNumber(3))
+	require.NoError(t, err)
+	require.Nil(t, txnHash)
+
+	// range
+	for blockNum := uint64(1); blockNum <= uint64(chain.Length()); blockNum++ {
+		txnHash, err := api.GetStateSyncTxnHash(ctx, rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(blockNum)))
+		require.NoError(t, err)
+		if blockNum == 2 || blockNum == 4 {
+			require.NotNil(t, txnHash, "block %d", blockNum)
+			require.Equal(t, bortypes.ComputeBorTxHash(blockNum, chain.Blocks[blockNum-1].Hash()), *txnHash)
+		} else {
+			require.Nil(t, txnHash, "block %d", blockNum)
+		}
+	}
+}
+
+func TestBorGetCheckpointByBlock(t *testing.T) {
+	ctx := context.Background()
+	checkpoint1 := &heimdall.Checkpoint{Id: 1, Fields: waypointFields(0, 3, common.Hash{1})}
+	checkpoint2 := &heimdall.Checkpoint{Id: 2, Fields: waypointFields(4, 7, common.Hash{2})}
+	api, _, _ := newBorHeimdallAPIForTest(t, nil, &mockWaypointReader{checkpoints: []*heimdall.Checkpoint{checkpoint1, checkpoint2}})
+
+	// found
+	checkpoint, err := api.GetCheckpointByBlock(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, checkpoint2, checkpoint)
+
+	// not found
+	checkpoint, err = api.GetCheckpointByBlock(ctx, 8)
+	require.NoError(t, err)
+	require.Nil(t, checkpoint)
+
+	// range
+	for blockNum := rpc.BlockNumber(0); blockNum <= 7; blockNum++ {
+		checkpoint, err := api.GetCheckpointByBlock(ctx, blockNum)
+		require.NoError(t, err)
+		if blockNum <= 3 {
+			require.Equal(t, checkpoint1, checkpoint, "block %d", blockNum)
+		} else {
+			require.Equal(t, checkpoint2, checkpoint, "block %d", blockNum)
+		}
+	}
+
+	// no waypoints without polygon sync
+	api, _, _ = newBorHeimdallAPIForTest(t, nil, nil)
+	_, err = api.GetCheckpointByBlock(ctx, 5)
+	require.ErrorIs(t, err, errNoWaypointReader)
+}
+
+func TestBorGetMilestoneByBlock(t *testing.T) {
+	ctx := context.Background()
+	milestone1 := &heimdall.Milestone{Id: 1, Fields: waypointFields(1, 2, common.Hash{1})}
+	milestone2 := &heimdall.Milestone{Id: 2, Fields: waypointFields(3, 5, common.Hash{2})}
+	api, _, _ := newBorHeimdallAPIForTest(t, nil, &mockWaypointReader{milestones: []*heimdall.Milestone{milestone1, milestone2}})
+
+	// found
+	milestone, err := api.GetMilestoneByBlock(ctx, 4)
+	require.NoError(t, err)
+	require.Equal(t, milestone2, milestone)
+
+	// not found
+	milestone, err = api.GetMilestoneByBlock(ctx, 6)
+	require.NoError(t, err)
+	require.Nil(t, milestone)
+
+	// range
+	for blockNum := rpc.BlockNumber(1); blockNum <= 5; blockNum++ {
+		milestone, err := api.GetMilestoneByBlock(ctx, blockNum)
+		require.NoError(t, err)
+		if blockNum <= 2 {
+			require.Equal(t, milestone1, milestone, "block %d", blockNum)
+		} else {
+			require.Equal(t, milestone2, milestone, "block %d", blockNum)
+		}
+	}
+}
+
+func TestBorIsBlockFinalizedByMilestone(t *testing.T) {
+	ctx := context.Background()
+	m, chain, _ := rpcdaemontest.CreateTestSentry(t)
+	require.GreaterOrEqual(t, chain.Length(), 6)
+	// milestone 2 does not match the canonical chain
+	milestone1 := &heimdall.Milestone{Id: 1, Fields: waypointFields(1, 3, chain.Blocks[2].Hash())}
+	milestone2 := &heimdall.Milestone{Id: 2, Fields: waypointFields(4, 5, common.Hash{2})}
+	base := NewBaseApi(nil, kvcache.New(kvcache.DefaultCoherentConfig), m.BlockReader, false, rpccfg.DefaultEvmCallTimeout, m.Engine, m.Dirs, nil)
+	api := NewBorAPI(base, m.DB, &mockWaypointReader{milestones: []*heimdall.Milestone{milestone1, milestone2}})
+
+	// found
+	finalized, err := api.IsBlockFinalizedByMilestone(ctx, rpc.BlockNumberOrHashWithHash(chain.Blocks[1].Hash(), true))
+	require.NoError(t, err)
+	require.True(t, finalized)
+
+	// not found
+	finalized, err = api.IsBlockFinalizedByMilestone(ctx, rpc.BlockNumberOrHashWithNumber(6))
+	require.NoError(t, err)
+	require.False(t, finalized)
+
+	// range
+	for blockNum := uint64(1); blockNum <= 5; blockNum++ {
+		finalized, err := api.IsBlockFinalizedByMilestone(ctx, rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(blockNum)))
+		require.NoError(t, err)
+		require.Equal(t, blockNum <= 3, finalized, "block %d", blockNum)
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/erigontech/erigon-lib/log/v3"
 	"github.com/erigontech/erigon/cmd/rpcdaemon/cli/httpcfg"
 	"github.com/erigontech/erigon/consensus"
+	"github.com/erigontech/erigon/consensus/aura"
 	"github.com/erigontech/erigon/consensus/clique"
 	"github.com/erigontech/erigon/polygon/bor"
 	"github.com/erigontech/erigon/rpc"
@@ -34,7 +35,7 @@ import (
 func APIList(db kv.TemporalRoDB, eth rpchelper.ApiBackend, txPool txpool.TxpoolClient, mining txpool.MiningClient,
 	filters *rpchelper.Filters, stateCache kvcache.Cache,
 	blockReader services.FullBlockReader, cfg *httpcfg.HttpCfg, engine consensus.EngineReader,
-	logger log.Logger, bridgeReader bridgeReader, spanProducersReader spanProducersReader,
+	logger log.Logger, bridgeReader bridgeReader, heimdallReader heimdallReader,
 ) (list []rpc.API) {
 	base := NewBaseApi(filters, stateCache, blockReader, cfg.WithDatadir, cfg.EvmCallTimeout, engine, cfg.Dirs, bridgeReader)
 	ethImpl := NewEthAPI(base, db, eth, txPool, mining, cfg.Gascap, cfg.Feecap, cfg.ReturnDataLimit, cfg.AllowUnprotectedTxs, cfg.MaxGetProofRewindBlockCount, cfg.WebsocketSubscribeLogsChannelSize, logger)
@@ -57,10 +58,10 @@ func APIList(db kv.TemporalRoDB, eth rpchelper.ApiBackend, txPool txpool.TxpoolC
 
 	switch engine := engine.(type) {
 	case *bor.Bor:
-		borImpl = NewBorAPI(base, db, spanProducersReader)
+		borImpl = NewBorAPI(base, db, heimdallReader)
 	case lazy:
 		if _, ok := engine.Engine().(*bor.Bor); !engine.HasEngine() || ok {
-			borImpl = NewBorAPI(base, db, spanProducersReader)
+			borImpl = NewBorAPI(base, db, heimdallReader)
 		}
 	}
 
@@ -167,6 +168,8 @@ func APIList(db kv.TemporalRoDB, eth rpchelper.ApiBackend, txPool txpool.TxpoolC
 			})
 		case "clique":
 			list = append(list, clique.NewCliqueAPI(db, engine, blockReader))
+		case "aura":
+			list = append(list, aura.NewAuRaAPI(db, engine, blockReader))
 		case "overlay":
 			list = append(list, rpc.API{
 				Namespace: "overlay",
@@ -22,7 +22,9 @@ import (
 
 	"github.com/erigontech/erigon-lib/log/v3"
 
+	"github.com/erigontech/erigon-lib/common"
 	"github.com/erigontech/erigon-lib/common/debug"
+	"github.com/erigontech/erigon-lib/gointerfaces"
 	"github.com/erigontech/erigon/core/types"
 	"github.com/erigontech/erigon/eth/filters"
 	"github.com/erigontech/erigon/rpc"
@@ -265,6 +267,62 @@ func (api *APIImpl) NewPendingTransactionsWithBody(ctx context.Context) (*rpc.Su
 	return rpcSub, nil
 }
 
+// TxpoolEventsCriteria - optional filter of eth_subscribe("txpoolEvents")
+type TxpoolEventsCriteria struct {
+	Senders []common.Address `json:"senders"` // if empty - events of all senders
+}
+
+// TxpoolEvents send a notification on each transaction lifecycle event in the pool: added, promoted, demoted, replaced, discarded (with reason), mined.
+func (api *APIImpl) TxpoolEvents(ctx context.Context, crit *TxpoolEventsCriteria) (*rpc.Subscription, error) {
+	if api.filters == nil {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	var senders map[common.Address]struct{}
+	if crit != nil && len(crit.Senders) > 0 {
+		senders = make(map[common.Address]struct{}, len(crit.Senders))
+		for _, sender := range crit.Senders {
+			senders[sender] = struct{}{}
+		}
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		defer debug.LogPanic()
+		eventsCh, id := api.filters.SubscribeTxpoolEvents(256)
+		defer api.filters.UnsubscribeTxpoolEvents(id)
+
+		for {
+			select {
+			case events, ok := <-eventsCh:
+				for _, event := range events {
+					if senders != nil {
+						if _, ok := senders[gointerfaces.ConvertH160toAddress(event.Sender)]; !ok {
+							continue
+						}
+					}
+					if err := notifier.Notify(rpcSub.ID, newTxpoolEvent(event)); err != nil {
+						log.Warn("[rpc] error while notifying subscription", "err", err)
+					}
+				}
+				if !ok {
+					log.Warn("[rpc] txpool events channel was closed")
+					return
+				}
+			case <-rpcSub.Err():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
 // Logs send a notification each time a new log appears.
 func (api *APIImpl) Logs(ctx context.Context, crit filters.FilterCriteria) (*rpc.Subscription, error) {
 	if api.filters == nil {
@@ -16,6 +16,26 @@ import (
 
 // SendRawTransaction implements eth_sendRawTransaction. Creates new message call transaction or a contract creation for previously-signed transactions.
 func (api *APIImpl) SendRawTransaction(ctx context.Context, encodedTx hexutil.Bytes) (common.Hash, error) {
+	return api.sendRawTransaction(ctx, encodedTx, &txPoolProto.AddRequest{RlpTxs: [][]byte{encodedTx}})
+}
+
+// PrivateTxnArgs represents the optional arguments of eth_sendPrivateRawTransaction
+type PrivateTxnArgs struct {
+	// MaxBlockNumber - if the transaction is not mined by this block (inclusive), it's announced to peers as a regular one
+	MaxBlockNumber *hexutil.Uint64 `json:"maxBlockNumber"`
+}
+
+// SendPrivateRawTransaction implements eth_sendPrivateRawTransaction. Same as eth_sendRawTransaction, but the transaction
+// is never gossiped to peers and is included only in locally built blocks - until the optional max block number is reached.
+func (api *APIImpl) SendPrivateRawTransaction(ctx context.Context, encodedTx hexutil.Bytes, args *PrivateTxnArgs) (common.Hash, error) {
+	req := &txPoolProto.AddRequest{RlpTxs: [][]byte{encodedTx}, Private: true}
+	if args != nil && args.MaxBlockNumber != nil {
+		req.PrivateMaxBlock = uint64(*args.MaxBlockNumber)
+	}
+	return api.sendRawTransaction(ctx, encodedTx, req)
+}
+
+func (api *APIImpl) sendRawTransaction(ctx context.Context, encodedTx hexutil.Bytes, req *txPoolProto.AddRequest) (common.Hash, error) {
 	txn, err := types.DecodeWrappedTransaction(encodedTx)
 	if err != nil {
 		return common.Hash{}, err
@@ -54,7 +74,7 @@ func (api *APIImpl) SendRawTransaction(ctx context.Context, encodedTx hexutil.By
 	}
 
 	hash := txn.Hash()
-	res, err := api.txPool.Add(ctx, &txPoolProto.AddRequest{RlpTxs: [][]byte{encodedTx}})
+	res, err := api.txPool.Add(ctx, req)
 	if err != nil {
 		return common.Hash{}, err
 	}
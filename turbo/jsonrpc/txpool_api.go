@@ -25,6 +25,7 @@ import (
 	"github.com/erigontech/erigon-lib/common/hexutil"
 	"github.com/erigontech/erigon-lib/gointerfaces"
 	proto_txpool "github.com/erigontech/erigon-lib/gointerfaces/txpoolproto"
+	"github.com/erigontech/erigon-lib/gointerfaces/typesproto"
 	"github.com/erigontech/erigon-lib/kv"
 	"github.com/erigontech/erigon/core/rawdb"
 	"github.com/erigontech/erigon/core/types"
@@ -35,6 +36,8 @@ import (
 type TxPoolAPI interface {
 	Content(ctx context.Context) (map[string]map[string]map[string]*ethapi.RPCTransaction, error)
 	ContentFrom(ctx context.Context, addr libcommon.Address) (map[string]map[string]*ethapi.RPCTransaction, error)
+	TransactionStatus(ctx context.Context, hash libcommon.Hash) (*TxnLifecycle, error)
+	LocalTransactions(ctx context.Context) ([]*TxnLifecycle, error)
 }
 
 // TxPoolAPIImpl data structure to store things needed for net_ commands
@@ -69,7 +72,7 @@ func (api *TxPoolAPIImpl) Content(ctx context.Context) (map[string]map[string]ma
 	baseFee := make(map[libcommon.Address][]types.Transaction, 8)
 	queued := make(map[libcommon.Address][]types.Transaction, 8)
 	for i := range reply.Txs {
-		txn, err := types.DecodeWrappedTransaction(reply.Txs[i].RlpTx)
+		txn, err := types.DecodeTransaction(reply.Txs[i].RlpTx)
 		if err != nil {
 			return nil, fmt.Errorf("decoding transaction from: %x: %w", reply.Txs[i].RlpTx, err)
 		}
@@ -150,7 +153,7 @@ func (api *TxPoolAPIImpl) ContentFrom(ctx context.Context, addr libcommon.Addres
 	baseFee := make([]types.Transaction, 0, 4)
 	queued := make([]types.Transaction, 0, 4)
 	for i := range reply.Txs {
-		txn, err := types.DecodeWrappedTransaction(reply.Txs[i].RlpTx)
+		txn, err := types.DecodeTransaction(reply.Txs[i].RlpTx)
 		if err != nil {
 			return nil, fmt.Errorf("decoding transaction from: %x: %w", reply.Txs[i].RlpTx, err)
 		}
@@ -217,6 +220,108 @@ func (api *TxPoolAPIImpl) Status(ctx context.Context) (map[string]hexutil.Uint,
 	}, nil
 }
 
+// TxnLifecycle is the status of a transaction as seen by the pool
+type TxnLifecycle struct {
+	Hash          libcommon.Hash `json:"hash"`
+	Status        string         `json:"status"` // unknown, pending, baseFee, queued, dropped, mined
+	DiscardReason string         `json:"discardReason,omitempty"`
+	Local         bool           `json:"local"`
+	Journaled     bool           `json:"journaled"` // retained and re-broadcast by the pool until mined or replaced
+}
+
+func newTxnLifecycle(txn *proto_txpool.TxnLifecycle) *TxnLifecycle {
+	var status string
+	switch txn.Status {
+	case proto_txpool.TxnLifecycle_PENDING:
+		status = "pending"
+	case proto_txpool.TxnLifecycle_BASE_FEE:
+		status = "baseFee"
+	case proto_txpool.TxnLifecycle_QUEUED:
+		status = "queued"
+	case proto_txpool.TxnLifecycle_DROPPED:
+		status = "dropped"
+	case proto_txpool.TxnLifecycle_MINED:
+		status = "mined"
+	default:
+		status = "unknown"
+	}
+	return &TxnLifecycle{
+		Hash:          gointerfaces.ConvertH256ToHash(txn.Hash),
+		Status:        status,
+		DiscardReason: txn.DiscardReason,
+		Local:         txn.Local,
+		Journaled:     txn.Journaled,
+	}
+}
+
+// TxpoolEvent is a lifecycle event of a transaction, streamed by eth_subscribe("txpoolEvents")
+type TxpoolEvent struct {
+	Hash          libcommon.Hash    `json:"hash"`
+	Sender        libcommon.Address `json:"sender"`
+	Type          string            `json:"type"`              // added, promoted, demoted, replaced, discarded, mined
+	SubPool       string            `json:"subPool,omitempty"` // pending, baseFee, queued - for added, promoted, demoted
+	DiscardReason string            `json:"discardReason,omitempty"`
+}
+
+func newTxpoolEvent(event *proto_txpool.TxnEvent) *TxpoolEvent {
+	res := &TxpoolEvent{
+		Hash:          gointerfaces.ConvertH256ToHash(event.Hash),
+		Sender:        gointerfaces.ConvertH160toAddress(event.Sender),
+		DiscardReason: event.DiscardReason,
+	}
+	switch event.Type {
+	case proto_txpool.TxnEvent_ADDED:
+		res.Type = "added"
+	case proto_txpool.TxnEvent_PROMOTED:
+		res.Type = "promoted"
+	case proto_txpool.TxnEvent_DEMOTED:
+		res.Type = "demoted"
+	case proto_txpool.TxnEvent_REPLACED:
+		res.Type = "replaced"
+	case proto_txpool.TxnEvent_DISCARDED:
+		res.Type = "discarded"
+	case proto_txpool.TxnEvent_MINED:
+		res.Type = "mined"
+	}
+	switch event.Type {
+	case proto_txpool.TxnEvent_ADDED, proto_txpool.TxnEvent_PROMOTED, proto_txpool.TxnEvent_DEMOTED:
+		switch event.SubPool {
+		case proto_txpool.AllReply_PENDING:
+			res.SubPool = "pending"
+		case proto_txpool.AllReply_BASE_FEE:
+			res.SubPool = "baseFee"
+		case proto_txpool.AllReply_QUEUED:
+			res.SubPool = "queued"
+		}
+	}
+	return res
+}
+
+// TransactionStatus returns the lifecycle status of the given transaction: in which sub-pool it is, or why it was dropped.
+func (api *TxPoolAPIImpl) TransactionStatus(ctx context.Context, hash libcommon.Hash) (*TxnLifecycle, error) {
+	reply, err := api.pool.Lifecycle(ctx, &proto_txpool.LifecycleRequest{Hashes: []*typesproto.H256{gointerfaces.ConvertHashToH256(hash)}})
+	if err != nil {
+		return nil, err
+	}
+	if len(reply.Txns) != 1 {
+		return nil, fmt.Errorf("unexpected txpool lifecycle reply: %d entries", len(reply.Txns))
+	}
+	return newTxnLifecycle(reply.Txns[0]), nil
+}
+
+// LocalTransactions returns the lifecycle status of all journaled local transactions.
+func (api *TxPoolAPIImpl) LocalTransactions(ctx context.Context) ([]*TxnLifecycle, error) {
+	reply, err := api.pool.Lifecycle(ctx, &proto_txpool.LifecycleRequest{})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*TxnLifecycle, len(reply.Txns))
+	for i, txn := range reply.Txns {
+		res[i] = newTxnLifecycle(txn)
+	}
+	return res, nil
+}
+
 /*
 
 // Inspect retrieves the content of the transaction pool and flattens it into an
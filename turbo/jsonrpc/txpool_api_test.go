@@ -78,4 +78,19 @@ func TestTxPoolContent(t *testing.T) {
 	require.Len(status, 3)
 	require.Equal(status["pending"], hexutil.Uint(1))
 	require.Equal(status["queued"], hexutil.Uint(0))
+
+	txnStatus, err := api.TransactionStatus(ctx, txn.Hash())
+	require.NoError(err)
+	require.Equal("pending", txnStatus.Status)
+	require.True(txnStatus.Local)
+	require.True(txnStatus.Journaled)
+
+	locals, err := api.LocalTransactions(ctx)
+	require.NoError(err)
+	require.Len(locals, 1)
+	require.Equal(txn.Hash(), locals[0].Hash)
+
+	txnStatus, err = api.TransactionStatus(ctx, libcommon.Hash{1})
+	require.NoError(err)
+	require.Equal("unknown", txnStatus.Status)
 }
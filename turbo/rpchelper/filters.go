@@ -58,6 +58,7 @@ type Filters struct {
 	pendingLogsSubs  *concurrent.SyncMap[PendingLogsSubID, Sub[types.Logs]]
 	pendingBlockSubs *concurrent.SyncMap[PendingBlockSubID, Sub[*types.Block]]
 	pendingTxsSubs   *concurrent.SyncMap[PendingTxsSubID, Sub[[]types.Transaction]]
+	txpoolEventsSubs *concurrent.SyncMap[TxpoolEventsSubID, Sub[[]*txpool.TxnEvent]]
 	logsSubs         *LogsFilterAggregator
 	logsRequestor    atomic.Value
 	onNewSnapshot    func()
@@ -81,6 +82,7 @@ func New(ctx context.Context, config FiltersConfig, ethBackend ApiBackend, txPoo
 		pendingTxsSubs:     concurrent.NewSyncMap[PendingTxsSubID, Sub[[]types.Transaction]](),
 		pendingLogsSubs:    concurrent.NewSyncMap[PendingLogsSubID, Sub[types.Logs]](),
 		pendingBlockSubs:   concurrent.NewSyncMap[PendingBlockSubID, Sub[*types.Block]](),
+		txpoolEventsSubs:   concurrent.NewSyncMap[TxpoolEventsSubID, Sub[[]*txpool.TxnEvent]](),
 		logsSubs:           NewLogsFilterAggregator(),
 		onNewSnapshot:      onNewSnapshot,
 		logsStores:         concurrent.NewSyncMap[LogsSubID, []*types.Log](),
@@ -173,6 +175,31 @@ func New(ctx context.Context, config FiltersConfig, ethBackend ApiBackend, txPoo
 			}
 		}()
 
+		go func() {
+			activeSubscriptionsLogsClientGauge.With(prometheus.Labels{clientLabelName: "txPool_TxnEvents"}).Inc()
+			for {
+				select {
+				case <-ctx.Done():
+					activeSubscriptionsLogsClientGauge.With(prometheus.Labels{clientLabelName: "txPool_TxnEvents"}).Dec()
+					return
+				default:
+				}
+				if err := ff.subscribeToTxpoolEvents(ctx, txPool); err != nil {
+					select {
+					case <-ctx.Done():
+						activeSubscriptionsLogsClientGauge.With(prometheus.Labels{clientLabelName: "txPool_TxnEvents"}).Dec()
+						return
+					default:
+					}
+					if grpcutil.IsEndOfStream(err) || grpcutil.IsRetryLater(err) || grpcutil.ErrIs(err, txpool2.ErrPoolDisabled) {
+						time.Sleep(3 * time.Second)
+						continue
+					}
+					logger.Warn("rpc filters: error subscribing to txpool events", "err", err)
+				}
+			}
+		}()
+
 		if !reflect.ValueOf(mining).IsNil() { //https://groups.google.com/g/golang-nuts/c/wnH302gBa4I
 			go func() {
 				activeSubscriptionsLogsClientGauge.With(prometheus.Labels{clientLabelName: "txPool_PendingBlock"}).Inc()
@@ -257,6 +284,28 @@ func (ff *Filters) subscribeToPendingTransactions(ctx context.Context, txPool tx
 	return nil
 }
 
+// subscribeToTxpoolEvents subscribes to the lifecycle events of all transactions in the pool, they are
+// filtered per subscription by the rpc layer.
+func (ff *Filters) subscribeToTxpoolEvents(ctx context.Context, txPool txpool.TxpoolClient) error {
+	subscription, err := txPool.TxnEvents(ctx, &txpool.TxnEventsRequest{}, grpc.WaitForReady(true))
+	if err != nil {
+		return err
+	}
+	for {
+		reply, err := subscription.Recv()
+		if errors.Is(err, io.EOF) {
+			ff.logger.Debug("rpcdaemon: the subscription to txpool events channel was closed")
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		ff.OnTxpoolEvents(reply)
+	}
+	return nil
+}
+
 // subscribeToPendingBlocks subscribes to pending blocks using the given mining client.
 // It listens for new pending blocks and processes them as they arrive.
 func (ff *Filters) subscribeToPendingBlocks(ctx context.Context, mining txpool.MiningClient) error {
@@ -435,6 +484,27 @@ func (ff *Filters) UnsubscribePendingTxs(id PendingTxsSubID) bool {
 	return true
 }
 
+// SubscribeTxpoolEvents subscribes to the lifecycle events of pool transactions and returns a channel to receive
+// the events and a subscription ID to manage the subscription.
+func (ff *Filters) SubscribeTxpoolEvents(size int) (<-chan []*txpool.TxnEvent, TxpoolEventsSubID) {
+	id := TxpoolEventsSubID(generateSubscriptionID())
+	sub := newChanSub[[]*txpool.TxnEvent](size)
+	ff.txpoolEventsSubs.Put(id, sub)
+	return sub.ch, id
+}
+
+// UnsubscribeTxpoolEvents unsubscribes from txpool events using the given subscription ID.
+// It returns true if the unsubscription was successful, otherwise false.
+func (ff *Filters) UnsubscribeTxpoolEvents(id TxpoolEventsSubID) bool {
+	ch, ok := ff.txpoolEventsSubs.Get(id)
+	if !ok {
+		return false
+	}
+	ch.Close()
+	_, ok = ff.txpoolEventsSubs.Delete(id)
+	return ok
+}
+
 // SubscribeLogs subscribes to logs using the specified filter criteria and returns a channel to receive the logs
 // and a subscription ID to manage the subscription.
 func (ff *Filters) SubscribeLogs(size int, criteria filters.FilterCriteria) (<-chan *types.Log, LogsSubID) {
@@ -650,6 +720,14 @@ func (ff *Filters) OnNewTx(reply *txpool.OnAddReply) {
 	})
 }
 
+// OnTxpoolEvents handles the lifecycle events of pool transactions and sends them to all subscribers.
+func (ff *Filters) OnTxpoolEvents(reply *txpool.TxnEventsReply) {
+	ff.txpoolEventsSubs.Range(func(k TxpoolEventsSubID, v Sub[[]*txpool.TxnEvent]) error {
+		v.Send(reply.Events)
+		return nil
+	})
+}
+
 // OnNewLogs handles a new log event from the remote and processes it.
 func (ff *Filters) OnNewLogs(reply *remote.SubscribeLogsReply) {
 	ff.logsSubs.distributeLog(reply)
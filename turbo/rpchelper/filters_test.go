@@ -27,6 +27,7 @@ import (
 	libcommon "github.com/erigontech/erigon-lib/common"
 	"github.com/erigontech/erigon-lib/gointerfaces"
 	remote "github.com/erigontech/erigon-lib/gointerfaces/remoteproto"
+	txpool "github.com/erigontech/erigon-lib/gointerfaces/txpoolproto"
 
 	types2 "github.com/erigontech/erigon-lib/gointerfaces/typesproto"
 
@@ -507,3 +508,33 @@ func TestFilters_AddPendingTxs(t *testing.T) {
 		})
 	}
 }
+
+func TestFilters_TxpoolEventsFanOut(t *testing.T) {
+	f := New(context.TODO(), DefaultFiltersConfig, nil, nil, nil, func() {}, log.New())
+	ch1, id1 := f.SubscribeTxpoolEvents(8)
+	ch2, id2 := f.SubscribeTxpoolEvents(8)
+
+	event := &txpool.TxnEvent{Hash: topic1H256, Sender: address1H160, Type: txpool.TxnEvent_ADDED}
+	f.OnTxpoolEvents(&txpool.TxnEventsReply{Events: []*txpool.TxnEvent{event}})
+	for _, ch := range []<-chan []*txpool.TxnEvent{ch1, ch2} {
+		events := <-ch
+		if len(events) != 1 || events[0] != event {
+			t.Fatalf("unexpected events %v", events)
+		}
+	}
+
+	if !f.UnsubscribeTxpoolEvents(id1) {
+		t.Fatal("expected to unsubscribe")
+	}
+	if f.UnsubscribeTxpoolEvents(id1) {
+		t.Fatal("expected the subscription to be gone")
+	}
+	if _, ok := <-ch1; ok {
+		t.Fatal("expected the channel to be closed")
+	}
+	f.OnTxpoolEvents(&txpool.TxnEventsReply{Events: []*txpool.TxnEvent{event}})
+	if events := <-ch2; len(events) != 1 {
+		t.Fatalf("unexpected events %v", events)
+	}
+	f.UnsubscribeTxpoolEvents(id2)
+}
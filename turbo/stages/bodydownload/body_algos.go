@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"time"
 
 	libcommon "github.com/erigontech/erigon-lib/common"
 	"github.com/erigontech/erigon-lib/common/dbg"
@@ -76,6 +77,12 @@ func (bd *BodyDownload) RequestMoreBodies(tx kv.RwTx, blockReader services.FullB
 				continue
 			}
 			bd.peerMap[req.peerID]++
+			if !req.timedOut {
+				req.timedOut = true
+				if bd.OnRequestTimeout != nil {
+					bd.OnRequestTimeout(req.peerID)
+				}
+			}
 			dataflow.BlockBodyDownloadStates.AddChange(blockNum, dataflow.BlockBodyExpired)
 			delete(bd.requests, blockNum)
 		}
@@ -215,6 +222,17 @@ func (bd *BodyDownload) RequestSent(bodyReq *BodyRequest, timeWithTimeout uint64
 	bodyReq.peerID = peer
 }
 
+// NextRetryTime returns the earliest time an outstanding request times out, false if there are no outstanding requests.
+func (bd *BodyDownload) NextRetryTime() (time.Time, bool) {
+	var waitUntil uint64
+	for _, req := range bd.requests {
+		if waitUntil == 0 || req.waitUntil < waitUntil {
+			waitUntil = req.waitUntil
+		}
+	}
+	return time.Unix(int64(waitUntil), 0), waitUntil > 0
+}
+
 // DeliverBodies takes the block body received from a peer and adds it to the various data structures
 func (bd *BodyDownload) DeliverBodies(txs [][][]byte, uncles [][]*types.Header, withdrawals []types.Withdrawals,
 	lenOfP2PMsg uint64, peerID [64]byte,
@@ -70,6 +70,9 @@ type BodyDownload struct {
 	blockBufferSize  int
 	br               services.FullBlockReader
 	logger           log.Logger
+
+	// OnRequestTimeout is called once for every request the peer didn't answer in time, optional
+	OnRequestTimeout func(peerID [64]byte)
 }
 
 // BodyRequest is a sketch of the request for block bodies, meaning that access to the database is required to convert it to the actual BlockBodies request (look up hashes of canonical blocks)
@@ -78,6 +81,7 @@ type BodyRequest struct {
 	Hashes    []libcommon.Hash
 	peerID    [64]byte
 	waitUntil uint64
+	timedOut  bool // request covers many blocks, but the timeout is reported once
 }
 
 // NewBodyDownload create a new body download state object
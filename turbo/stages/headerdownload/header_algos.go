@@ -500,6 +500,32 @@ func (hd *HeaderDownload) UpdateRetryTime(req *HeaderRequest, currentTime time.T
 	req.Anchor.nextRetryTime = currentTime.Add(timeout)
 }
 
+// NextRetryTime returns the earliest time a request for the ancestors of an anchor is due again, false if no anchor
+// waits for a retry.
+func (hd *HeaderDownload) NextRetryTime() (time.Time, bool) {
+	hd.lock.RLock()
+	defer hd.lock.RUnlock()
+	var next time.Time
+	consider := func(anchor *Anchor) {
+		if anchor.nextRetryTime.IsZero() {
+			return
+		}
+		if next.IsZero() || anchor.nextRetryTime.Before(next) {
+			next = anchor.nextRetryTime
+		}
+	}
+	hd.anchorTree.Ascend(func(anchor *Anchor) bool {
+		if anchor.blockHeight > 0 {
+			consider(anchor)
+		}
+		return true
+	})
+	if hd.posAnchor != nil {
+		consider(hd.posAnchor)
+	}
+	return next, !next.IsZero()
+}
+
 func (hd *HeaderDownload) RequestSkeleton() *HeaderRequest {
 	hd.lock.RLock()
 	defer hd.lock.RUnlock()
@@ -681,9 +707,10 @@ func (hd *HeaderDownload) SetHeaderToDownloadPoS(hash libcommon.Hash, height uin
 	}
 }
 
-func (hd *HeaderDownload) ProcessHeadersPOS(csHeaders []ChainSegmentHeader, tx kv.Getter, peerId [64]byte) ([]PenaltyItem, error) {
+// ProcessHeadersPOS collects the headers extending the PoS anchor downwards, accepted reports whether any header was collected
+func (hd *HeaderDownload) ProcessHeadersPOS(csHeaders []ChainSegmentHeader, tx kv.Getter, peerId [64]byte) (penalties []PenaltyItem, accepted bool, err error) {
 	if len(csHeaders) == 0 {
-		return nil, nil
+		return nil, false, nil
 	}
 	hd.logger.Debug("[downloader] Collecting...", "from", csHeaders[0].Number, "to", csHeaders[len(csHeaders)-1].Number, "len", len(csHeaders))
 	hd.lock.Lock()
@@ -691,12 +718,12 @@ func (hd *HeaderDownload) ProcessHeadersPOS(csHeaders []ChainSegmentHeader, tx k
 	if hd.posAnchor == nil {
 		// May happen if peers are sending unrequested header packets after we've synced
 		hd.logger.Debug("[downloader] posAnchor is nil")
-		return nil, nil
+		return nil, false, nil
 	}
 
 	// Handle request after closing collectors
 	if hd.headersCollector == nil {
-		return nil, nil
+		return nil, false, nil
 	}
 
 	for _, sh := range csHeaders {
@@ -723,18 +750,19 @@ func (hd *HeaderDownload) ProcessHeadersPOS(csHeaders []ChainSegmentHeader, tx k
 
 		headerNumber := header.Number.Uint64()
 		if err := hd.headersCollector.Collect(dbutils.HeaderKey(headerNumber, headerHash), sh.HeaderRaw); err != nil {
-			return nil, err
+			return nil, accepted, err
 		}
+		accepted = true
 
 		hh, err := hd.headerReader.HeaderByHash(context.Background(), tx, header.ParentHash)
 		if err != nil {
-			return nil, err
+			return nil, accepted, err
 		}
 		if hh != nil {
 			hd.logger.Debug("[downloader] Synced", "requestId", hd.requestId)
 			if headerNumber != hh.Number.Uint64()+1 {
 				hd.badPoSHeaders[headerHash] = header.ParentHash
-				return nil, fmt.Errorf("invalid PoS segment detected: invalid block number. got %d, expected %d", headerNumber, hh.Number.Uint64()+1)
+				return nil, accepted, fmt.Errorf("invalid PoS segment detected: invalid block number. got %d, expected %d", headerNumber, hh.Number.Uint64()+1)
 			}
 			hd.posAnchor = nil
 			hd.posStatus = Synced
@@ -743,7 +771,7 @@ func (hd *HeaderDownload) ProcessHeadersPOS(csHeaders []ChainSegmentHeader, tx k
 			case hd.DeliveryNotify <- struct{}{}:
 			default:
 			}
-			return nil, nil
+			return nil, accepted, nil
 		}
 
 		hd.posAnchor = &Anchor{
@@ -753,10 +781,10 @@ func (hd *HeaderDownload) ProcessHeadersPOS(csHeaders []ChainSegmentHeader, tx k
 		}
 
 		if headerNumber <= 1 {
-			return nil, errors.New("wrong genesis in PoS sync")
+			return nil, accepted, errors.New("wrong genesis in PoS sync")
 		}
 	}
-	return nil, nil
+	return nil, accepted, nil
 }
 
 // GrabAnnounces - returns all available announces and forget them
@@ -1039,7 +1067,8 @@ func (hi *HeaderInserter) BestHeaderChanged() bool {
 	return hi.newCanonical
 }
 
-func (hd *HeaderDownload) ProcessHeader(sh ChainSegmentHeader, newBlock bool, peerID [64]byte) bool {
+// ProcessHeader adds the header as a link, accepted reports whether the header was added
+func (hd *HeaderDownload) ProcessHeader(sh ChainSegmentHeader, newBlock bool, peerID [64]byte) (requestMore, accepted bool) {
 	hd.lock.Lock()
 	defer hd.lock.Unlock()
 	if sh.Number > hd.stats.RespMaxBlock {
@@ -1051,18 +1080,18 @@ func (hd *HeaderDownload) ProcessHeader(sh ChainSegmentHeader, newBlock bool, pe
 	if _, ok := hd.links[sh.Hash]; ok {
 		hd.stats.Duplicates++
 		// Duplicate
-		return false
+		return false, false
 	}
 	parent, foundParent := hd.links[sh.Header.ParentHash]
 	anchor, foundAnchor := hd.anchors[sh.Hash]
 	if !foundParent && !foundAnchor {
 		if sh.Number < hd.highestInDb {
 			hd.logger.Debug(fmt.Sprintf("[downloader] new anchor too far in the past: %d, latest header in db: %d", sh.Number, hd.highestInDb))
-			return false
+			return false, false
 		}
 		if len(hd.anchors) >= hd.anchorLimit {
 			hd.logger.Debug(fmt.Sprintf("[downloader] too many anchors: %d, limit %d", len(hd.anchors), hd.anchorLimit))
-			return false
+			return false, false
 		}
 	}
 	link := hd.addHeaderAsLink(sh, false /* persisted */)
@@ -1076,7 +1105,7 @@ func (hd *HeaderDownload) ProcessHeader(sh ChainSegmentHeader, newBlock bool, pe
 		// Adding link as another child to the anchor and quit (not to overwrite the anchor)
 		link.next = parentAnchor.fLink
 		parentAnchor.fLink = link
-		return false
+		return false, true
 	}
 	if foundParent {
 		// Add this link as another child to the parent that is found
@@ -1091,7 +1120,7 @@ func (hd *HeaderDownload) ProcessHeader(sh ChainSegmentHeader, newBlock bool, pe
 		if sh.Number+params.FullImmutabilityThreshold < hd.highestInDb {
 			hd.logger.Debug("[downloader] Remove upwards", "height", link.blockHeight, "hash", link.blockHeight)
 			hd.removeUpwards(link)
-			return false
+			return false, false
 		}
 		anchor = &Anchor{
 			parentHash:    sh.Header.ParentHash,
@@ -1102,18 +1131,18 @@ func (hd *HeaderDownload) ProcessHeader(sh ChainSegmentHeader, newBlock bool, pe
 		anchor.fLink = link
 		hd.anchors[anchor.parentHash] = anchor
 		hd.anchorTree.ReplaceOrInsert(anchor)
-		return true
+		return true, true
 	}
-	return false
+	return false, true
 }
 
-func (hd *HeaderDownload) ProcessHeaders(csHeaders []ChainSegmentHeader, newBlock bool, peerID [64]byte) bool {
-	requestMore := false
+// ProcessHeaders adds the headers as links, accepted reports whether any header was added
+func (hd *HeaderDownload) ProcessHeaders(csHeaders []ChainSegmentHeader, newBlock bool, peerID [64]byte) (requestMore, accepted bool) {
 	for _, sh := range csHeaders {
 		// Lock is acquired for every invocation of ProcessHeader
-		if hd.ProcessHeader(sh, newBlock, peerID) {
-			requestMore = true
-		}
+		more, added := hd.ProcessHeader(sh, newBlock, peerID)
+		requestMore = requestMore || more
+		accepted = accepted || added
 	}
 	hd.lock.Lock()
 	defer hd.lock.Unlock()
@@ -1129,7 +1158,7 @@ func (hd *HeaderDownload) ProcessHeaders(csHeaders []ChainSegmentHeader, newBloc
 	default:
 	}
 
-	return !hd.initialCycle && requestMore
+	return !hd.initialCycle && requestMore, accepted
 }
 
 func (hd *HeaderDownload) ExtractStats() Stats {
@@ -1263,7 +1292,7 @@ func (hd *HeaderDownload) AddMinedHeader(header *types.Header) error {
 
 	peerID := [64]byte{'m', 'i', 'n', 'e', 'r'} // "miner"
 
-	_ = hd.ProcessHeaders(segments, false /* newBlock */, peerID)
+	_, _ = hd.ProcessHeaders(segments, false /* newBlock */, peerID)
 	hd.setLatestMinedBlockNumber(header.Number.Uint64())
 	return nil
 }
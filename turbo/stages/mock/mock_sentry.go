@@ -119,6 +119,7 @@ type MockSentry struct {
 	PeerId               *ptypes.H512
 	streams              map[proto_sentry.MessageId][]proto_sentry.Sentry_MessagesServer
 	sentMessages         []*proto_sentry.OutboundMessageData
+	network              *Network
 	StreamWg             sync.WaitGroup
 	ReceiveWg            sync.WaitGroup
 	Address              libcommon.Address
@@ -173,7 +174,13 @@ func (ms *MockSentry) SetStatus(context.Context, *proto_sentry.StatusData) (*pro
 	return &proto_sentry.SetStatusReply{}, nil
 }
 
-func (ms *MockSentry) PenalizePeer(context.Context, *proto_sentry.PenalizePeerRequest) (*emptypb.Empty, error) {
+func (ms *MockSentry) PenalizePeer(_ context.Context, r *proto_sentry.PenalizePeerRequest) (*emptypb.Empty, error) {
+	if ms.network != nil {
+		ms.network.penalize(ms.network.index(ms), sentry.ConvertH512ToPeerID(r.PeerId))
+	}
+	return nil, nil
+}
+func (ms *MockSentry) ScorePeer(context.Context, *proto_sentry.ScorePeerRequest) (*emptypb.Empty, error) {
 	return nil, nil
 }
 func (ms *MockSentry) PeerMinBlock(context.Context, *proto_sentry.PeerMinBlockRequest) (*emptypb.Empty, error) {
@@ -185,18 +192,30 @@ func (ms *MockSentry) HandShake(ctx context.Context, in *emptypb.Empty) (*proto_
 }
 func (ms *MockSentry) SendMessageByMinBlock(_ context.Context, r *proto_sentry.SendMessageByMinBlockRequest) (*proto_sentry.SentPeers, error) {
 	ms.sentMessages = append(ms.sentMessages, r.Data)
+	if ms.network != nil {
+		return ms.network.sendByMinBlock(ms.network.index(ms), r.MinBlock, r.MaxPeers, r.Data), nil
+	}
 	return nil, nil
 }
 func (ms *MockSentry) SendMessageById(_ context.Context, r *proto_sentry.SendMessageByIdRequest) (*proto_sentry.SentPeers, error) {
 	ms.sentMessages = append(ms.sentMessages, r.Data)
+	if ms.network != nil {
+		return ms.network.sendById(ms.network.index(ms), sentry.ConvertH512ToPeerID(r.PeerId), r.Data), nil
+	}
 	return nil, nil
 }
 func (ms *MockSentry) SendMessageToRandomPeers(_ context.Context, r *proto_sentry.SendMessageToRandomPeersRequest) (*proto_sentry.SentPeers, error) {
 	ms.sentMessages = append(ms.sentMessages, r.Data)
+	if ms.network != nil {
+		return ms.network.sendToAll(ms.network.index(ms), r.MaxPeers, r.Data), nil
+	}
 	return nil, nil
 }
 func (ms *MockSentry) SendMessageToAll(_ context.Context, r *proto_sentry.OutboundMessageData) (*proto_sentry.SentPeers, error) {
 	ms.sentMessages = append(ms.sentMessages, r)
+	if ms.network != nil {
+		return ms.network.sendToAll(ms.network.index(ms), 0, r), nil
+	}
 	return nil, nil
 }
 func (ms *MockSentry) SentMessage(i int) *proto_sentry.OutboundMessageData {
@@ -333,14 +352,29 @@ func MockWithEverything(tb testing.TB, gspec *types.Genesis, key *ecdsa.PrivateK
 
 	mock.Address = crypto.PubkeyToAddress(mock.Key.PublicKey)
 
-	sendHeaderRequest := func(_ context.Context, r *headerdownload.HeaderRequest) ([64]byte, bool) { return [64]byte{}, false }
+	// Downloads are only requested when the node is connected to a Network
+	sendHeaderRequest := func(ctx context.Context, r *headerdownload.HeaderRequest) ([64]byte, bool) {
+		if mock.network == nil {
+			return [64]byte{}, false
+		}
+		return mock.sentriesClient.SendHeaderRequest(ctx, r)
+	}
 	propagateNewBlockHashes := func(context.Context, []headerdownload.Announce) {}
-	penalize := func(context.Context, []headerdownload.PenaltyItem) {}
+	penalize := func(ctx context.Context, penalties []headerdownload.PenaltyItem) {
+		if mock.network != nil {
+			mock.sentriesClient.Penalize(ctx, penalties)
+		}
+	}
 
 	mock.SentryClient = direct.NewSentryClientDirect(direct.ETH68, mock)
 	sentries := []proto_sentry.SentryClient{mock.SentryClient}
 
-	sendBodyRequest := func(context.Context, *bodydownload.BodyRequest) ([64]byte, bool) { return [64]byte{}, false }
+	sendBodyRequest := func(ctx context.Context, r *bodydownload.BodyRequest) ([64]byte, bool) {
+		if mock.network == nil {
+			return [64]byte{}, false
+		}
+		return mock.sentriesClient.SendBodyRequest(ctx, r)
+	}
 	blockPropagator := func(Ctx context.Context, header *types.Header, body *types.RawBody, td *big.Int) {}
 	if !cfg.TxPool.Disable {
 		poolCfg := txpoolcfg.DefaultConfig
@@ -398,6 +432,7 @@ func MockWithEverything(tb testing.TB, gspec *types.Genesis, key *ecdsa.PrivateK
 
 	statusDataProvider := sentry.NewStatusDataProvider(
 		db,
+		mock.BlockReader,
 		mock.ChainConfig,
 		mock.Genesis,
 		mock.ChainConfig.ChainID.Uint64(),
@@ -0,0 +1,430 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package mock
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/gointerfaces"
+	proto_sentry "github.com/erigontech/erigon-lib/gointerfaces/sentryproto"
+	ptypes "github.com/erigontech/erigon-lib/gointerfaces/typesproto"
+	"github.com/erigontech/erigon-lib/kv"
+	"github.com/erigontech/erigon-lib/rlp"
+	"github.com/erigontech/erigon-lib/wrap"
+	"github.com/erigontech/erigon/eth/protocols/eth"
+	"github.com/erigontech/erigon/eth/stagedsync/stages"
+	"github.com/erigontech/erigon/p2p/sentry"
+	stages2 "github.com/erigontech/erigon/turbo/stages"
+)
+
+// MessageFilter intercepts messages sent by a node before they reach the network.
+// It returns the message to deliver (possibly modified), or nil to drop it.
+type MessageFilter func(to int, msg *proto_sentry.OutboundMessageData) *proto_sentry.OutboundMessageData
+
+// Network connects MockSentry nodes through an in-process router, so that the staged sync
+// of several nodes can be tested end-to-end without sockets.
+//
+// The network advances in rounds. In every round, first all messages which are due are
+// delivered one by one (including the replies they trigger), and then every node runs one
+// iteration of its staged sync. Latency is expressed in rounds, which keeps the runs
+// deterministic. Partitions, per-link latency and per-node message filters (to emulate
+// malicious peers) can be changed between rounds.
+type Network struct {
+	nodes []*MockSentry
+	ids   map[[64]byte]int
+
+	lock    sync.Mutex
+	round   uint64
+	queue   []*envelope
+	groups  []int             // partition group of every node, only nodes of the same group can talk
+	kicked  map[link]struct{} // links closed by a penalty
+	latency map[link]uint64
+	filters []MessageFilter
+	next    []int // round-robin cursor of every node for messages sent by min block
+}
+
+type link struct {
+	from, to int
+}
+
+type envelope struct {
+	from, to int
+	due      uint64
+	msg      *proto_sentry.OutboundMessageData
+}
+
+// NewNetwork connects the given nodes with each other. The nodes must share the genesis
+// and must not run a txpool.
+func NewNetwork(tb testing.TB, nodes ...*MockSentry) *Network {
+	n := &Network{
+		nodes:   nodes,
+		ids:     map[[64]byte]int{},
+		groups:  make([]int, len(nodes)),
+		kicked:  map[link]struct{}{},
+		latency: map[link]uint64{},
+		filters: make([]MessageFilter, len(nodes)),
+		next:    make([]int, len(nodes)),
+	}
+	for i, node := range nodes {
+		if node.TxPool != nil {
+			tb.Fatalf("node %d: txpool is not supported by the network", i)
+		}
+		if node.Genesis.Hash() != nodes[0].Genesis.Hash() {
+			tb.Fatalf("node %d: genesis mismatch", i)
+		}
+		var id [64]byte
+		binary.BigEndian.PutUint64(id[56:], uint64(i+1))
+		n.ids[id] = i
+		node.PeerId = gointerfaces.ConvertHashToH512(id)
+		node.network = n
+		// the stage loop iterations are not initial cycles, see MockInsertAsInitialCycle
+		node.HeaderDownload().AfterInitialCycle()
+	}
+	return n
+}
+
+// Node returns the i-th node of the network.
+func (n *Network) Node(i int) *MockSentry {
+	return n.nodes[i]
+}
+
+// Round returns the number of rounds stepped so far.
+func (n *Network) Round() uint64 {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.round
+}
+
+// Partition splits the network into the given groups of nodes. Nodes which are not
+// listed form one more group. Messages in flight between the groups are dropped.
+func (n *Network) Partition(groups ...[]int) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	for i := range n.groups {
+		n.groups[i] = 0
+	}
+	for g, nodes := range groups {
+		for _, i := range nodes {
+			n.groups[i] = g + 1
+		}
+	}
+}
+
+// Heal removes all partitions and reconnects the links closed by penalties.
+func (n *Network) Heal() {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	for i := range n.groups {
+		n.groups[i] = 0
+	}
+	clear(n.kicked)
+}
+
+// SetLatency delays messages between nodes a and b, in both directions, by the given
+// number of rounds.
+func (n *Network) SetLatency(a, b int, rounds uint64) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.latency[link{a, b}] = rounds
+	n.latency[link{b, a}] = rounds
+}
+
+// SetFilter installs a filter for all messages sent by node i. A nil filter removes it.
+func (n *Network) SetFilter(i int, filter MessageFilter) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.filters[i] = filter
+}
+
+// Connected reports whether nodes a and b can exchange messages.
+func (n *Network) Connected(a, b int) bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.connected(a, b)
+}
+
+func (n *Network) connected(a, b int) bool {
+	if a == b || n.groups[a] != n.groups[b] {
+		return false
+	}
+	_, kicked := n.kicked[link{a, b}]
+	return !kicked
+}
+
+// Head returns the block number and hash the node has fully synced to.
+func (n *Network) Head(i int) (uint64, libcommon.Hash, error) {
+	node := n.nodes[i]
+	var number uint64
+	var hash libcommon.Hash
+	err := node.DB.View(node.Ctx, func(tx kv.Tx) (err error) {
+		if number, err = stages.GetStageProgress(tx, stages.Finish); err != nil {
+			return err
+		}
+		hash, _, err = node.BlockReader.CanonicalHash(node.Ctx, tx, number)
+		return err
+	})
+	return number, hash, err
+}
+
+// InSync reports whether all the given nodes (all nodes if none given) have the same head.
+func (n *Network) InSync(nodes ...int) (bool, error) {
+	if len(nodes) == 0 {
+		for i := range n.nodes {
+			nodes = append(nodes, i)
+		}
+	}
+	_, first, err := n.Head(nodes[0])
+	if err != nil {
+		return false, err
+	}
+	for _, i := range nodes[1:] {
+		_, hash, err := n.Head(i)
+		if err != nil {
+			return false, err
+		}
+		if hash != first {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Step runs one round: it delivers all due messages and then runs one staged sync
+// iteration on every node. It returns the number of delivered messages.
+func (n *Network) Step() (int, error) {
+	delivered, err := n.deliver()
+	if err != nil {
+		return delivered, err
+	}
+	for i, node := range n.nodes {
+		// the bodies stage stops after one iteration in tests, even if not all bodies are there yet
+		err := stages2.StageLoopIteration(node.Ctx, node.DB, wrap.TxContainer{}, node.Sync, MockInsertAsInitialCycle, false, node.Log, node.BlockReader, nil)
+		if err != nil && !errors.Is(err, libcommon.ErrStopped) {
+			return delivered, fmt.Errorf("node %d: %w", i, err)
+		}
+	}
+	n.lock.Lock()
+	n.round++
+	n.lock.Unlock()
+	return delivered, nil
+}
+
+// Run steps the network until done returns true or the timeout expires. Retries of the
+// header and body downloaders are driven by wall-clock deadlines, so rounds which deliver
+// no messages wait until the earliest retry of any node is due.
+func (n *Network) Run(done func() (bool, error), timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		delivered, err := n.Step()
+		if err != nil {
+			return err
+		}
+		ok, err := done()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("network did not reach the expected state in %s (%d rounds)", timeout, n.Round())
+		}
+		if delivered == 0 {
+			n.waitForRetry(deadline)
+		}
+	}
+}
+
+// waitForRetry blocks until the downloader of any node is due to retry a request, or until
+// the deadline. It returns at once if no request waits for a retry.
+func (n *Network) waitForRetry(deadline time.Time) {
+	next, pending := deadline, false
+	for _, node := range n.nodes {
+		if retry, ok := node.sentriesClient.Hd.NextRetryTime(); ok {
+			next, pending = minTime(next, retry), true
+		}
+		if retry, ok := node.sentriesClient.Bd.NextRetryTime(); ok {
+			next, pending = minTime(next, retry), true
+		}
+	}
+	wait := time.Until(next)
+	if !pending || wait <= 0 {
+		return
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-n.nodes[0].Ctx.Done():
+	}
+}
+
+func minTime(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+// deliver hands the due messages to their receivers one at a time and waits until each
+// of them is processed. Replies are queued and delivered in the same round, unless
+// delayed by latency.
+func (n *Network) deliver() (int, error) {
+	var delivered int
+	for {
+		env := n.pop()
+		if env == nil {
+			return delivered, nil
+		}
+		node := n.nodes[env.to]
+		node.ReceiveWg.Add(len(node.streams[env.msg.Id]))
+		for _, err := range node.Send(&proto_sentry.InboundMessage{Id: env.msg.Id, Data: env.msg.Data, PeerId: n.nodes[env.from].PeerId}) {
+			if err != nil {
+				return delivered, err
+			}
+		}
+		node.ReceiveWg.Wait()
+		delivered++
+	}
+}
+
+func (n *Network) pop() *envelope {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	for i := 0; i < len(n.queue); {
+		env := n.queue[i]
+		if env.due > n.round {
+			i++
+			continue
+		}
+		n.queue = append(n.queue[:i], n.queue[i+1:]...)
+		if n.connected(env.from, env.to) {
+			return env
+		}
+		// links broken while the message was in flight drop it
+	}
+	return nil
+}
+
+func (n *Network) enqueue(from, to int, msg *proto_sentry.OutboundMessageData) {
+	if filter := n.filters[from]; filter != nil {
+		if msg = filter(to, msg); msg == nil {
+			return
+		}
+	}
+	n.queue = append(n.queue, &envelope{from: from, to: to, due: n.round + n.latency[link{from, to}], msg: msg})
+}
+
+func (n *Network) sendById(from int, peerId [64]byte, msg *proto_sentry.OutboundMessageData) *proto_sentry.SentPeers {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	to, ok := n.ids[peerId]
+	if !ok || !n.connected(from, to) {
+		return &proto_sentry.SentPeers{}
+	}
+	n.enqueue(from, to, msg)
+	return &proto_sentry.SentPeers{Peers: []*ptypes.H512{n.nodes[to].PeerId}}
+}
+
+// sendByMinBlock picks the peers in round-robin order, so that the choice is deterministic
+// and the load is spread over all peers which have the block.
+func (n *Network) sendByMinBlock(from int, minBlock uint64, maxPeers uint64, msg *proto_sentry.OutboundMessageData) *proto_sentry.SentPeers {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	sent := &proto_sentry.SentPeers{}
+	for k := 0; k < len(n.nodes) && uint64(len(sent.Peers)) < maxPeers; k++ {
+		to := (n.next[from] + k) % len(n.nodes)
+		if !n.connected(from, to) {
+			continue
+		}
+		head, _, err := n.Head(to)
+		if err != nil || head < minBlock {
+			continue
+		}
+		n.enqueue(from, to, msg)
+		sent.Peers = append(sent.Peers, n.nodes[to].PeerId)
+	}
+	n.next[from] = (n.next[from] + 1) % len(n.nodes)
+	return sent
+}
+
+func (n *Network) sendToAll(from int, maxPeers uint64, msg *proto_sentry.OutboundMessageData) *proto_sentry.SentPeers {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	sent := &proto_sentry.SentPeers{}
+	for to := range n.nodes {
+		if maxPeers > 0 && uint64(len(sent.Peers)) >= maxPeers {
+			break
+		}
+		if !n.connected(from, to) {
+			continue
+		}
+		n.enqueue(from, to, msg)
+		sent.Peers = append(sent.Peers, n.nodes[to].PeerId)
+	}
+	return sent
+}
+
+// penalize closes the link to the penalized peer, as the sentry disconnects it.
+func (n *Network) penalize(from int, peerId [64]byte) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if to, ok := n.ids[peerId]; ok {
+		n.kicked[link{from, to}] = struct{}{}
+		n.kicked[link{to, from}] = struct{}{}
+	}
+}
+
+func (n *Network) index(node *MockSentry) int {
+	return n.ids[sentry.ConvertH512ToPeerID(node.PeerId)]
+}
+
+// WithholdHeaders is a MessageFilter of a peer which never answers header requests.
+func WithholdHeaders(_ int, msg *proto_sentry.OutboundMessageData) *proto_sentry.OutboundMessageData {
+	if msg.Id == proto_sentry.MessageId_BLOCK_HEADERS_66 {
+		return nil
+	}
+	return msg
+}
+
+// CorruptBodies is a MessageFilter of a peer which strips the last transaction from
+// every block body it serves, so that the bodies do not match the requested headers.
+func CorruptBodies(_ int, msg *proto_sentry.OutboundMessageData) *proto_sentry.OutboundMessageData {
+	if msg.Id != proto_sentry.MessageId_BLOCK_BODIES_66 {
+		return msg
+	}
+	var packet eth.BlockRawBodiesPacket66
+	if err := rlp.DecodeBytes(msg.Data, &packet); err != nil {
+		return msg
+	}
+	for _, body := range packet.BlockRawBodiesPacket {
+		if len(body.Transactions) > 0 {
+			body.Transactions = body.Transactions[:len(body.Transactions)-1]
+		}
+	}
+	data, err := rlp.EncodeToBytes(&packet)
+	if err != nil {
+		return msg
+	}
+	return &proto_sentry.OutboundMessageData{Id: msg.Id, Data: data}
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package mock_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	libcommon "github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/common/u256"
+	"github.com/erigontech/erigon-lib/crypto"
+	sentry "github.com/erigontech/erigon-lib/gointerfaces/sentryproto"
+	"github.com/erigontech/erigon/core"
+	"github.com/erigontech/erigon/core/types"
+	"github.com/erigontech/erigon/params"
+	"github.com/erigontech/erigon/turbo/stages/mock"
+)
+
+// newTestNetwork creates n nodes with the same genesis, the first of which has a chain
+// of the given length with one transaction in every block.
+func newTestNetwork(t *testing.T, n int, length int) (*mock.Network, *core.ChainPack) {
+	key, _ := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	gspec := &types.Genesis{
+		Config: params.TestChainConfig,
+		Alloc: types.GenesisAlloc{
+			crypto.PubkeyToAddress(key.PublicKey): {Balance: big.NewInt(params.Ether)},
+		},
+	}
+	nodes := make([]*mock.MockSentry, n)
+	for i := range nodes {
+		nodes[i] = mock.MockWithGenesis(t, gspec, key, false)
+	}
+	producer := nodes[0]
+	chain, err := core.GenerateChain(producer.ChainConfig, producer.Genesis, producer.Engine, producer.DB, length, func(i int, b *core.BlockGen) {
+		b.SetCoinbase(libcommon.Address{1})
+		txn, err := types.SignTx(types.NewTransaction(b.TxNonce(producer.Address), libcommon.Address{2}, uint256.NewInt(1000), params.TxGas, u256.Num1, nil), *types.LatestSignerForChainID(producer.ChainConfig.ChainID), producer.Key)
+		require.NoError(t, err)
+		b.AddTx(txn)
+	})
+	require.NoError(t, err)
+	require.NoError(t, producer.InsertChain(chain))
+	return mock.NewNetwork(t, nodes...), chain
+}
+
+func headIs(network *mock.Network, block *types.Block, nodes ...int) func() (bool, error) {
+	return func() (bool, error) {
+		for _, i := range nodes {
+			number, hash, err := network.Head(i)
+			if err != nil {
+				return false, err
+			}
+			if number != block.NumberU64() || hash != block.Hash() {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+}
+
+func TestNetworkSync(t *testing.T) {
+	t.Parallel()
+	network, chain := newTestNetwork(t, 3, 20)
+	network.SetLatency(0, 2, 3)
+
+	require.NoError(t, network.Run(headIs(network, chain.TopBlock, 1, 2), time.Minute))
+	inSync, err := network.InSync()
+	require.NoError(t, err)
+	require.True(t, inSync)
+}
+
+func TestNetworkPartition(t *testing.T) {
+	t.Parallel()
+	network, chain := newTestNetwork(t, 3, 10)
+	network.Partition([]int{0, 1}, []int{2})
+
+	require.NoError(t, network.Run(headIs(network, chain.TopBlock, 1), time.Minute))
+	for i := 0; i < 5; i++ {
+		_, err := network.Step()
+		require.NoError(t, err)
+	}
+	number, _, err := network.Head(2)
+	require.NoError(t, err)
+	require.Zero(t, number, "partitioned node must not sync")
+
+	network.Heal()
+	require.NoError(t, network.Run(headIs(network, chain.TopBlock, 2), time.Minute))
+}
+
+func TestNetworkMaliciousPeers(t *testing.T) {
+	t.Parallel()
+	network, chain := newTestNetwork(t, 4, 10)
+	require.NoError(t, network.Node(1).InsertChain(chain))
+	require.NoError(t, network.Node(2).InsertChain(chain))
+	network.SetFilter(1, func(to int, msg *sentry.OutboundMessageData) *sentry.OutboundMessageData {
+		if msg = mock.WithholdHeaders(to, msg); msg == nil {
+			return nil
+		}
+		return mock.CorruptBodies(to, msg)
+	})
+	network.SetFilter(2, mock.CorruptBodies)
+
+	// the honest peer is slow, so the malicious ones answer first
+	network.SetLatency(0, 3, 2)
+	require.NoError(t, network.Run(headIs(network, chain.TopBlock, 3), time.Minute))
+
+	// without the honest peer, a node cannot get valid bodies
+	network, chain = newTestNetwork(t, 2, 5)
+	network.SetFilter(0, mock.CorruptBodies)
+	for i := 0; i < 10; i++ {
+		_, err := network.Step()
+		require.NoError(t, err)
+	}
+	number, _, err := network.Head(1)
+	require.NoError(t, err)
+	require.Zero(t, number)
+	network.SetFilter(0, nil)
+	require.NoError(t, network.Run(headIs(network, chain.TopBlock, 1), time.Minute))
+}
@@ -81,6 +81,7 @@ func Assemble(
 		agraBlock,
 		cancunTime,
 		pragueTime,
+		chainConfig.OsakaTime,
 		chainConfig.BlobSchedule,
 		sentryClients,
 		stateChangesClient,
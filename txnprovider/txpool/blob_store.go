@@ -0,0 +1,313 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"sync"
+
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/spf13/afero"
+
+	"github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/common/fixedgas"
+	"github.com/erigontech/erigon-lib/common/length"
+	"github.com/erigontech/erigon-lib/rlp"
+	"github.com/erigontech/erigon/core/types"
+)
+
+// blobSidecar - the part of the blob txn network wrapper, which is not included into blocks
+type blobSidecar struct {
+	version     byte
+	blobs       [][]byte
+	commitments []gokzg4844.KZGCommitment
+	proofs      []gokzg4844.KZGProof // cell proofs for version 1
+}
+
+func blobSidecarOf(txn *TxnSlot) *blobSidecar {
+	return &blobSidecar{version: txn.BlobWrapperVersion, blobs: txn.Blobs, commitments: txn.Commitments, proofs: txn.Proofs}
+}
+
+// blobStore keeps sidecars of pooled blob txns on disk, outside of the pool db: they are big, and needed only to serve
+// GetPooledTransactions, engine_getBlobs and block building. The pool db keeps blob txns without the wrapper.
+//
+// The pool queues writes and removals under its lock (put, delete), and they are applied to disk by flush, outside
+// of it. Until then, get serves sidecars from the queue.
+type blobStore struct {
+	fs afero.Fs
+
+	lock      sync.Mutex
+	queued    map[common.Hash]*blobStoreOp // the last queued op of each txn
+	flushLock sync.Mutex                   // one flush at a time, for ops of a txn to be applied in order
+}
+
+// blobStoreOp - the write of the sidecar, or the removal if it's nil
+type blobStoreOp struct {
+	sidecar *blobSidecar
+}
+
+func newBlobStore(fs afero.Fs) *blobStore {
+	return &blobStore{fs: fs, queued: map[common.Hash]*blobStoreOp{}}
+}
+
+// put queues the write of the sidecar of the given txn
+func (s *blobStore) put(txnHash common.Hash, sidecar *blobSidecar) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.queued[txnHash] = &blobStoreOp{sidecar: sidecar}
+}
+
+// delete queues the removal of the sidecar of the given txn
+func (s *blobStore) delete(txnHash common.Hash) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.queued[txnHash] = &blobStoreOp{}
+}
+
+// get returns the sidecar of the given txn, queued or on disk, or nil if there is none
+func (s *blobStore) get(txnHash common.Hash) (*blobSidecar, error) {
+	s.lock.Lock()
+	op, ok := s.queued[txnHash]
+	s.lock.Unlock()
+	if ok {
+		return op.sidecar, nil
+	}
+	return s.read(txnHash)
+}
+
+// flush applies the queued ops to disk. Failed ops stay queued, to be retried by the next flush.
+func (s *blobStore) flush() error {
+	s.flushLock.Lock()
+	defer s.flushLock.Unlock()
+
+	s.lock.Lock()
+	ops := maps.Clone(s.queued)
+	s.lock.Unlock()
+
+	var errs []error
+	for txnHash, op := range ops {
+		var err error
+		if op.sidecar != nil {
+			err = s.write(txnHash, op.sidecar)
+		} else {
+			err = s.remove(txnHash)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("txn %x: %w", txnHash, err))
+			continue
+		}
+		// an op queued since then is applied by the next flush
+		s.lock.Lock()
+		if s.queued[txnHash] == op {
+			delete(s.queued, txnHash)
+		}
+		s.lock.Unlock()
+	}
+	return errors.Join(errs...)
+}
+
+/*
+file system layout: <first byte of txn hash>/<txn hash>
+file format: version(1) | blobs count(4) | blobs | commitments | proofs count(4) | proofs
+*/
+
+func blobSidecarFilePath(txnHash common.Hash) (folderpath, filepath string) {
+	folderpath = fmt.Sprintf("%02x", txnHash[0])
+	filepath = fmt.Sprintf("%s/%x", folderpath, txnHash)
+	return
+}
+
+func (s *blobStore) write(txnHash common.Hash, sidecar *blobSidecar) error {
+	if len(sidecar.blobs) != len(sidecar.commitments) {
+		return fmt.Errorf("blob store: %d blobs and %d commitments", len(sidecar.blobs), len(sidecar.commitments))
+	}
+	folderPath, filePath := blobSidecarFilePath(txnHash)
+	if err := s.fs.MkdirAll(folderPath, 0755); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 0, 1+4+len(sidecar.blobs)*(fixedgas.BlobSize+48)+4+len(sidecar.proofs)*48)
+	buf = append(buf, sidecar.version)
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(sidecar.blobs)))
+	for _, blob := range sidecar.blobs {
+		if len(blob) != fixedgas.BlobSize {
+			return fmt.Errorf("blob store: blob of size %d", len(blob))
+		}
+		buf = append(buf, blob...)
+	}
+	for _, commitment := range sidecar.commitments {
+		buf = append(buf, commitment[:]...)
+	}
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(sidecar.proofs)))
+	for _, proof := range sidecar.proofs {
+		buf = append(buf, proof[:]...)
+	}
+
+	// write to a temporary file first, to never leave a partially written sidecar on crash
+	tmpPath := filePath + ".tmp"
+	if err := afero.WriteFile(s.fs, tmpPath, buf, 0644); err != nil {
+		return err
+	}
+	return s.fs.Rename(tmpPath, filePath)
+}
+
+// read returns nil if there is no sidecar of the given txn
+func (s *blobStore) read(txnHash common.Hash) (*blobSidecar, error) {
+	_, filePath := blobSidecarFilePath(txnHash)
+	buf, err := afero.ReadFile(s.fs, filePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	corrupted := fmt.Errorf("blob store: corrupted sidecar of txn %x", txnHash)
+	if len(buf) < 5 {
+		return nil, corrupted
+	}
+	sidecar := &blobSidecar{version: buf[0]}
+	blobsCount := int(binary.BigEndian.Uint32(buf[1:5]))
+	pos := 5
+	if len(buf) < pos+blobsCount*(fixedgas.BlobSize+48)+4 {
+		return nil, corrupted
+	}
+	sidecar.blobs = make([][]byte, blobsCount)
+	for i := range sidecar.blobs {
+		sidecar.blobs[i] = buf[pos : pos+fixedgas.BlobSize]
+		pos += fixedgas.BlobSize
+	}
+	sidecar.commitments = make([]gokzg4844.KZGCommitment, blobsCount)
+	for i := range sidecar.commitments {
+		copy(sidecar.commitments[i][:], buf[pos:pos+48])
+		pos += 48
+	}
+	proofsCount := int(binary.BigEndian.Uint32(buf[pos : pos+4]))
+	pos += 4
+	if len(buf) != pos+proofsCount*48 {
+		return nil, corrupted
+	}
+	sidecar.proofs = make([]gokzg4844.KZGProof, proofsCount)
+	for i := range sidecar.proofs {
+		copy(sidecar.proofs[i][:], buf[pos:pos+48])
+		pos += 48
+	}
+	return sidecar, nil
+}
+
+func (s *blobStore) has(txnHash common.Hash) (bool, error) {
+	_, filePath := blobSidecarFilePath(txnHash)
+	return afero.Exists(s.fs, filePath)
+}
+
+func (s *blobStore) remove(txnHash common.Hash) error {
+	_, filePath := blobSidecarFilePath(txnHash)
+	if err := s.fs.Remove(filePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// prune removes sidecars of txns not known to the pool, for example left after a crash before the pool db was flushed
+func (s *blobStore) prune(keep func(txnHash common.Hash) bool) (pruned int, err error) {
+	err = afero.Walk(s.fs, ".", func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		// temporary files, left after a crash, are removed too
+		if b, err := hex.DecodeString(filepath.Base(path)); err == nil && len(b) == length.Hash && keep(common.BytesToHash(b)) {
+			return nil
+		}
+		pruned++
+		return s.fs.Remove(path)
+	})
+	return pruned, err
+}
+
+// isWrappedBlobTxnRlp - whether the given typed blob txn is in the network form, wrapped with blobs
+func isWrappedBlobTxnRlp(txnRlp []byte) bool {
+	if len(txnRlp) < 2 || txnRlp[0] != BlobTxnType {
+		return false
+	}
+	dataPos, _, err := rlp.ParseList(txnRlp, 1)
+	if err != nil {
+		return false
+	}
+	_, _, isList, err := rlp.Prefix(txnRlp, dataPos)
+	return err == nil && isList
+}
+
+// unwrapBlobTxnRlp returns a copy of the given network form of blob txn without the wrapper
+func unwrapBlobTxnRlp(wrapped []byte) ([]byte, error) {
+	dataPos, _, err := rlp.ParseList(wrapped, 1)
+	if err != nil {
+		return nil, err
+	}
+	txnPos, txnLen, err := rlp.ParseList(wrapped, dataPos)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{BlobTxnType}, wrapped[dataPos:txnPos+txnLen]...), nil
+}
+
+// wrapBlobTxnRlp assembles the network form of blob txn from the txn without the wrapper and its sidecar
+func wrapBlobTxnRlp(txnRlp []byte, sidecar *blobSidecar) []byte {
+	txn := txnRlp[1:]
+	blobsLen := 0
+	for _, blob := range sidecar.blobs {
+		blobsLen += rlp.StringLen(blob)
+	}
+	commitmentsLen := len(sidecar.commitments) * (1 + 48)
+	proofsLen := len(sidecar.proofs) * (1 + 48)
+	dataLen := len(txn) + rlp.ListPrefixLen(blobsLen) + blobsLen + rlp.ListPrefixLen(commitmentsLen) + commitmentsLen +
+		rlp.ListPrefixLen(proofsLen) + proofsLen
+	if sidecar.version != types.BlobTxWrapperVersion0 {
+		dataLen += rlp.U64Len(uint64(sidecar.version))
+	}
+
+	buf := make([]byte, 1+rlp.ListPrefixLen(dataLen)+dataLen)
+	buf[0] = BlobTxnType
+	pos := 1
+	pos += rlp.EncodeListPrefix(dataLen, buf[pos:])
+	pos += copy(buf[pos:], txn)
+	if sidecar.version != types.BlobTxWrapperVersion0 {
+		pos += rlp.EncodeU64(uint64(sidecar.version), buf[pos:])
+	}
+	pos += rlp.EncodeListPrefix(blobsLen, buf[pos:])
+	for _, blob := range sidecar.blobs {
+		pos += rlp.EncodeString2(blob, buf[pos:])
+	}
+	pos += rlp.EncodeListPrefix(commitmentsLen, buf[pos:])
+	for _, commitment := range sidecar.commitments {
+		pos += rlp.EncodeString2(commitment[:], buf[pos:])
+	}
+	pos += rlp.EncodeListPrefix(proofsLen, buf[pos:])
+	for _, proof := range sidecar.proofs {
+		pos += rlp.EncodeString2(proof[:], buf[pos:])
+	}
+	return buf[:pos]
+}
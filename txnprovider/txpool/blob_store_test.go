@@ -0,0 +1,94 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"testing"
+
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/holiman/uint256"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/common/fixedgas"
+	"github.com/erigontech/erigon/core/types"
+)
+
+func TestBlobStore(t *testing.T) {
+	store := newBlobStore(afero.NewMemMapFs())
+	blobTxn := makeBlobTxn()
+	sidecar := blobSidecarOf(&blobTxn)
+
+	read, err := store.read(blobTxn.IDHash)
+	require.NoError(t, err)
+	require.Nil(t, read)
+
+	require.NoError(t, store.write(blobTxn.IDHash, sidecar))
+	read, err = store.read(blobTxn.IDHash)
+	require.NoError(t, err)
+	assert.Equal(t, sidecar, read)
+
+	other := common.Hash{1}
+	require.NoError(t, store.write(other, sidecar))
+	pruned, err := store.prune(func(txnHash common.Hash) bool { return txnHash == other })
+	require.NoError(t, err)
+	assert.Equal(t, 1, pruned)
+	stored, err := store.has(blobTxn.IDHash)
+	require.NoError(t, err)
+	assert.False(t, stored)
+	stored, err = store.has(other)
+	require.NoError(t, err)
+	assert.True(t, stored)
+
+	require.NoError(t, store.remove(other))
+	require.NoError(t, store.remove(other)) // removal of unknown sidecar is not an error
+	stored, err = store.has(other)
+	require.NoError(t, err)
+	assert.False(t, stored)
+}
+
+func TestWrapBlobTxnRlp(t *testing.T) {
+	wrapped, _ := types.MakeBlobTxnRlp()
+	require.True(t, isWrappedBlobTxnRlp(wrapped))
+
+	var blobTxn TxnSlot
+	parseCtx := NewTxnParseContext(*uint256.NewInt(5)).ChainIDRequired()
+	parseCtx.WithSender(false)
+	_, err := parseCtx.ParseTransaction(wrapped, 0, &blobTxn, nil, false, true, nil)
+	require.NoError(t, err)
+
+	unwrapped, err := unwrapBlobTxnRlp(wrapped)
+	require.NoError(t, err)
+	assert.False(t, isWrappedBlobTxnRlp(unwrapped))
+	assert.Equal(t, wrapped, wrapBlobTxnRlp(unwrapped, blobSidecarOf(&blobTxn)))
+
+	// EIP-7594 wrapper: version and cell proofs
+	sidecar := blobSidecarOf(&blobTxn)
+	sidecar.version = types.BlobTxWrapperVersion1
+	sidecar.proofs = make([]gokzg4844.KZGProof, len(sidecar.blobs)*fixedgas.CellsPerExtBlob)
+	wrappedV1 := wrapBlobTxnRlp(unwrapped, sidecar)
+	require.True(t, isWrappedBlobTxnRlp(wrappedV1))
+
+	var blobTxnV1 TxnSlot
+	_, err = parseCtx.ParseTransaction(wrappedV1, 0, &blobTxnV1, nil, false, true, nil)
+	require.NoError(t, err)
+	assert.Equal(t, types.BlobTxWrapperVersion1, blobTxnV1.BlobWrapperVersion)
+	assert.Equal(t, blobTxn.IDHash, blobTxnV1.IDHash)
+	assert.Len(t, blobTxnV1.Proofs, len(blobTxn.Blobs)*fixedgas.CellsPerExtBlob)
+}
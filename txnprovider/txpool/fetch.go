@@ -32,6 +32,7 @@ import (
 	"github.com/erigontech/erigon-lib/gointerfaces/grpcutil"
 	remote "github.com/erigontech/erigon-lib/gointerfaces/remoteproto"
 	sentry "github.com/erigontech/erigon-lib/gointerfaces/sentryproto"
+	"github.com/erigontech/erigon-lib/gointerfaces/typesproto"
 	"github.com/erigontech/erigon-lib/kv"
 	"github.com/erigontech/erigon-lib/log/v3"
 	"github.com/erigontech/erigon-lib/rlp"
@@ -223,11 +224,13 @@ func (f *Fetch) handleInboundMessage(ctx context.Context, req *sentry.InboundMes
 	case sentry.MessageId_NEW_POOLED_TRANSACTION_HASHES_66:
 		hashCount, pos, err := ParseHashesCount(req.Data, 0)
 		if err != nil {
+			f.scorePeer(sentryClient, req.PeerId, sentry.ReputationEvent_TxnSpam)
 			return fmt.Errorf("parsing NewPooledTransactionHashes: %w", err)
 		}
 		hashes := make([]byte, 32*hashCount)
 		for i := 0; i < len(hashes); i += 32 {
 			if _, pos, err = ParseHash(req.Data, pos, hashes[i:]); err != nil {
+				f.scorePeer(sentryClient, req.PeerId, sentry.ReputationEvent_TxnSpam)
 				return err
 			}
 		}
@@ -252,6 +255,7 @@ func (f *Fetch) handleInboundMessage(ctx context.Context, req *sentry.InboundMes
 	case sentry.MessageId_NEW_POOLED_TRANSACTION_HASHES_68:
 		_, _, hashes, _, err := rlp.ParseAnnouncements(req.Data, 0)
 		if err != nil {
+			f.scorePeer(sentryClient, req.PeerId, sentry.ReputationEvent_TxnSpam)
 			return fmt.Errorf("parsing NewPooledTransactionHashes88: %w", err)
 		}
 		unknownHashes, err := f.pool.FilterKnownIdHashes(tx, hashes)
@@ -299,6 +303,9 @@ func (f *Fetch) handleInboundMessage(ctx context.Context, req *sentry.InboundMes
 			}
 
 			txnHash := hashes[i:min(i+hashSize, len(hashes))]
+			if f.pool.IsPrivate(txnHash) {
+				continue
+			}
 			txn, err := f.pool.GetRlp(tx, txnHash)
 			if err != nil {
 				return err
@@ -349,6 +356,9 @@ func (f *Fetch) handleInboundMessage(ctx context.Context, req *sentry.InboundMes
 				}
 				return nil
 			}); err != nil {
+				if errors.Is(err, rlp.ErrParse) {
+					f.scorePeer(sentryClient, req.PeerId, sentry.ReputationEvent_TxnSpam)
+				}
 				return err
 			}
 		case sentry.MessageId_POOLED_TRANSACTIONS_66:
@@ -368,6 +378,9 @@ func (f *Fetch) handleInboundMessage(ctx context.Context, req *sentry.InboundMes
 				}
 				return nil
 			}); err != nil {
+				if errors.Is(err, rlp.ErrParse) {
+					f.scorePeer(sentryClient, req.PeerId, sentry.ReputationEvent_TxnSpam)
+				}
 				return err
 			}
 		default:
@@ -412,6 +425,12 @@ func (f *Fetch) handleInboundMessage(ctx context.Context, req *sentry.InboundMes
 	return nil
 }
 
+func (f *Fetch) scorePeer(sentryClient sentry.SentryClient, peerID *typesproto.H512, event sentry.ReputationEvent) {
+	if _, err := sentryClient.ScorePeer(f.ctx, &sentry.ScorePeerRequest{PeerId: peerID, Event: event}, &grpc.EmptyCallOption{}); err != nil {
+		f.logger.Debug("[txpool.fetch] Could not score peer", "event", event, "err", err)
+	}
+}
+
 func (f *Fetch) receivePeerLoop(sentryClient sentry.SentryClient) {
 	for {
 		select {
@@ -0,0 +1,302 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/kv"
+	"github.com/erigontech/erigon-lib/kv/kvcache"
+	"github.com/erigontech/erigon-lib/kv/order"
+	"github.com/erigontech/erigon/txnprovider/txpool/txpoolcfg"
+)
+
+// localJournal keeps local transactions (submitted via RPC) until they are mined or replaced:
+//   - journaled transactions are exempt from sub-pool overflow eviction
+//   - they are persisted in kv.PoolLocalJournal and re-injected on restart, even if the pool itself dropped them
+//   - they are periodically re-broadcast while they sit in the pending sub-pool
+//
+// Not thread-safe: guarded by TxPool.lock
+type localJournal struct {
+	limit   int
+	entries map[string]*journalEntry // txn_hash => entry
+	changed map[string]struct{}      // txn_hashes added or removed since last flush
+	full    bool                     // a txn was turned away since the journal last had room
+}
+
+type journalEntry struct {
+	sender common.Address
+	rlp    []byte
+}
+
+func newLocalJournal(limit int) *localJournal {
+	return &localJournal{limit: limit, entries: map[string]*journalEntry{}, changed: map[string]struct{}{}}
+}
+
+func (j *localJournal) add(hash string, sender common.Address, rlp []byte) bool {
+	if _, ok := j.entries[hash]; ok {
+		return true
+	}
+	if len(j.entries) >= j.limit {
+		return false
+	}
+	j.entries[hash] = &journalEntry{sender: sender, rlp: common.Copy(rlp)}
+	j.changed[hash] = struct{}{}
+	return true
+}
+
+func (j *localJournal) remove(hash string) {
+	if _, ok := j.entries[hash]; !ok {
+		return
+	}
+	delete(j.entries, hash)
+	j.changed[hash] = struct{}{}
+	j.full = false
+}
+
+func (j *localJournal) has(hash string) bool {
+	_, ok := j.entries[hash]
+	return ok
+}
+
+func (j *localJournal) len() int { return len(j.entries) }
+
+// flush writes the entries added or removed since the last flush
+func (j *localJournal) flush(tx kv.RwTx) error {
+	v := make([]byte, 0, 1024)
+	for hash := range j.changed {
+		e, ok := j.entries[hash]
+		if !ok {
+			if err := tx.Delete(kv.PoolLocalJournal, []byte(hash)); err != nil {
+				return err
+			}
+			continue
+		}
+		v = common.EnsureEnoughSize(v, 20+len(e.rlp))
+		copy(v[:20], e.sender[:])
+		copy(v[20:], e.rlp)
+		if err := tx.Put(kv.PoolLocalJournal, []byte(hash), v); err != nil {
+			return err
+		}
+	}
+	clear(j.changed)
+	return nil
+}
+
+func (j *localJournal) load(tx kv.Tx) error {
+	it, err := tx.Range(kv.PoolLocalJournal, nil, nil, order.Asc, kv.Unlim)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for it.HasNext() {
+		k, v, err := it.Next()
+		if err != nil {
+			return err
+		}
+		if len(v) < 20 {
+			continue
+		}
+		j.entries[string(k)] = &journalEntry{sender: common.BytesToAddress(v[:20]), rlp: common.Copy(v[20:])}
+	}
+	return nil
+}
+
+// releasesJournaled reports whether a txn discarded for the given reason is done with, and should leave the journal:
+// it was mined, replaced, or its nonce is stale. Journaled txns dropped for any other reason, e.g. on overflow,
+// are re-injected on restart.
+func releasesJournaled(reason txpoolcfg.DiscardReason) bool {
+	switch reason {
+	case txpoolcfg.Mined, txpoolcfg.ReplacedByHigherTip, txpoolcfg.NonceTooLow:
+		return true
+	default:
+		return false
+	}
+}
+
+// journalLocked adds a local txn to the journal, if it's not full yet
+func (p *TxPool) journalLocked(mt *metaTxn) {
+	if mt.subPool&IsLocal == 0 || mt.TxnSlot.Rlp == nil || p.cfg.LocalJournalLimit <= 0 {
+		return
+	}
+	addr, ok := p.senders.getAddr(mt.TxnSlot.SenderID)
+	if !ok {
+		return
+	}
+	if p.localJournal.add(string(mt.TxnSlot.IDHash[:]), addr, mt.TxnSlot.Rlp) {
+		return
+	}
+	if mt.TxnSlot.Traced {
+		p.logger.Info("TX TRACING: local journal is full", "idHash", fmt.Sprintf("%x", mt.TxnSlot.IDHash), "limit", p.cfg.LocalJournalLimit)
+	}
+	// Warn once per overflow, until a journaled txn is mined, replaced or dropped
+	if !p.localJournal.full {
+		p.localJournal.full = true
+		p.logger.Warn("[txpool] local journal is full, new local txns can be evicted on overflow and are not restored on restart",
+			"limit", p.cfg.LocalJournalLimit, "hint", "raise --txpool.journal.limit")
+	}
+}
+
+// restoreJournaled re-injects journaled txns which didn't survive restart as part of the pool itself
+func (p *TxPool) restoreJournaled(cacheView kvcache.CacheView, pendingBaseFee, pendingBlobFee, blockGasLimit uint64) error {
+	txns := TxnSlots{}
+	parseCtx := NewTxnParseContext(p.chainID)
+	parseCtx.WithSender(false)
+
+	i := 0
+	for hash, e := range p.localJournal.entries {
+		if _, ok := p.byHash[hash]; ok {
+			continue
+		}
+		txn := &TxnSlot{}
+		if _, err := parseCtx.ParseTransaction(e.rlp, 0, txn, nil, false /* hasEnvelope */, true /*wrappedWithBlobs*/, nil); err != nil {
+			p.logger.Warn("[txpool] local journal: parseTransaction", "hash", hex.EncodeToString([]byte(hash)), "err", err)
+			p.localJournal.remove(hash)
+			continue
+		}
+		txn.SenderID, txn.Traced = p.senders.getOrCreateID(e.sender, p.logger)
+		if reason := p.validateTx(txn, true, cacheView); reason != txpoolcfg.NotSet && reason != txpoolcfg.Success {
+			p.localJournal.remove(hash)
+			p.discardReasonsLRU.Add(hash, reason)
+			continue
+		}
+		txns.Resize(uint(i + 1))
+		txns.Txns[i] = txn
+		txns.IsLocal[i] = true
+		copy(txns.Senders.At(i), e.sender[:])
+		i++
+	}
+	if i == 0 {
+		return nil
+	}
+
+	if err := p.senders.registerNewSenders(&txns, p.logger); err != nil {
+		return err
+	}
+	_, reasons, err := p.addTxns(p.lastSeenBlock.Load(), cacheView, p.senders, txns,
+		pendingBaseFee, pendingBlobFee, blockGasLimit, false, p.logger)
+	if err != nil {
+		return err
+	}
+	for i, reason := range reasons {
+		if reason != txpoolcfg.NotSet && reason != txpoolcfg.Success {
+			hash := string(txns.Txns[i].IDHash[:])
+			if releasesJournaled(reason) {
+				p.localJournal.remove(hash)
+			}
+			p.discardReasonsLRU.Add(hash, reason)
+		}
+	}
+	p.logger.Info("[txpool] restored local txns from journal", "count", i)
+	return nil
+}
+
+// rebroadcastLocals re-sends journaled txns which are still waiting in the pending sub-pool
+func (p *TxPool) rebroadcastLocals() {
+	var txnTypes []byte
+	var txnSizes []uint32
+	var txnHashes Hashes
+	var txnRlps [][]byte
+
+	p.lock.Lock()
+	for hash, e := range p.localJournal.entries {
+		mt, ok := p.byHash[hash]
+		if !ok || mt.currentSubPool != PendingSubPool || p.privateTxns.has(hash) {
+			continue
+		}
+		txnTypes = append(txnTypes, mt.TxnSlot.Type)
+		txnSizes = append(txnSizes, mt.TxnSlot.Size)
+		txnHashes = append(txnHashes, hash...)
+		// "Nodes MUST NOT automatically broadcast blob transactions to their peers" - EIP-4844
+		if mt.TxnSlot.Type != BlobTxnType {
+			txnRlps = append(txnRlps, e.rlp)
+		}
+	}
+	p.lock.Unlock()
+
+	if txnHashes.Len() == 0 {
+		return
+	}
+	p.p2pSender.BroadcastPooledTxns(txnRlps, localTxnsBroadcastMaxPeers)
+	p.p2pSender.AnnouncePooledTxns(txnTypes, txnSizes, txnHashes, localTxnsBroadcastMaxPeers*2)
+	p.logger.Debug("[txpool] re-broadcast local txns", "count", txnHashes.Len())
+}
+
+type TxnStatus uint8
+
+const (
+	TxnStatusUnknown TxnStatus = iota // never seen, or already forgotten
+	TxnStatusPending
+	TxnStatusBaseFee
+	TxnStatusQueued
+	TxnStatusDropped
+	TxnStatusMined
+)
+
+type TxnLifecycle struct {
+	Hash          common.Hash
+	Status        TxnStatus
+	DiscardReason txpoolcfg.DiscardReason // set for TxnStatusDropped
+	Local         bool
+	Journaled     bool
+}
+
+// Lifecycle reports status of given txns. If no hashes given - reports all journaled local txns.
+func (p *TxPool) Lifecycle(_ context.Context, hashes []common.Hash) []TxnLifecycle {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if len(hashes) == 0 {
+		hashes = make([]common.Hash, 0, p.localJournal.len())
+		for hash := range p.localJournal.entries {
+			hashes = append(hashes, common.BytesToHash([]byte(hash)))
+		}
+	}
+
+	res := make([]TxnLifecycle, len(hashes))
+	for i, h := range hashes {
+		hashS := string(h[:])
+		res[i] = TxnLifecycle{
+			Hash:      h,
+			Local:     p.isLocalLRU.Contains(hashS),
+			Journaled: p.localJournal.has(hashS),
+		}
+		if mt, ok := p.byHash[hashS]; ok {
+			switch mt.currentSubPool {
+			case PendingSubPool:
+				res[i].Status = TxnStatusPending
+			case BaseFeeSubPool:
+				res[i].Status = TxnStatusBaseFee
+			case QueuedSubPool:
+				res[i].Status = TxnStatusQueued
+			}
+			continue
+		}
+		if reason, ok := p.discardReasonsLRU.Peek(hashS); ok {
+			if reason == txpoolcfg.Mined {
+				res[i].Status = TxnStatusMined
+			} else {
+				res[i].Status = TxnStatusDropped
+				res[i].DiscardReason = reason
+			}
+		}
+	}
+	return res
+}
@@ -36,6 +36,7 @@ type metaTxn struct {
 	bestIndex                 int
 	worstIndex                int
 	timestamp                 uint64 // when it was added to pool
+	arrival                   uint64 // order of arrival to the pool, for fifo ordering. Not persisted: after a restart txns restored from the pool DB arrive in DB order, before any new txn
 	subPool                   SubPoolMarker
 	currentSubPool            SubPoolType
 	minedBlockNum             uint64
@@ -0,0 +1,162 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon/txnprovider/txpool/txpoolcfg"
+)
+
+// orderingPolicy decides which txns of the pending sub-pool are offered for block building (YieldBest, ProvideTxns,
+// PeekBest) and in which order. It doesn't affect which txns are kept in the pool and which are evicted.
+type orderingPolicy interface {
+	// Order receives pending txns sorted by effective tip (pending.best) and returns the txns to offer, best first.
+	// Txns of the same sender must keep nonce order. Must not modify the given slice.
+	Order(best []*metaTxn, senderAddr func(senderID uint64) (common.Address, bool)) []*metaTxn
+}
+
+func newOrderingPolicy(cfg txpoolcfg.Config) (orderingPolicy, error) {
+	var policy orderingPolicy
+	switch cfg.OrderingPolicy {
+	case "", txpoolcfg.TipOrdering:
+		policy = tipOrdering{}
+	case txpoolcfg.FifoOrdering:
+		policy = fifoOrdering{}
+	case txpoolcfg.SenderFairOrdering:
+		policy = senderFairOrdering{}
+	default:
+		return nil, fmt.Errorf("unknown txpool ordering policy: %q", cfg.OrderingPolicy)
+	}
+	if len(cfg.AllowedSenders) == 0 && len(cfg.DeniedSenders) == 0 {
+		return policy, nil
+	}
+	return newSenderListOrdering(policy, cfg.AllowedSenders, cfg.DeniedSenders), nil
+}
+
+// tipOrdering - the default: highest effective tip first
+type tipOrdering struct{}
+
+func (tipOrdering) Order(best []*metaTxn, _ func(senderID uint64) (common.Address, bool)) []*metaTxn {
+	return best
+}
+
+// fifoOrdering - in order of arrival to the pool. A txn never goes before the lower nonce txns of its sender,
+// even if it arrived earlier. Arrival order is not persisted: after a restart the txns restored from the pool DB
+// count as arrived in the order they are loaded, all of them before any txn received since.
+type fifoOrdering struct{}
+
+func (fifoOrdering) Order(best []*metaTxn, _ func(senderID uint64) (common.Address, bool)) []*metaTxn {
+	res := slices.Clone(best)
+	slices.SortFunc(res, sortByNonceCmp)
+
+	// arrival of a txn is bumped to the arrival of its lower nonce predecessor, if that one arrived later
+	arrivals := make(map[*metaTxn]uint64, len(res))
+	for i, mt := range res {
+		arrival := mt.arrival
+		if i > 0 && res[i-1].TxnSlot.SenderID == mt.TxnSlot.SenderID {
+			arrival = max(arrival, arrivals[res[i-1]])
+		}
+		arrivals[mt] = arrival
+	}
+	slices.SortStableFunc(res, func(a, b *metaTxn) int {
+		if c := cmp.Compare(arrivals[a], arrivals[b]); c != 0 {
+			return c
+		}
+		return sortByNonceCmp(a, b)
+	})
+	return res
+}
+
+// senderFairOrdering - round-robin between senders: every round takes the next (by nonce) txn of each sender.
+// Within a round senders are ordered by the effective tip of their best txn.
+type senderFairOrdering struct{}
+
+func (senderFairOrdering) Order(best []*metaTxn, _ func(senderID uint64) (common.Address, bool)) []*metaTxn {
+	var senders []uint64
+	bySender := map[uint64][]*metaTxn{}
+	for _, mt := range best {
+		senderID := mt.TxnSlot.SenderID
+		if _, ok := bySender[senderID]; !ok {
+			senders = append(senders, senderID)
+		}
+		bySender[senderID] = append(bySender[senderID], mt)
+	}
+	for _, txns := range bySender {
+		slices.SortFunc(txns, sortByNonceCmp)
+	}
+
+	res := make([]*metaTxn, 0, len(best))
+	for round := 0; len(res) < len(best); round++ {
+		for _, senderID := range senders {
+			if txns := bySender[senderID]; round < len(txns) {
+				res = append(res, txns[round])
+			}
+		}
+	}
+	return res
+}
+
+// senderListOrdering - filters txns by allow/deny lists of senders, then applies the wrapped policy
+type senderListOrdering struct {
+	next    orderingPolicy
+	allowed map[common.Address]struct{} // empty - all senders are allowed
+	denied  map[common.Address]struct{}
+}
+
+func newSenderListOrdering(next orderingPolicy, allowed, denied []common.Address) *senderListOrdering {
+	o := &senderListOrdering{
+		next:    next,
+		allowed: make(map[common.Address]struct{}, len(allowed)),
+		denied:  make(map[common.Address]struct{}, len(denied)),
+	}
+	for _, addr := range allowed {
+		o.allowed[addr] = struct{}{}
+	}
+	for _, addr := range denied {
+		o.denied[addr] = struct{}{}
+	}
+	return o
+}
+
+func (o *senderListOrdering) Order(best []*metaTxn, senderAddr func(senderID uint64) (common.Address, bool)) []*metaTxn {
+	res := make([]*metaTxn, 0, len(best))
+	for _, mt := range best {
+		addr, ok := senderAddr(mt.TxnSlot.SenderID)
+		if !ok {
+			continue
+		}
+		if _, ok := o.denied[addr]; ok {
+			continue
+		}
+		if _, ok := o.allowed[addr]; !ok && len(o.allowed) > 0 {
+			continue
+		}
+		res = append(res, mt)
+	}
+	return o.next.Order(res, senderAddr)
+}
+
+func sortByNonceCmp(a, b *metaTxn) int {
+	if c := cmp.Compare(a.TxnSlot.SenderID, b.TxnSlot.SenderID); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.TxnSlot.Nonce, b.TxnSlot.Nonce)
+}
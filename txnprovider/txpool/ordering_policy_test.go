@@ -0,0 +1,205 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon/core/types"
+	"github.com/erigontech/erigon/txnprovider"
+	"github.com/erigontech/erigon/txnprovider/txpool/txpoolcfg"
+)
+
+type orderingTestTxn struct {
+	sender  uint64
+	nonce   uint64
+	tip     uint64
+	arrival uint64
+}
+
+// orderingTestPool fills the pending sub-pool in the same way the TxPool does, and sorts it by effective tip
+func orderingTestPool(txns []orderingTestTxn) *PendingPool {
+	pending := NewPendingSubPool(PendingSubPool, 1024)
+	for _, txn := range txns {
+		mt := newMetaTxn(&TxnSlot{SenderID: txn.sender, Nonce: txn.nonce, Tip: *uint256.NewInt(txn.tip), FeeCap: *uint256.NewInt(1_000)}, false, 0)
+		mt.subPool = BaseFeePoolBits
+		mt.minTip = txn.tip
+		mt.minFeeCap = *uint256.NewInt(1_000)
+		mt.nonceDistance = txn.nonce
+		mt.arrival = txn.arrival
+		pending.Add(mt, log.New())
+	}
+	pending.EnforceWorstInvariants()
+	pending.EnforceBestInvariants()
+	return pending
+}
+
+func orderingTestSenderAddr(senderID uint64) (common.Address, bool) {
+	return common.Address{byte(senderID)}, true
+}
+
+func orderingTestResult(txns []*metaTxn) [][2]uint64 {
+	res := make([][2]uint64, len(txns))
+	for i, mt := range txns {
+		res[i] = [2]uint64{mt.TxnSlot.SenderID, mt.TxnSlot.Nonce}
+	}
+	return res
+}
+
+func TestOrderingPolicies(t *testing.T) {
+	// sender 1 pays the least, and its nonce 1 arrived before nonce 0
+	txns := []orderingTestTxn{
+		{sender: 1, nonce: 0, tip: 10, arrival: 5},
+		{sender: 1, nonce: 1, tip: 10, arrival: 1},
+		{sender: 2, nonce: 0, tip: 30, arrival: 2},
+		{sender: 2, nonce: 1, tip: 30, arrival: 3},
+		{sender: 2, nonce: 2, tip: 30, arrival: 4},
+		{sender: 3, nonce: 0, tip: 20, arrival: 6},
+	}
+
+	tests := []struct {
+		name     string
+		cfg      func(cfg *txpoolcfg.Config)
+		expected [][2]uint64 // (sender, nonce)
+	}{
+		{
+			name:     "tip",
+			cfg:      func(cfg *txpoolcfg.Config) {},
+			expected: [][2]uint64{{2, 0}, {2, 1}, {2, 2}, {3, 0}, {1, 0}, {1, 1}},
+		},
+		{
+			name:     "fifo",
+			cfg:      func(cfg *txpoolcfg.Config) { cfg.OrderingPolicy = txpoolcfg.FifoOrdering },
+			expected: [][2]uint64{{2, 0}, {2, 1}, {2, 2}, {1, 0}, {1, 1}, {3, 0}},
+		},
+		{
+			name:     "sender-fair",
+			cfg:      func(cfg *txpoolcfg.Config) { cfg.OrderingPolicy = txpoolcfg.SenderFairOrdering },
+			expected: [][2]uint64{{2, 0}, {3, 0}, {1, 0}, {2, 1}, {1, 1}, {2, 2}},
+		},
+		{
+			name: "fifo with denied sender",
+			cfg: func(cfg *txpoolcfg.Config) {
+				cfg.OrderingPolicy = txpoolcfg.FifoOrdering
+				cfg.DeniedSenders = []common.Address{{2}}
+			},
+			expected: [][2]uint64{{1, 0}, {1, 1}, {3, 0}},
+		},
+		{
+			name: "tip with allowed senders",
+			cfg: func(cfg *txpoolcfg.Config) {
+				cfg.AllowedSenders = []common.Address{{1}, {2}}
+				cfg.DeniedSenders = []common.Address{{2}}
+			},
+			expected: [][2]uint64{{1, 0}, {1, 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := txpoolcfg.DefaultConfig
+			tt.cfg(&cfg)
+			policy, err := newOrderingPolicy(cfg)
+			require.NoError(t, err)
+
+			pending := orderingTestPool(txns)
+			best := append([]*metaTxn{}, pending.best.ms...)
+			assert.Equal(t, tt.expected, orderingTestResult(policy.Order(pending.best.ms, orderingTestSenderAddr)))
+			assert.Equal(t, best, pending.best.ms, "pending sub-pool must not be modified")
+		})
+	}
+}
+
+func TestUnknownOrderingPolicy(t *testing.T) {
+	cfg := txpoolcfg.DefaultConfig
+	cfg.OrderingPolicy = "lifo"
+	_, err := newOrderingPolicy(cfg)
+	require.Error(t, err)
+}
+
+// TestOrderingPolicyProvideTxns drives the policies through the whole pool: txns are added one by one, promoted from
+// queued to pending on a new block, and decoded back from the RLP yielded to the block builder
+func TestOrderingPolicyProvideTxns(t *testing.T) {
+	var addr1, addr2 [20]byte
+	addr1[0], addr2[0] = 1, 2
+
+	// the cheap sender's txns arrive first
+	arrivals := []struct {
+		sender [20]byte
+		nonce  uint64
+		feeCap uint64
+	}{
+		{addr1, 0, 300_000},
+		{addr1, 1, 300_000},
+		{addr1, 2, 300_000},
+		{addr2, 0, 400_000},
+		{addr2, 1, 400_000},
+	}
+	for _, tt := range []struct {
+		policy   string
+		allowed  []common.Address
+		expected [][2]uint64 // sender, nonce
+	}{
+		{policy: txpoolcfg.TipOrdering, expected: [][2]uint64{{2, 0}, {2, 1}, {1, 0}, {1, 1}, {1, 2}}},
+		{policy: txpoolcfg.FifoOrdering, expected: [][2]uint64{{1, 0}, {1, 1}, {1, 2}, {2, 0}, {2, 1}}},
+		{policy: txpoolcfg.SenderFairOrdering, expected: [][2]uint64{{2, 0}, {1, 0}, {2, 1}, {1, 1}, {1, 2}}},
+		{policy: txpoolcfg.FifoOrdering, allowed: []common.Address{addr2}, expected: [][2]uint64{{2, 0}, {2, 1}}},
+	} {
+		t.Run(tt.policy, func(t *testing.T) {
+			ctx := context.Background()
+			cfg := txpoolcfg.DefaultConfig
+			cfg.OrderingPolicy = tt.policy
+			cfg.AllowedSenders = tt.allowed
+			pool, _, change := newTestPool(t, cfg, addr1, addr2)
+
+			for _, arrival := range arrivals {
+				txn := types.NewTransaction(arrival.nonce, common.Address{}, uint256.NewInt(0), 100_000, uint256.NewInt(arrival.feeCap), nil)
+				var rlp bytes.Buffer
+				require.NoError(t, txn.MarshalBinary(&rlp))
+				txnSlot := &TxnSlot{Tip: *uint256.NewInt(arrival.feeCap), FeeCap: *uint256.NewInt(arrival.feeCap), Gas: 100_000, Nonce: arrival.nonce, Rlp: rlp.Bytes(), IDHash: txn.Hash()}
+				var txnSlots TxnSlots
+				txnSlots.Append(txnSlot, arrival.sender[:], true)
+				reasons, err := pool.AddLocalTxns(ctx, txnSlots)
+				require.NoError(t, err)
+				require.Equal(t, []txpoolcfg.DiscardReason{txpoolcfg.Success}, reasons)
+			}
+
+			// a new block re-sorts the sub-pools
+			change.ChangeBatch[0].BlockHeight = 1
+			change.ChangeBatch[0].Changes = nil
+			require.NoError(t, pool.OnNewBlock(ctx, change, TxnSlots{}, TxnSlots{}, TxnSlots{}))
+
+			txns, err := pool.ProvideTxns(ctx, txnprovider.WithParentBlockNum(1), txnprovider.WithAmount(10), txnprovider.WithGasTarget(1_000_000), txnprovider.WithTxnIdsFilter(mapset.NewSet[[32]byte]()))
+			require.NoError(t, err)
+			res := make([][2]uint64, len(txns))
+			for i, txn := range txns {
+				sender, ok := txn.GetSender()
+				require.True(t, ok)
+				res[i] = [2]uint64{uint64(sender[0]), txn.GetNonce()}
+			}
+			assert.Equal(t, tt.expected, res)
+		})
+	}
+}
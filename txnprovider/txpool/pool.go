@@ -25,6 +25,8 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"os"
+	"path/filepath"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -35,6 +37,7 @@ import (
 	"github.com/google/btree"
 	"github.com/hashicorp/golang-lru/v2/simplelru"
 	"github.com/holiman/uint256"
+	"github.com/spf13/afero"
 
 	"github.com/erigontech/erigon-lib/chain"
 	"github.com/erigontech/erigon-lib/common"
@@ -65,6 +68,9 @@ const DefaultBlockGasLimit = uint64(36000000)
 // by the peer.
 const txMaxBroadcastSize = 4 * 1024
 
+// localTxnsBroadcastMaxPeers is the number of peers local transactions are broadcast to (and twice as many are announced to)
+const localTxnsBroadcastMaxPeers uint64 = 10
+
 // Pool is interface for the transaction pool
 // This interface exists for the convenience of testing, and not yet because
 // there are multiple implementations
@@ -76,13 +82,17 @@ type Pool interface {
 	// Handle 3 main events - new remote txns from p2p, new local txns from RPC, new blocks from execution layer
 	AddRemoteTxns(ctx context.Context, newTxns TxnSlots)
 	AddLocalTxns(ctx context.Context, newTxns TxnSlots) ([]txpoolcfg.DiscardReason, error)
+	AddPrivateTxns(ctx context.Context, newTxns TxnSlots, maxBlock uint64) ([]txpoolcfg.DiscardReason, error)
 	OnNewBlock(ctx context.Context, stateChanges *remote.StateChangeBatch, unwindTxns, unwindBlobTxns, minedTxns TxnSlots) error
 	// IdHashKnown check whether transaction with given Id hash is known to the pool
 	IdHashKnown(tx kv.Tx, hash []byte) (bool, error)
 	FilterKnownIdHashes(tx kv.Tx, hashes Hashes) (unknownHashes Hashes, err error)
+	// IsPrivate check whether transaction with given Id hash must be kept out of p2p gossip
+	IsPrivate(idHash []byte) bool
 	Started() bool
 	GetRlp(tx kv.Tx, hash []byte) ([]byte, error)
 	GetBlobs(blobhashes []common.Hash) ([][]byte, [][]byte)
+	GetBlobsAndCellProofs(blobhashes []common.Hash) ([][]byte, [][][]byte)
 	AddNewGoodPeer(peerID PeerID)
 }
 
@@ -118,7 +128,10 @@ type TxPool struct {
 	queued                  *SubPool
 	minedBlobTxnsByBlock    map[uint64][]*metaTxn            // (blockNum => slice): cache of recently mined blobs
 	minedBlobTxnsByHash     map[string]*metaTxn              // (hash => mt): map of recently mined blobs
+	blobStore               *blobStore                       // sidecars of pooled and recently mined blob txns : persisted
 	isLocalLRU              *simplelru.LRU[string, struct{}] // txn_hash => is_local : to restore isLocal flag of unwinded transactions
+	localJournal            *localJournal                    // local txns retained until mined or replaced : persisted
+	privateTxns             *privateTxns                     // txns excluded from gossip : persisted
 	newPendingTxns          chan Announcements               // notifications about new txns in Pending sub-pool
 	all                     *BySenderAndNonce                // senderID => (sorted map of txn nonce => *metaTxn)
 	deletedTxns             []*metaTxn                       // list of discarded txns since last db commit
@@ -133,6 +146,7 @@ type TxPool struct {
 	pendingBlobFee          atomic.Uint64 // For gas accounting for blobs, which has its own dimension
 	blockGasLimit           atomic.Uint64
 	totalBlobsInPool        atomic.Uint64
+	remoteBlobTxns          *btree.BTreeG[*metaTxn] // remote blob txns by blob fee cap, for eviction from the full blob pool
 	shanghaiTime            *uint64
 	isPostShanghai          atomic.Bool
 	agraBlock               *uint64
@@ -141,11 +155,20 @@ type TxPool struct {
 	isPostCancun            atomic.Bool
 	pragueTime              *uint64
 	isPostPrague            atomic.Bool
+	osakaTime               *uint64
+	isPostOsaka             atomic.Bool
 	blobSchedule            *chain.BlobSchedule
 	feeCalculator           FeeCalculator
 	p2pFetcher              *Fetch
 	p2pSender               *Send
 	newSlotsStreams         *NewSlotsStreams
+	txnEventsStreams        *TxnEventsStreams
+	txnEvents               []TxnEvent    // not yet published to txnEventsStreams
+	txnEventsDropped        int           // events dropped since last publish, because txnEvents was full
+	txnEventsReady          chan struct{} // notifications about new txnEvents
+	blobStoreReady          chan struct{} // notifications about queued blob store writes and removals
+	orderingPolicy          orderingPolicy
+	arrivals                uint64 // counter of txns added to the pool
 	builderNotifyNewTxns    func()
 	logger                  log.Logger
 	auths                   map[common.Address]*metaTxn // All accounts with a pooled authorization
@@ -171,6 +194,7 @@ func New(
 	agraBlock *big.Int,
 	cancunTime *big.Int,
 	pragueTime *big.Int,
+	osakaTime *big.Int,
 	blobSchedule *chain.BlobSchedule,
 	sentryClients []sentryproto.SentryClient,
 	stateChangesClient StateChangesClient,
@@ -200,6 +224,20 @@ func New(
 		tracedSenders[common.BytesToAddress([]byte(sender))] = struct{}{}
 	}
 
+	orderingPolicy, err := newOrderingPolicy(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	blobsFs := afero.NewMemMapFs()
+	if cfg.DBDir != "" {
+		blobsDir := filepath.Join(cfg.DBDir, "blobs")
+		if err := os.MkdirAll(blobsDir, 0755); err != nil {
+			return nil, err
+		}
+		blobsFs = afero.NewBasePathFs(afero.NewOsFs(), blobsDir)
+	}
+
 	lock := &sync.Mutex{}
 
 	res := &TxPool{
@@ -207,8 +245,11 @@ func New(
 		lastSeenCond:            sync.NewCond(lock),
 		byHash:                  map[string]*metaTxn{},
 		isLocalLRU:              localsHistory,
+		localJournal:            newLocalJournal(cfg.LocalJournalLimit),
+		privateTxns:             newPrivateTxns(),
 		discardReasonsLRU:       discardHistory,
 		all:                     byNonce,
+		remoteBlobTxns:          btree.NewG[*metaTxn](32, blobEvictionLess),
 		recentlyConnectedPeers:  &recentlyConnectedPeers{},
 		pending:                 NewPendingSubPool(PendingSubPool, cfg.PendingSubPoolLimit),
 		baseFee:                 NewSubPool(BaseFeeSubPool, cfg.BaseFeeSubPoolLimit),
@@ -228,6 +269,11 @@ func New(
 		feeCalculator:           options.feeCalculator,
 		builderNotifyNewTxns:    builderNotifyNewTxns,
 		newSlotsStreams:         newSlotsStreams,
+		txnEventsStreams:        &TxnEventsStreams{},
+		txnEventsReady:          make(chan struct{}, 1),
+		blobStoreReady:          make(chan struct{}, 1),
+		orderingPolicy:          orderingPolicy,
+		blobStore:               newBlobStore(blobsFs),
 		logger:                  logger,
 		auths:                   map[common.Address]*metaTxn{},
 		blobHashToTxn: map[common.Hash]struct {
@@ -264,6 +310,13 @@ func New(
 		pragueTimeU64 := pragueTime.Uint64()
 		res.pragueTime = &pragueTimeU64
 	}
+	if osakaTime != nil {
+		if !osakaTime.IsUint64() {
+			return nil, errors.New("osakaTime overflow")
+		}
+		osakaTimeU64 := osakaTime.Uint64()
+		res.osakaTime = &osakaTimeU64
+	}
 
 	res.p2pFetcher = NewFetch(ctx, sentryClients, res, stateChangesClient, poolDB, chainID, logger, opts...)
 	res.p2pSender = NewSend(ctx, sentryClients, logger, opts...)
@@ -320,6 +373,17 @@ func (p *TxPool) OnNewBlock(ctx context.Context, stateChanges *remote.StateChang
 		return err
 	}
 
+	// sidecars of unwound blob txns are read before taking the lock
+	unwindSidecars := map[common.Hash]*blobSidecar{}
+	for _, txn := range unwindBlobTxns.Txns {
+		if txn.Type != BlobTxnType {
+			continue
+		}
+		if unwindSidecars[txn.IDHash], err = p.blobStore.get(txn.IDHash); err != nil {
+			return err
+		}
+	}
+
 	p.lock.Lock()
 	defer func() {
 		if err == nil {
@@ -396,9 +460,18 @@ func (p *TxPool) OnNewBlock(ctx context.Context, stateChanges *remote.StateChang
 			if err != nil {
 				return err
 			}
-			if knownBlobTxn != nil {
-				unwindTxns.Append(knownBlobTxn.TxnSlot, unwindBlobTxns.Senders.At(i), false)
+			if knownBlobTxn == nil {
+				continue
+			}
+			if len(knownBlobTxn.TxnSlot.Blobs) == 0 {
+				sidecar := unwindSidecars[txn.IDHash]
+				if sidecar == nil {
+					continue
+				}
+				knownBlobTxn.TxnSlot.BlobWrapperVersion = sidecar.version
+				knownBlobTxn.TxnSlot.Blobs, knownBlobTxn.TxnSlot.Commitments, knownBlobTxn.TxnSlot.Proofs = sidecar.blobs, sidecar.commitments, sidecar.proofs
 			}
+			unwindTxns.Append(knownBlobTxn.TxnSlot, unwindBlobTxns.Senders.At(i), false)
 		}
 	}
 	if err = p.senders.onNewBlock(stateChanges, unwindTxns, minedTxns, p.logger); err != nil {
@@ -460,6 +533,7 @@ func (p *TxPool) OnNewBlock(ctx context.Context, stateChanges *remote.StateChang
 	p.queued.EnforceInvariants()
 	p.promote(pendingBaseFee, pendingBlobFee, &announcements, p.logger)
 	p.pending.EnforceBestInvariants()
+	p.publishExpiredPrivateLocked(block, &announcements)
 	p.promoted.Reset()
 	p.promoted.AppendOther(announcements)
 
@@ -548,6 +622,7 @@ func (p *TxPool) processRemoteTxns(ctx context.Context) (err error) {
 	return nil
 }
 
+// getRlpLocked returns txn as it is kept by the pool: blob txns are without their sidecars, see wrapBlobs
 func (p *TxPool) getRlpLocked(tx kv.Tx, hash []byte) (rlpTxn []byte, sender common.Address, isLocal bool, err error) {
 	txn, ok := p.byHash[string(hash)]
 	if ok && txn.TxnSlot.Rlp != nil {
@@ -563,18 +638,35 @@ func (p *TxPool) getRlpLocked(tx kv.Tx, hash []byte) (rlpTxn []byte, sender comm
 	return v[20:], *(*[20]byte)(v[:20]), txn != nil && txn.subPool&IsLocal > 0, nil
 }
 
+// wrapBlobs returns txn in the network form: blob txns are wrapped with their sidecars. It reads the blob store,
+// so it's called outside of the pool lock. Nil is returned for blob txns without sidecar, they're useless without blobs.
+func (p *TxPool) wrapBlobs(hash common.Hash, rlpTxn []byte) ([]byte, error) {
+	if len(rlpTxn) == 0 || rlpTxn[0] != BlobTxnType || isWrappedBlobTxnRlp(rlpTxn) {
+		return rlpTxn, nil
+	}
+	sidecar, err := p.blobStore.get(hash)
+	if err != nil || sidecar == nil {
+		return nil, err
+	}
+	return wrapBlobTxnRlp(rlpTxn, sidecar), nil
+}
+
 func (p *TxPool) GetRlp(tx kv.Tx, hash []byte) ([]byte, error) {
 	p.lock.Lock()
-	defer p.lock.Unlock()
 	rlpTx, _, _, err := p.getRlpLocked(tx, hash)
-	return common.Copy(rlpTx), err
+	rlpTx = common.Copy(rlpTx)
+	p.lock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return p.wrapBlobs(common.BytesToHash(hash), rlpTx)
 }
 
 func (p *TxPool) AppendLocalAnnouncements(types []byte, sizes []uint32, hashes []byte) ([]byte, []uint32, []byte) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
 	for hash, txn := range p.byHash {
-		if txn.subPool&IsLocal == 0 {
+		if txn.subPool&IsLocal == 0 || p.privateTxns.has(hash) {
 			continue
 		}
 		types = append(types, txn.TxnSlot.Type)
@@ -678,7 +770,7 @@ func (p *TxPool) getCachedBlobTxnLocked(tx kv.Tx, hash []byte) (*metaTxn, error)
 	parseCtx := NewTxnParseContext(p.chainID)
 	parseCtx.WithSender(false)
 	txnSlot := &TxnSlot{}
-	parseCtx.ParseTransaction(txnRlp, 0, txnSlot, nil, false, true, nil)
+	parseCtx.ParseTransaction(txnRlp, 0, txnSlot, nil, false, isWrappedBlobTxnRlp(txnRlp), nil)
 	return newMetaTxn(txnSlot, false, 0), nil
 }
 
@@ -698,6 +790,56 @@ func (p *TxPool) Started() bool {
 }
 
 func (p *TxPool) best(ctx context.Context, n int, txns *TxnsRlp, onTopOf, availableGas, availableBlobGas uint64, yielded mapset.Set[[32]byte]) (bool, int, error) {
+	onTime, count, blobTxns, err := p.bestLocked(ctx, n, txns, onTopOf, availableGas, availableBlobGas, yielded)
+	if err != nil || len(blobTxns) == 0 {
+		return onTime, count, err
+	}
+
+	// sidecars of blob txns are read outside of the pool lock, txns which lost them are dropped
+	var missing []*metaTxn
+	for i, j := 0, 0; i < count; i++ {
+		if j < len(blobTxns) && blobTxns[j].idx == i {
+			mt := blobTxns[j].mt
+			j++
+			wrapped, err := p.wrapBlobs(mt.TxnSlot.IDHash, txns.Txns[i])
+			if err != nil {
+				return false, 0, err
+			}
+			if wrapped == nil {
+				missing = append(missing, mt)
+				yielded.Remove(mt.TxnSlot.IDHash)
+				continue
+			}
+			txns.Txns[i] = wrapped
+		}
+		k := i - len(missing)
+		txns.Txns[k] = txns.Txns[i]
+		copy(txns.Senders.At(k), txns.Senders.At(i))
+		txns.IsLocal[k] = txns.IsLocal[i]
+	}
+	if len(missing) == 0 {
+		return onTime, count, nil
+	}
+	count -= len(missing)
+	txns.Resize(uint(count))
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for _, mt := range missing {
+		if p.byHash[string(mt.TxnSlot.IDHash[:])] == mt && mt.currentSubPool == PendingSubPool {
+			p.pending.Remove(mt, "best", p.logger)
+		}
+	}
+	return onTime, count, nil
+}
+
+// bestBlobTxn is a blob txn returned by bestLocked, at index idx of the result, still to be wrapped with its sidecar
+type bestBlobTxn struct {
+	idx int
+	mt  *metaTxn
+}
+
+func (p *TxPool) bestLocked(ctx context.Context, n int, txns *TxnsRlp, onTopOf, availableGas, availableBlobGas uint64, yielded mapset.Set[[32]byte]) (bool, int, []bestBlobTxn, error) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
 
@@ -706,33 +848,34 @@ func (p *TxPool) best(ctx context.Context, n int, txns *TxnsRlp, onTopOf, availa
 		p.lastSeenCond.Wait()
 	}
 
-	best := p.pending.best
+	best := p.orderingPolicy.Order(p.pending.best.ms, p.senders.getAddr)
 
 	isShanghai := p.isShanghai() || p.isAgra()
 	isPrague := p.isPrague()
 
-	txns.Resize(uint(min(n, len(best.ms))))
+	txns.Resize(uint(min(n, len(best))))
 	var toRemove []*metaTxn
+	var blobTxns []bestBlobTxn
 	count := 0
 	i := 0
 
 	defer func() {
-		p.logger.Debug("[txpool] Processing best request", "last", onTopOf, "txRequested", n, "txAvailable", len(best.ms), "txProcessed", i, "txReturned", count)
+		p.logger.Debug("[txpool] Processing best request", "last", onTopOf, "txRequested", n, "txAvailable", len(best), "txProcessed", i, "txReturned", count)
 	}()
 
 	tx, err := p.poolDB.BeginRo(ctx)
 	if err != nil {
-		return false, 0, err
+		return false, 0, nil, err
 	}
 
 	defer tx.Rollback()
-	for ; count < n && i < len(best.ms); i++ {
+	for ; count < n && i < len(best); i++ {
 		// if we wouldn't have enough gas for a standard transaction then quit out early
 		if availableGas < fixedgas.TxGas {
 			break
 		}
 
-		mt := best.ms[i]
+		mt := best[i]
 
 		if yielded.Contains(mt.TxnSlot.IDHash) {
 			continue
@@ -745,7 +888,7 @@ func (p *TxPool) best(ctx context.Context, n int, txns *TxnsRlp, onTopOf, availa
 
 		rlpTxn, sender, isLocal, err := p.getRlpLocked(tx, mt.TxnSlot.IDHash[:])
 		if err != nil {
-			return false, count, err
+			return false, count, nil, err
 		}
 		if len(rlpTxn) == 0 {
 			toRemove = append(toRemove, mt)
@@ -773,6 +916,9 @@ func (p *TxPool) best(ctx context.Context, n int, txns *TxnsRlp, onTopOf, availa
 		}
 		availableGas -= intrinsicGas
 
+		if rlpTxn[0] == BlobTxnType && !isWrappedBlobTxnRlp(rlpTxn) {
+			blobTxns = append(blobTxns, bestBlobTxn{idx: count, mt: mt})
+		}
 		txns.Txns[count] = rlpTxn
 		copy(txns.Senders.At(count), sender.Bytes())
 		txns.IsLocal[count] = isLocal
@@ -786,7 +932,7 @@ func (p *TxPool) best(ctx context.Context, n int, txns *TxnsRlp, onTopOf, availa
 			p.pending.Remove(mt, "best", p.logger)
 		}
 	}
-	return true, count, nil
+	return true, count, blobTxns, nil
 }
 
 func (p *TxPool) ProvideTxns(ctx context.Context, opts ...txnprovider.ProvideOption) ([]types.Transaction, error) {
@@ -825,8 +971,14 @@ func (p *TxPool) YieldBest(ctx context.Context, n int, txns *TxnsRlp, onTopOf, a
 	return p.best(ctx, n, txns, onTopOf, availableGas, availableBlobGas, toSkip)
 }
 
+// PeekBest is used by consumers outside of local block building, so it never returns private txns
 func (p *TxPool) PeekBest(ctx context.Context, n int, txns *TxnsRlp, onTopOf, availableGas, availableBlobGas uint64) (bool, error) {
 	set := mapset.NewThreadUnsafeSet[[32]byte]()
+	p.lock.Lock()
+	for hash := range p.privateTxns.maxBlocks {
+		set.Add([32]byte([]byte(hash)))
+	}
+	p.lock.Unlock()
 	onTime, _, err := p.YieldBest(ctx, n, txns, onTopOf, availableGas, availableBlobGas, set)
 	return onTime, err
 }
@@ -886,9 +1038,18 @@ func (p *TxPool) validateTx(txn *TxnSlot, isLocal bool, stateCache kvcache.Cache
 		if blobCount > p.GetMaxBlobsPerBlock() {
 			return txpoolcfg.TooManyBlobs
 		}
+		// EIP-7594: after Osaka blobs come with cell proofs instead of blob proofs
+		isOsaka := p.isOsaka()
+		if isOsaka != (txn.BlobWrapperVersion == types.BlobTxWrapperVersion1) {
+			return txpoolcfg.BlobWrapperVersion
+		}
+		proofsPerBlob := 1
+		if isOsaka {
+			proofsPerBlob = fixedgas.CellsPerExtBlob
+		}
 		equalNumber := len(txn.BlobHashes) == len(txn.Blobs) &&
 			len(txn.Blobs) == len(txn.Commitments) &&
-			len(txn.Commitments) == len(txn.Proofs)
+			len(txn.Commitments)*proofsPerBlob == len(txn.Proofs)
 
 		if !equalNumber {
 			return txpoolcfg.UnequalBlobTxExt
@@ -901,8 +1062,13 @@ func (p *TxPool) validateTx(txn *TxnSlot, isLocal bool, stateCache kvcache.Cache
 		}
 
 		// https://github.com/ethereum/consensus-specs/blob/017a8495f7671f5fff2075a9bfc9238c1a0982f8/specs/deneb/polynomial-commitments.md#verify_blob_kzg_proof_batch
-		kzgCtx := libkzg.Ctx()
-		err := kzgCtx.VerifyBlobKZGProofBatch(toBlobs(txn.Blobs), txn.Commitments, txn.Proofs)
+		// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/polynomial-commitments-sampling.md#verify_cell_kzg_proof_batch
+		var err error
+		if isOsaka {
+			err = libkzg.VerifyCellProofBatch(toBlobs(txn.Blobs), txn.Commitments, txn.Proofs)
+		} else {
+			err = libkzg.Ctx().VerifyBlobKZGProofBatch(toBlobs(txn.Blobs), txn.Commitments, txn.Proofs)
+		}
 		if err != nil {
 			return txpoolcfg.UnmatchedBlobTxExt
 		}
@@ -913,7 +1079,7 @@ func (p *TxPool) validateTx(txn *TxnSlot, isLocal bool, stateCache kvcache.Cache
 			}
 			return txpoolcfg.Spammer
 		}
-		if p.totalBlobsInPool.Load() >= p.cfg.TotalBlobPoolLimit {
+		if p.totalBlobsInPool.Load() >= p.cfg.TotalBlobPoolLimit && p.blobEvictionCandidatesLocked(txn) == nil {
 			if txn.Traced {
 				p.logger.Info(fmt.Sprintf("TX TRACING: validateTx total blobs limit reached in pool limit=%x current blobs=%d", p.cfg.TotalBlobPoolLimit, p.totalBlobsInPool.Load()))
 			}
@@ -1100,6 +1266,10 @@ func (p *TxPool) isPrague() bool {
 	return isTimeBasedForkActivated(&p.isPostPrague, p.pragueTime)
 }
 
+func (p *TxPool) isOsaka() bool {
+	return isTimeBasedForkActivated(&p.isPostOsaka, p.osakaTime)
+}
+
 func (p *TxPool) GetMaxBlobsPerBlock() uint64 {
 	return p.blobSchedule.MaxBlobsPerBlock(p.isPrague())
 }
@@ -1157,6 +1327,7 @@ func (p *TxPool) validateTxns(txns *TxnSlots, stateCache kvcache.CacheView) (rea
 			p.punishSpammer(txn.SenderID)
 		}
 		reasons[i] = reason
+		p.rejectedTxnEventLocked(txn, txns.Senders.At(i), reason)
 	}
 
 	goodTxns.Resize(uint(goodCount))
@@ -1217,6 +1388,10 @@ func fillDiscardReasons(reasons []txpoolcfg.DiscardReason, newTxns TxnSlots, dis
 }
 
 func (p *TxPool) AddLocalTxns(ctx context.Context, newTxns TxnSlots) ([]txpoolcfg.DiscardReason, error) {
+	return p.addLocalTxns(ctx, newTxns, false, 0)
+}
+
+func (p *TxPool) addLocalTxns(ctx context.Context, newTxns TxnSlots, private bool, privateMaxBlock uint64) ([]txpoolcfg.DiscardReason, error) {
 	coreDb, cache := p.chainDB()
 	coreTx, err := coreDb.BeginRo(ctx)
 	if err != nil {
@@ -1236,8 +1411,32 @@ func (p *TxPool) AddLocalTxns(ctx context.Context, newTxns TxnSlots) ([]txpoolcf
 		return nil, err
 	}
 
+	// must be marked before validating and adding, to not leak txn events and txns promoted to pending right away.
+	// Txns already in the pool may have been gossiped, so they can't be made private
+	var markedPrivate, knownPublic map[string]struct{}
+	if private {
+		markedPrivate = make(map[string]struct{}, len(newTxns.Txns))
+		for _, txn := range newTxns.Txns {
+			hashStr := string(txn.IDHash[:])
+			if _, ok := p.byHash[hashStr]; ok {
+				if !p.privateTxns.has(hashStr) {
+					if knownPublic == nil {
+						knownPublic = map[string]struct{}{}
+					}
+					knownPublic[hashStr] = struct{}{}
+				}
+				continue
+			}
+			p.privateTxns.add(hashStr, privateMaxBlock)
+			markedPrivate[hashStr] = struct{}{}
+		}
+	}
+
 	reasons, newTxns, err := p.validateTxns(&newTxns, cacheView)
 	if err != nil {
+		for hashStr := range markedPrivate {
+			p.privateTxns.remove(hashStr)
+		}
 		return nil, err
 	}
 
@@ -1245,17 +1444,28 @@ func (p *TxPool) AddLocalTxns(ctx context.Context, newTxns TxnSlots) ([]txpoolcf
 		p.pendingBaseFee.Load(), p.pendingBlobFee.Load(), p.blockGasLimit.Load(), true, p.logger)
 	if err == nil {
 		for i, reason := range addReasons {
+			if _, ok := knownPublic[string(newTxns.Txns[i].IDHash[:])]; ok {
+				reason = txpoolcfg.AlreadyPublic
+			}
 			if reason != txpoolcfg.NotSet {
 				reasons[i] = reason
 			}
 		}
 	} else {
+		for hashStr := range markedPrivate {
+			p.privateTxns.remove(hashStr)
+		}
 		return nil, err
 	}
 	p.promoted.Reset()
 	p.promoted.AppendOther(announcements)
 
 	reasons = fillDiscardReasons(reasons, newTxns, p.discardReasonsLRU)
+	for hashStr := range markedPrivate {
+		if _, ok := p.byHash[hashStr]; !ok {
+			p.privateTxns.remove(hashStr)
+		}
+	}
 	for i, reason := range reasons {
 		if reason == txpoolcfg.Success {
 			txn := newTxns.Txns[i]
@@ -1315,6 +1525,7 @@ func (p *TxPool) addTxns(blockNum uint64, cacheView kvcache.CacheView, senders *
 
 		if reason := p.addLocked(mt, &announcements); reason != txpoolcfg.NotSet {
 			discardReasons[i] = reason
+			p.rejectedTxnEventLocked(txn, newTxns.Senders.At(i), reason)
 			continue
 		}
 		discardReasons[i] = txpoolcfg.NotSet // unnecessary
@@ -1478,6 +1689,25 @@ func (p *TxPool) addLocked(mt *metaTxn, announcements *Announcements) txpoolcfg.
 		return txpoolcfg.FeeTooLow
 	}
 
+	// Make room in the full blob pool
+	if mt.TxnSlot.Type == BlobTxnType && found == nil && p.totalBlobsInPool.Load() >= p.cfg.TotalBlobPoolLimit {
+		evict := p.blobEvictionCandidatesLocked(mt.TxnSlot)
+		if evict == nil {
+			return txpoolcfg.BlobPoolOverflow
+		}
+		for _, evicted := range evict {
+			switch evicted.currentSubPool {
+			case PendingSubPool:
+				p.pending.Remove(evicted, "evict-blobs", p.logger)
+			case BaseFeeSubPool:
+				p.baseFee.Remove(evicted, "evict-blobs", p.logger)
+			case QueuedSubPool:
+				p.queued.Remove(evicted, "evict-blobs", p.logger)
+			}
+			p.discardLocked(evicted, txpoolcfg.BlobPoolEvicted)
+		}
+	}
+
 	// Check if we have txn with same authorization in the pool
 	if mt.TxnSlot.Type == SetCodeTxnType {
 		numAuths := len(mt.TxnSlot.AuthRaw)
@@ -1514,6 +1744,8 @@ func (p *TxPool) addLocked(mt *metaTxn, announcements *Announcements) txpoolcfg.
 
 	hashStr := string(mt.TxnSlot.IDHash[:])
 	p.byHash[hashStr] = mt
+	p.arrivals++
+	mt.arrival = p.arrivals
 
 	if replaced := p.all.replaceOrInsert(mt, p.logger); replaced != nil {
 		if assert.Enable {
@@ -1523,18 +1755,24 @@ func (p *TxPool) addLocked(mt *metaTxn, announcements *Announcements) txpoolcfg.
 
 	if mt.subPool&IsLocal != 0 {
 		p.isLocalLRU.Add(hashStr, struct{}{})
+		p.journalLocked(mt)
 	}
 	// All transactions are first added to the queued pool and then immediately promoted from there if required
 	p.queued.Add(mt, "addLocked", p.logger)
+	p.txnEventLocked(mt, TxnEventAdded, QueuedSubPool, txpoolcfg.NotSet)
 	if mt.TxnSlot.Type == BlobTxnType {
 		t := p.totalBlobsInPool.Load()
 		p.totalBlobsInPool.Store(t + (uint64(len(mt.TxnSlot.BlobHashes))))
+		if mt.subPool&IsLocal == 0 {
+			p.remoteBlobTxns.ReplaceOrInsert(mt)
+		}
 		for i, b := range mt.TxnSlot.BlobHashes {
 			p.blobHashToTxn[b] = struct {
 				index   int
 				txnHash common.Hash
 			}{i, mt.TxnSlot.IDHash}
 		}
+		p.storeBlobsLocked(mt.TxnSlot)
 	}
 
 	// Remove from mined cache as we are now "resurrecting" it to a sub-pool
@@ -1550,9 +1788,24 @@ func (p *TxPool) discardLocked(mt *metaTxn, reason txpoolcfg.DiscardReason) {
 	p.deletedTxns = append(p.deletedTxns, mt)
 	p.all.delete(mt, reason, p.logger)
 	p.discardReasonsLRU.Add(hashStr, reason)
+	p.discardTxnEventLocked(mt, reason)
+	if releasesJournaled(reason) {
+		p.localJournal.remove(hashStr)
+	}
+	p.privateTxns.remove(hashStr)
 	if mt.TxnSlot.Type == BlobTxnType {
 		t := p.totalBlobsInPool.Load()
 		p.totalBlobsInPool.Store(t - uint64(len(mt.TxnSlot.BlobHashes)))
+		p.remoteBlobTxns.Delete(mt)
+		for _, b := range mt.TxnSlot.BlobHashes {
+			if th, ok := p.blobHashToTxn[b]; ok && th.txnHash == mt.TxnSlot.IDHash {
+				delete(p.blobHashToTxn, b)
+			}
+		}
+		// sidecars of mined txns are kept until finalization, in case of reorg
+		if reason != txpoolcfg.Mined {
+			p.removeBlobsLocked(mt.TxnSlot.IDHash)
+		}
 	}
 	if mt.TxnSlot.Type == SetCodeTxnType {
 		numAuths := len(mt.TxnSlot.AuthRaw)
@@ -1568,28 +1821,166 @@ func (p *TxPool) discardLocked(mt *metaTxn, reason txpoolcfg.DiscardReason) {
 	}
 }
 
-func (p *TxPool) getBlobsAndProofByBlobHashLocked(blobHashes []common.Hash) ([][]byte, [][]byte) {
+// blobEvictionCandidatesLocked returns blob txns to evict from the full blob pool to make room for the given blob txn,
+// or nil if it's not possible. Blob txns are evicted by blob fee cap, not by tip: when blob base fee rises,
+// the ones with the lowest blob fee cap are the first to become non-includable. Only remote txns with lower blob fee cap
+// than the new txn are evicted, and only the highest nonce txn of a sender - to not leave nonce gaps.
+func (p *TxPool) blobEvictionCandidatesLocked(txn *TxnSlot) []*metaTxn {
+	total, limit := p.totalBlobsInPool.Load(), p.cfg.TotalBlobPoolLimit
+	if total+uint64(len(txn.BlobHashes)) <= limit {
+		return nil
+	}
+	needed := total + uint64(len(txn.BlobHashes)) - limit
+
+	var candidates []*metaTxn
+	var freed uint64
+	p.remoteBlobTxns.Ascend(func(mt *metaTxn) bool {
+		if !mt.TxnSlot.BlobFeeCap.Lt(&txn.BlobFeeCap) {
+			return false
+		}
+		if mt.TxnSlot.SenderID == txn.SenderID || p.all.get(mt.TxnSlot.SenderID, mt.TxnSlot.Nonce+1) != nil {
+			return true
+		}
+		candidates = append(candidates, mt)
+		freed += uint64(len(mt.TxnSlot.BlobHashes))
+		return freed < needed
+	})
+	if freed < needed {
+		return nil
+	}
+	return candidates
+}
+
+// blobEvictionLess orders blob txns by blob fee cap, then by tip, the first ones are the first to evict
+func blobEvictionLess(a, b *metaTxn) bool {
+	if c := a.TxnSlot.BlobFeeCap.Cmp(&b.TxnSlot.BlobFeeCap); c != 0 {
+		return c < 0
+	}
+	if c := a.TxnSlot.Tip.Cmp(&b.TxnSlot.Tip); c != 0 {
+		return c < 0
+	}
+	return SortByNonceLess(a, b)
+}
+
+// storeBlobsLocked moves the sidecar of a blob txn from memory to the blob store, the txn itself is kept without the wrapper
+func (p *TxPool) storeBlobsLocked(txn *TxnSlot) {
+	if len(txn.Blobs) == 0 {
+		return
+	}
+	if txn.Rlp != nil && isWrappedBlobTxnRlp(txn.Rlp) {
+		unwrapped, err := unwrapBlobTxnRlp(txn.Rlp)
+		if err != nil {
+			p.logger.Warn("[txpool] failed to store blobs, keeping them in memory", "hash", hex.EncodeToString(txn.IDHash[:]), "err", err)
+			return
+		}
+		txn.Rlp = unwrapped
+	}
+	p.blobStore.put(txn.IDHash, blobSidecarOf(txn))
+	p.blobStoreChangedLocked()
+	txn.Blobs, txn.Commitments, txn.Proofs = nil, nil, nil
+}
+
+// loadBlobs reads the sidecar of a blob txn back to memory, for the txn to be validated again
+func (p *TxPool) loadBlobs(txn *TxnSlot) (bool, error) {
+	sidecar, err := p.blobStore.get(txn.IDHash)
+	if err != nil || sidecar == nil {
+		return false, err
+	}
+	txn.BlobWrapperVersion, txn.Blobs, txn.Commitments, txn.Proofs = sidecar.version, sidecar.blobs, sidecar.commitments, sidecar.proofs
+	return true, nil
+}
+
+func (p *TxPool) removeBlobsLocked(txnHash common.Hash) {
+	p.blobStore.delete(txnHash)
+	p.blobStoreChangedLocked()
+}
+
+func (p *TxPool) blobStoreChangedLocked() {
+	select {
+	case p.blobStoreReady <- struct{}{}:
+	default:
+	}
+}
+
+// flushBlobStore writes and removes sidecars queued by the pool, outside of the pool lock
+func (p *TxPool) flushBlobStore() {
+	if err := p.blobStore.flush(); err != nil {
+		p.logger.Warn("[txpool] failed to flush blobs, will retry", "err", err)
+	}
+}
+
+// blobSidecarsByBlobHash returns the sidecar and the index in it of each of the given blobs, nil sidecar for unknown blobs.
+// Sidecars are read outside of the pool lock.
+func (p *TxPool) blobSidecarsByBlobHash(blobHashes []common.Hash) ([]*blobSidecar, []int) {
+	txnHashes := make([]common.Hash, len(blobHashes))
+	indices := make([]int, len(blobHashes))
+	found := make([]bool, len(blobHashes))
 	p.lock.Lock()
-	defer p.lock.Unlock()
-	blobs := make([][]byte, len(blobHashes))
-	proofs := make([][]byte, len(blobHashes))
 	for i, h := range blobHashes {
 		th, ok := p.blobHashToTxn[h]
 		if !ok {
 			continue
 		}
-		mt, ok := p.byHash[string(th.txnHash[:])]
-		if !ok || mt == nil {
+		if mt, ok := p.byHash[string(th.txnHash[:])]; !ok || mt == nil {
 			continue
 		}
-		blobs[i] = mt.TxnSlot.Blobs[th.index]
-		proofs[i] = mt.TxnSlot.Proofs[th.index][:]
+		txnHashes[i], indices[i], found[i] = th.txnHash, th.index, true
 	}
-	return blobs, proofs
+	p.lock.Unlock()
+
+	sidecars := make([]*blobSidecar, len(blobHashes))
+	byTxnHash := map[common.Hash]*blobSidecar{}
+	for i, txnHash := range txnHashes {
+		if !found[i] {
+			continue
+		}
+		sidecar, ok := byTxnHash[txnHash]
+		if !ok {
+			var err error
+			if sidecar, err = p.blobStore.get(txnHash); err != nil {
+				p.logger.Warn("[txpool] failed to read blobs", "hash", hex.EncodeToString(txnHash[:]), "err", err)
+			}
+			byTxnHash[txnHash] = sidecar
+		}
+		if sidecar == nil || indices[i] >= len(sidecar.blobs) {
+			continue
+		}
+		sidecars[i] = sidecar
+	}
+	return sidecars, indices
 }
 
+// GetBlobs serves engine_getBlobsV1: blob proofs are known only for txns with EIP-4844 wrapper
 func (p *TxPool) GetBlobs(blobHashes []common.Hash) ([][]byte, [][]byte) {
-	return p.getBlobsAndProofByBlobHashLocked(blobHashes)
+	sidecars, indices := p.blobSidecarsByBlobHash(blobHashes)
+	blobs := make([][]byte, len(blobHashes))
+	proofs := make([][]byte, len(blobHashes))
+	for i, sidecar := range sidecars {
+		if sidecar == nil || sidecar.version != types.BlobTxWrapperVersion0 {
+			continue
+		}
+		blobs[i] = sidecar.blobs[indices[i]]
+		proofs[i] = sidecar.proofs[indices[i]][:]
+	}
+	return blobs, proofs
+}
+
+// GetBlobsAndCellProofs serves engine_getBlobsV2: cell proofs are known only for txns with EIP-7594 wrapper
+func (p *TxPool) GetBlobsAndCellProofs(blobHashes []common.Hash) ([][]byte, [][][]byte) {
+	sidecars, indices := p.blobSidecarsByBlobHash(blobHashes)
+	blobs := make([][]byte, len(blobHashes))
+	cellProofs := make([][][]byte, len(blobHashes))
+	for i, sidecar := range sidecars {
+		if sidecar == nil || sidecar.version != types.BlobTxWrapperVersion1 {
+			continue
+		}
+		blobs[i] = sidecar.blobs[indices[i]]
+		cellProofs[i] = make([][]byte, fixedgas.CellsPerExtBlob)
+		for j := range cellProofs[i] {
+			cellProofs[i][j] = sidecar.proofs[indices[i]*fixedgas.CellsPerExtBlob+j][:]
+		}
+	}
+	return blobs, cellProofs
 }
 
 // Cache recently mined blobs in anticipation of reorg, delete finalized ones
@@ -1600,6 +1991,9 @@ func (p *TxPool) processMinedFinalizedBlobs(minedTxns []*TxnSlot, finalizedBlock
 		// delete individual hashes
 		for _, mt := range p.minedBlobTxnsByBlock[finalizedBlock] {
 			delete(p.minedBlobTxnsByHash, string(mt.TxnSlot.IDHash[:]))
+			if _, ok := p.byHash[string(mt.TxnSlot.IDHash[:])]; !ok {
+				p.removeBlobsLocked(mt.TxnSlot.IDHash)
+			}
 		}
 		// delete the map entry for this block num
 		delete(p.minedBlobTxnsByBlock, finalizedBlock)
@@ -1833,8 +2227,11 @@ func (p *TxPool) promote(pendingBaseFee uint64, pendingBlobFee uint64, announcem
 			tx := p.pending.PopWorst()
 			announcements.Append(tx.TxnSlot.Type, tx.TxnSlot.Size, tx.TxnSlot.IDHash[:])
 			p.baseFee.Add(tx, "demote-pending", logger)
+			p.txnEventLocked(tx, TxnEventDemoted, BaseFeeSubPool, txpoolcfg.NotSet)
 		} else {
-			p.queued.Add(p.pending.PopWorst(), "demote-pending", logger)
+			tx := p.pending.PopWorst()
+			p.queued.Add(tx, "demote-pending", logger)
+			p.txnEventLocked(tx, TxnEventDemoted, QueuedSubPool, txpoolcfg.NotSet)
 		}
 	}
 
@@ -1843,11 +2240,14 @@ func (p *TxPool) promote(pendingBaseFee uint64, pendingBlobFee uint64, announcem
 		tx := p.baseFee.PopBest()
 		announcements.Append(tx.TxnSlot.Type, tx.TxnSlot.Size, tx.TxnSlot.IDHash[:])
 		p.pending.Add(tx, logger)
+		p.txnEventLocked(tx, TxnEventPromoted, PendingSubPool, txpoolcfg.NotSet)
 	}
 
 	// Demote worst transactions that do not qualify for base fee pool anymore, to queued sub pool, or discard
 	for worst := p.baseFee.Worst(); p.baseFee.Len() > 0 && worst.subPool < BaseFeePoolBits; worst = p.baseFee.Worst() {
-		p.queued.Add(p.baseFee.PopWorst(), "demote-base", logger)
+		tx := p.baseFee.PopWorst()
+		p.queued.Add(tx, "demote-base", logger)
+		p.txnEventLocked(tx, TxnEventDemoted, QueuedSubPool, txpoolcfg.NotSet)
 	}
 
 	// Promote best transactions from the queued pool to either pending or base fee pool, while they qualify
@@ -1856,27 +2256,60 @@ func (p *TxPool) promote(pendingBaseFee uint64, pendingBlobFee uint64, announcem
 			tx := p.queued.PopBest()
 			announcements.Append(tx.TxnSlot.Type, tx.TxnSlot.Size, tx.TxnSlot.IDHash[:])
 			p.pending.Add(tx, logger)
+			p.txnEventLocked(tx, TxnEventPromoted, PendingSubPool, txpoolcfg.NotSet)
 		} else {
-			p.baseFee.Add(p.queued.PopBest(), "promote-queued", logger)
+			tx := p.queued.PopBest()
+			p.baseFee.Add(tx, "promote-queued", logger)
+			p.txnEventLocked(tx, TxnEventPromoted, BaseFeeSubPool, txpoolcfg.NotSet)
 		}
 	}
 
 	// Discard worst transactions from the queued sub pool if they do not qualify
 	// <FUNCTIONALITY REMOVED>
 
+	// Journaled local transactions count towards the limits, but are never evicted on overflow - they are put back
+	// after the limits are enforced on the rest of the sub pool
+	var retained []*metaTxn
+
 	// Discard worst transactions from pending pool until it is within capacity limit
-	for p.pending.Len() > p.pending.limit {
-		p.discardLocked(p.pending.PopWorst(), txpoolcfg.PendingPoolOverflow)
+	for p.pending.Len() > 0 && p.pending.Len()+len(retained) > p.pending.limit {
+		worst := p.pending.PopWorst()
+		if p.localJournal.has(string(worst.TxnSlot.IDHash[:])) {
+			retained = append(retained, worst)
+			continue
+		}
+		p.discardLocked(worst, txpoolcfg.PendingPoolOverflow)
+	}
+	for _, mt := range retained {
+		p.pending.Add(mt, logger)
 	}
+	retained = retained[:0]
 
 	// Discard worst transactions from pending sub pool until it is within capacity limits
-	for p.baseFee.Len() > p.baseFee.limit {
-		p.discardLocked(p.baseFee.PopWorst(), txpoolcfg.BaseFeePoolOverflow)
+	for p.baseFee.Len() > 0 && p.baseFee.Len()+len(retained) > p.baseFee.limit {
+		worst := p.baseFee.PopWorst()
+		if p.localJournal.has(string(worst.TxnSlot.IDHash[:])) {
+			retained = append(retained, worst)
+			continue
+		}
+		p.discardLocked(worst, txpoolcfg.BaseFeePoolOverflow)
+	}
+	for _, mt := range retained {
+		p.baseFee.Add(mt, "retain-local", logger)
 	}
+	retained = retained[:0]
 
 	// Discard worst transactions from the queued sub pool until it is within its capacity limits
-	for _ = p.queued.Worst(); p.queued.Len() > p.queued.limit; _ = p.queued.Worst() {
-		p.discardLocked(p.queued.PopWorst(), txpoolcfg.QueuedPoolOverflow)
+	for p.queued.Len() > 0 && p.queued.Len()+len(retained) > p.queued.limit {
+		worst := p.queued.PopWorst()
+		if p.localJournal.has(string(worst.TxnSlot.IDHash[:])) {
+			retained = append(retained, worst)
+			continue
+		}
+		p.discardLocked(worst, txpoolcfg.QueuedPoolOverflow)
+	}
+	for _, mt := range retained {
+		p.queued.Add(mt, "retain-local", logger)
 	}
 }
 
@@ -1902,6 +2335,12 @@ func (p *TxPool) Run(ctx context.Context) error {
 	defer commitEvery.Stop()
 	logEvery := time.NewTicker(p.cfg.LogEvery)
 	defer logEvery.Stop()
+	var rebroadcastLocalsEvery <-chan time.Time
+	if p.cfg.RebroadcastLocalsEvery > 0 {
+		t := time.NewTicker(p.cfg.RebroadcastLocalsEvery)
+		defer t.Stop()
+		rebroadcastLocalsEvery = t.C
+	}
 
 	if err := p.start(ctx); err != nil {
 		p.logger.Error("[txpool] Failed to start", "err", err)
@@ -1919,6 +2358,11 @@ func (p *TxPool) Run(ctx context.Context) error {
 			return err
 		case <-logEvery.C:
 			p.logStats()
+		case <-rebroadcastLocalsEvery:
+			if !p.Started() || p.cfg.NoGossip {
+				continue
+			}
+			go p.rebroadcastLocals()
 		case <-processRemoteTxnsEvery.C:
 			if !p.Started() {
 				continue
@@ -1985,6 +2429,9 @@ func (p *TxPool) Run(ctx context.Context) error {
 				if err := p.poolDB.View(ctx, func(tx kv.Tx) error {
 					for i := 0; i < announcements.Len(); i++ {
 						t, size, hash := announcements.At(i)
+						if p.IsPrivate(hash) {
+							continue
+						}
 						slotRlp, err := p.GetRlp(tx, hash)
 						if err != nil {
 							return err
@@ -2031,7 +2478,6 @@ func (p *TxPool) Run(ctx context.Context) error {
 				}
 
 				// broadcast local transactions
-				const localTxnsBroadcastMaxPeers uint64 = 10
 				txnSentTo := p.p2pSender.BroadcastPooledTxns(localTxnRlps, localTxnsBroadcastMaxPeers)
 				for i, peer := range txnSentTo {
 					p.logger.Trace("Local txn broadcast", "txHash", hex.EncodeToString(broadcastHashes.At(i)), "to peer", peer)
@@ -2047,6 +2493,10 @@ func (p *TxPool) Run(ctx context.Context) error {
 				p.p2pSender.BroadcastPooledTxns(remoteTxnRlps, remoteTxnsBroadcastMaxPeers)
 				p.p2pSender.AnnouncePooledTxns(remoteTxnTypes, remoteTxnSizes, remoteTxnHashes, remoteTxnsBroadcastMaxPeers*2)
 			}()
+		case <-p.txnEventsReady:
+			p.publishTxnEvents()
+		case <-p.blobStoreReady:
+			p.flushBlobStore()
 		case <-syncToNewPeersEvery.C: // new peer
 			newPeers := p.recentlyConnectedPeers.GetAndClean()
 			if len(newPeers) == 0 {
@@ -2092,6 +2542,8 @@ func (p *TxPool) flush(ctx context.Context) (written uint64, err error) {
 	defer writeToDBTimer.ObserveDuration(time.Now())
 	// 1. get global lock on txpool and flush it to db, without fsync (to release lock asap)
 	// 2. then fsync db without txpool lock
+	// Sidecars go first, for txns saved to the db to have them.
+	p.flushBlobStore()
 	written, err = p.flushNoFsync(ctx)
 	if err != nil {
 		return 0, err
@@ -2153,6 +2605,12 @@ func (p *TxPool) flushLocked(tx kv.RwTx) (err error) {
 			return err
 		}
 	}
+	if err := p.localJournal.flush(tx); err != nil {
+		return err
+	}
+	if err := p.privateTxns.flush(tx); err != nil {
+		return err
+	}
 
 	v := make([]byte, 0, 1024)
 	for txHash, metaTx := range p.byHash {
@@ -2242,6 +2700,12 @@ func (p *TxPool) fromDB(ctx context.Context, tx kv.Tx, coreTx kv.Tx) error {
 		}
 		p.isLocalLRU.Add(string(v), struct{}{})
 	}
+	if err := p.localJournal.load(tx); err != nil {
+		return err
+	}
+	if err := p.privateTxns.load(tx); err != nil {
+		return err
+	}
 
 	txns := TxnSlots{}
 	parseCtx := NewTxnParseContext(p.chainID)
@@ -2260,14 +2724,27 @@ func (p *TxPool) fromDB(ctx context.Context, tx kv.Tx, coreTx kv.Tx) error {
 		addr, txnRlp := *(*[20]byte)(v[:20]), v[20:]
 		txn := &TxnSlot{}
 
-		// TODO(eip-4844) ensure wrappedWithBlobs when transactions are saved to the DB
-		_, err = parseCtx.ParseTransaction(txnRlp, 0, txn, nil, false /* hasEnvelope */, true /*wrappedWithBlobs*/, nil)
+		// blob txns are saved to the DB without the wrapper, their sidecars are in the blob store
+		wrappedWithBlobs := isWrappedBlobTxnRlp(txnRlp)
+		if wrappedWithBlobs {
+			txnRlp = common.Copy(txnRlp) // parsed blobs point into it
+		}
+		_, err = parseCtx.ParseTransaction(txnRlp, 0, txn, nil, false /* hasEnvelope */, wrappedWithBlobs, nil)
 		if err != nil {
 			err = fmt.Errorf("err: %w, rlp: %x", err, txnRlp)
 			p.logger.Warn("[txpool] fromDB: parseTransaction", "err", err)
 			continue
 		}
 		txn.Rlp = nil // means that we don't need store it in db anymore
+		if txn.Type == BlobTxnType {
+			if wrappedWithBlobs {
+				// saved by an older version: the sidecar is moved to the blob store and the txn is saved again without it
+				txn.Rlp = txnRlp
+			} else if found, err := p.loadBlobs(txn); err != nil || !found {
+				p.logger.Warn("[txpool] fromDB: blobs of txn not found", "hash", hex.EncodeToString(k), "err", err)
+				continue
+			}
+		}
 
 		txn.SenderID, txn.Traced = p.senders.getOrCreateID(addr, p.logger)
 		isLocalTx := p.isLocalLRU.Contains(string(k))
@@ -2329,9 +2806,23 @@ func (p *TxPool) fromDB(ctx context.Context, tx kv.Tx, coreTx kv.Tx) error {
 		pendingBaseFee, pendingBlobFee, blockGasLimit, false, p.logger); err != nil {
 		return err
 	}
+	// journaled txns are validated against the current block gas limit
 	p.pendingBaseFee.Store(pendingBaseFee)
 	p.pendingBlobFee.Store(pendingBlobFee)
 	p.blockGasLimit.Store(blockGasLimit)
+	if err := p.restoreJournaled(cacheView, pendingBaseFee, pendingBlobFee, blockGasLimit); err != nil {
+		return err
+	}
+	pruned, err := p.blobStore.prune(func(txnHash common.Hash) bool {
+		_, ok := p.byHash[string(txnHash[:])]
+		return ok
+	})
+	if err != nil {
+		return err
+	}
+	if pruned > 0 {
+		p.logger.Info("[txpool] pruned blob sidecars of unknown txns", "count", pruned)
+	}
 	return nil
 }
 
@@ -2382,6 +2873,9 @@ func (p *TxPool) deprecatedForEach(_ context.Context, f func(rlp []byte, sender
 	defer p.lock.Unlock()
 	p.all.ascendAll(func(mt *metaTxn) bool {
 		slot := mt.TxnSlot
+		if p.privateTxns.has(string(slot.IDHash[:])) {
+			return true
+		}
 		slotRlp := slot.Rlp
 		if slot.Rlp == nil {
 			v, err := tx.GetOne(kv.PoolTransaction, slot.IDHash[:])
@@ -2395,6 +2889,13 @@ func (p *TxPool) deprecatedForEach(_ context.Context, f func(rlp []byte, sender
 			}
 			slotRlp = v[20:]
 		}
+		if isWrappedBlobTxnRlp(slotRlp) { // sidecar wasn't moved to the blob store
+			var err error
+			if slotRlp, err = unwrapBlobTxnRlp(slotRlp); err != nil {
+				p.logger.Warn("[txpool] foreach: unwrap blob txn", "err", err)
+				return true
+			}
+		}
 		if sender, found := p.senders.senderID2Addr[slot.SenderID]; found {
 			f(slotRlp, sender, mt.currentSubPool)
 		}
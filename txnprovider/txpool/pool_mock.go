@@ -119,6 +119,45 @@ func (c *MockPoolAddNewGoodPeerCall) DoAndReturn(f func(PeerID)) *MockPoolAddNew
 	return c
 }
 
+// AddPrivateTxns mocks base method.
+func (m *MockPool) AddPrivateTxns(ctx context.Context, newTxns TxnSlots, maxBlock uint64) ([]txpoolcfg.DiscardReason, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPrivateTxns", ctx, newTxns, maxBlock)
+	ret0, _ := ret[0].([]txpoolcfg.DiscardReason)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPrivateTxns indicates an expected call of AddPrivateTxns.
+func (mr *MockPoolMockRecorder) AddPrivateTxns(ctx, newTxns, maxBlock any) *MockPoolAddPrivateTxnsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPrivateTxns", reflect.TypeOf((*MockPool)(nil).AddPrivateTxns), ctx, newTxns, maxBlock)
+	return &MockPoolAddPrivateTxnsCall{Call: call}
+}
+
+// MockPoolAddPrivateTxnsCall wrap *gomock.Call
+type MockPoolAddPrivateTxnsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPoolAddPrivateTxnsCall) Return(arg0 []txpoolcfg.DiscardReason, arg1 error) *MockPoolAddPrivateTxnsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPoolAddPrivateTxnsCall) Do(f func(context.Context, TxnSlots, uint64) ([]txpoolcfg.DiscardReason, error)) *MockPoolAddPrivateTxnsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPoolAddPrivateTxnsCall) DoAndReturn(f func(context.Context, TxnSlots, uint64) ([]txpoolcfg.DiscardReason, error)) *MockPoolAddPrivateTxnsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // AddRemoteTxns mocks base method.
 func (m *MockPool) AddRemoteTxns(ctx context.Context, newTxns TxnSlots) {
 	m.ctrl.T.Helper()
@@ -233,6 +272,45 @@ func (c *MockPoolGetBlobsCall) DoAndReturn(f func([]common.Hash) ([][]byte, [][]
 	return c
 }
 
+// GetBlobsAndCellProofs mocks base method.
+func (m *MockPool) GetBlobsAndCellProofs(blobhashes []common.Hash) ([][]byte, [][][]byte) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlobsAndCellProofs", blobhashes)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].([][][]byte)
+	return ret0, ret1
+}
+
+// GetBlobsAndCellProofs indicates an expected call of GetBlobsAndCellProofs.
+func (mr *MockPoolMockRecorder) GetBlobsAndCellProofs(blobhashes any) *MockPoolGetBlobsAndCellProofsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlobsAndCellProofs", reflect.TypeOf((*MockPool)(nil).GetBlobsAndCellProofs), blobhashes)
+	return &MockPoolGetBlobsAndCellProofsCall{Call: call}
+}
+
+// MockPoolGetBlobsAndCellProofsCall wrap *gomock.Call
+type MockPoolGetBlobsAndCellProofsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPoolGetBlobsAndCellProofsCall) Return(arg0 [][]byte, arg1 [][][]byte) *MockPoolGetBlobsAndCellProofsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPoolGetBlobsAndCellProofsCall) Do(f func([]common.Hash) ([][]byte, [][][]byte)) *MockPoolGetBlobsAndCellProofsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPoolGetBlobsAndCellProofsCall) DoAndReturn(f func([]common.Hash) ([][]byte, [][][]byte)) *MockPoolGetBlobsAndCellProofsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetRlp mocks base method.
 func (m *MockPool) GetRlp(tx kv.Tx, hash []byte) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -311,6 +389,44 @@ func (c *MockPoolIdHashKnownCall) DoAndReturn(f func(kv.Tx, []byte) (bool, error
 	return c
 }
 
+// IsPrivate mocks base method.
+func (m *MockPool) IsPrivate(idHash []byte) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPrivate", idHash)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsPrivate indicates an expected call of IsPrivate.
+func (mr *MockPoolMockRecorder) IsPrivate(idHash any) *MockPoolIsPrivateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPrivate", reflect.TypeOf((*MockPool)(nil).IsPrivate), idHash)
+	return &MockPoolIsPrivateCall{Call: call}
+}
+
+// MockPoolIsPrivateCall wrap *gomock.Call
+type MockPoolIsPrivateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPoolIsPrivateCall) Return(arg0 bool) *MockPoolIsPrivateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPoolIsPrivateCall) Do(f func([]byte) bool) *MockPoolIsPrivateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPoolIsPrivateCall) DoAndReturn(f func([]byte) bool) *MockPoolIsPrivateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // OnNewBlock mocks base method.
 func (m *MockPool) OnNewBlock(ctx context.Context, stateChanges *remoteproto.StateChangeBatch, unwindTxns, unwindBlobTxns, minedTxns TxnSlots) error {
 	m.ctrl.T.Helper()
@@ -22,11 +22,14 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"slices"
 	"testing"
 
 	"github.com/erigontech/erigon-lib/state"
 	accounts3 "github.com/erigontech/erigon-lib/types/accounts"
 
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -40,6 +43,7 @@ import (
 	"github.com/erigontech/erigon-lib/crypto/kzg"
 	"github.com/erigontech/erigon-lib/gointerfaces"
 	remote "github.com/erigontech/erigon-lib/gointerfaces/remoteproto"
+	"github.com/erigontech/erigon-lib/gointerfaces/txpoolproto"
 	"github.com/erigontech/erigon-lib/kv"
 	"github.com/erigontech/erigon-lib/kv/kvcache"
 	"github.com/erigontech/erigon-lib/kv/memdb"
@@ -60,7 +64,7 @@ func TestNonceFromAddress(t *testing.T) {
 	db := memdb.NewTestPoolDB(t)
 	cfg := txpoolcfg.DefaultConfig
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
+	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
 	assert.NoError(err)
 	require.True(pool != nil)
 	var stateVersionID uint64 = 0
@@ -186,7 +190,7 @@ func TestMultipleAuthorizations(t *testing.T) {
 
 	cfg := txpoolcfg.DefaultConfig
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, common.Big0 /* shanghaiTime */, nil /* agraBlock */, common.Big0 /* cancunTime */, common.Big0 /* pragueTime */, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
+	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, common.Big0 /* shanghaiTime */, nil /* agraBlock */, common.Big0 /* cancunTime */, common.Big0 /* pragueTime */, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
 	assert.NoError(t, err)
 	require.True(t, pool != nil)
 
@@ -444,7 +448,7 @@ func TestReplaceWithHigherFee(t *testing.T) {
 	t.Cleanup(cancel)
 	cfg := txpoolcfg.DefaultConfig
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
+	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
 	assert.NoError(err)
 	require.NotEqual(nil, pool)
 	var stateVersionID uint64 = 0
@@ -567,7 +571,7 @@ func TestReverseNonces(t *testing.T) {
 	t.Cleanup(cancel)
 	cfg := txpoolcfg.DefaultConfig
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
+	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
 	assert.NoError(err)
 	require.True(pool != nil)
 	var stateVersionID uint64 = 0
@@ -697,7 +701,7 @@ func TestTxnPoke(t *testing.T) {
 	t.Cleanup(cancel)
 	cfg := txpoolcfg.DefaultConfig
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
+	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
 	assert.NoError(err)
 	require.True(pool != nil)
 	var stateVersionID uint64 = 0
@@ -934,7 +938,7 @@ func TestShanghaiValidateTxn(t *testing.T) {
 			asrt.NoError(err)
 			defer sd.Close()
 			cache := kvcache.NewDummy()
-			pool, err := New(ctx, ch, nil, coreDB, cfg, cache, *u256.N1, shanghaiTime, nil /* agraBlock */, nil /* cancunTime */, nil, nil, nil, nil, func() {}, nil, logger, WithFeeCalculator(nil))
+			pool, err := New(ctx, ch, nil, coreDB, cfg, cache, *u256.N1, shanghaiTime, nil /* agraBlock */, nil /* cancunTime */, nil, nil, nil, nil, nil, func() {}, nil, logger, WithFeeCalculator(nil))
 			asrt.NoError(err)
 
 			sndr := accounts3.Account{Nonce: 0, Balance: *uint256.NewInt(math.MaxUint64)}
@@ -980,7 +984,7 @@ func TestTooHighGasLimitTxnValidation(t *testing.T) {
 	t.Cleanup(cancel)
 	cfg := txpoolcfg.DefaultConfig
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
+	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
 	assert.NoError(err)
 	require.True(pool != nil)
 	var stateVersionID uint64 = 0
@@ -1046,7 +1050,7 @@ func TestSetCodeTxnValidationWithLargeAuthorizationValues(t *testing.T) {
 	cache := kvcache.NewDummy()
 	logger := log.New()
 	pool, err := New(ctx, ch, nil, coreDB, cfg, cache, chainID, common.Big0 /* shanghaiTime */, nil, /* agraBlock */
-		common.Big0 /* cancunTime */, common.Big0 /* pragueTime */, nil, nil, nil, func() {}, nil, logger, WithFeeCalculator(nil))
+		common.Big0 /* cancunTime */, common.Big0 /* pragueTime */, nil, nil, nil, nil, func() {}, nil, logger, WithFeeCalculator(nil))
 	assert.NoError(t, err)
 	pool.blockGasLimit.Store(30_000_000)
 	tx, err := coreDB.BeginRw(ctx)
@@ -1099,7 +1103,7 @@ func TestBlobTxnReplacement(t *testing.T) {
 	t.Cleanup(cancel)
 	cfg := txpoolcfg.DefaultConfig
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, common.Big0, nil, common.Big0, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
+	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, common.Big0, nil, common.Big0, nil, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
 	assert.NoError(err)
 
 	require.True(pool != nil)
@@ -1283,7 +1287,7 @@ func TestDropRemoteAtNoGossip(t *testing.T) {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	t.Cleanup(cancel)
-	txnPool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, nil, func() {}, nil, logger, WithFeeCalculator(nil))
+	txnPool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, nil, nil, func() {}, nil, logger, WithFeeCalculator(nil))
 	assert.NoError(err)
 	require.True(txnPool != nil)
 
@@ -1394,7 +1398,7 @@ func TestBlobSlots(t *testing.T) {
 	cfg.TotalBlobPoolLimit = 20
 
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, common.Big0, nil, common.Big0, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
+	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, common.Big0, nil, common.Big0, nil, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
 	assert.NoError(err)
 	require.True(pool != nil)
 	var stateVersionID uint64 = 0
@@ -1465,6 +1469,182 @@ func TestBlobSlots(t *testing.T) {
 	}
 }
 
+// TestBlobCellProofs - after Osaka blob txns come with cell proofs, which are verified before the txn is admitted
+func TestBlobCellProofs(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ch := make(chan Announcements, 5)
+	coreDB, _ := temporaltest.NewTestDB(t, datadir.New(t.TempDir()))
+	db := memdb.NewTestPoolDB(t)
+	cfg := txpoolcfg.DefaultConfig
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
+	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, common.Big0, nil, common.Big0, common.Big0, common.Big0, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
+	require.NoError(err)
+	require.NoError(pool.start(ctx))
+
+	var addr [20]byte
+	addr[0] = 1
+	acc := accounts3.Account{Nonce: 0, Balance: *uint256.NewInt(1 * common.Ether), Incarnation: 1}
+	change := &remote.StateChangeBatch{
+		PendingBlockBaseFee:  200_000,
+		BlockGasLimit:        math.MaxUint64,
+		PendingBlobFeePerGas: 100_000,
+		ChangeBatch: []*remote.StateChange{{
+			BlockHeight: 0,
+			BlockHash:   gointerfaces.ConvertHashToH256([32]byte{}),
+			Changes: []*remote.AccountChange{{
+				Action:  remote.Action_UPSERT,
+				Address: gointerfaces.ConvertAddressToH160(addr),
+				Data:    accounts3.SerialiseV3(&acc),
+			}},
+		}},
+	}
+	require.NoError(pool.OnNewBlock(ctx, change, TxnSlots{}, TxnSlots{}, TxnSlots{}))
+
+	// Computing cell proofs is slow, so the blobs are zero: their commitments and cell proofs are all the point at infinity
+	cellProofsBlobTxn := func(proof gokzg4844.KZGProof) *TxnSlot {
+		blobTxn := makeBlobTxn()
+		blobTxn.Nonce = 0
+		blobTxn.BlobWrapperVersion = types.BlobTxWrapperVersion1
+		blobTxn.Proofs = nil
+		for i := range blobTxn.Blobs {
+			blobTxn.Blobs[i] = make([]byte, fixedgas.BlobSize)
+			blobTxn.Commitments[i] = gokzg4844.PointAtInfinity
+			blobTxn.BlobHashes[i] = common.Hash(kzg.KZGToVersionedHash(blobTxn.Commitments[i]))
+			for j := 0; j < fixedgas.CellsPerExtBlob; j++ {
+				blobTxn.Proofs = append(blobTxn.Proofs, proof)
+			}
+		}
+		return &blobTxn
+	}
+	// a valid point, which is not the proof of any cell of the zero blob
+	var blob gokzg4844.Blob
+	blob[gokzg4844.SerializedScalarSize-1] = 1
+	invalidProof, err := kzg.Ctx().BlobToKZGCommitment(blob[:], 0)
+	require.NoError(err)
+	for _, tt := range []struct {
+		name   string
+		txn    *TxnSlot
+		reason txpoolcfg.DiscardReason
+	}{
+		{name: "blob proofs", txn: func() *TxnSlot { txn := makeBlobTxn(); txn.Nonce = 0; return &txn }(), reason: txpoolcfg.BlobWrapperVersion},
+		{name: "invalid cell proofs", txn: cellProofsBlobTxn(gokzg4844.KZGProof(invalidProof)), reason: txpoolcfg.UnmatchedBlobTxExt},
+		{name: "cell proofs", txn: cellProofsBlobTxn(gokzg4844.PointAtInfinity), reason: txpoolcfg.Success},
+	} {
+		var txnSlots TxnSlots
+		txnSlots.Append(tt.txn, addr[:], true)
+		reasons, err := pool.AddLocalTxns(ctx, txnSlots)
+		require.NoError(err)
+		assert.Equal([]txpoolcfg.DiscardReason{tt.reason}, reasons, tt.name)
+	}
+}
+
+func TestBlobSlotsEviction(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ch := make(chan Announcements, 5)
+	coreDB, _ := temporaltest.NewTestDB(t, datadir.New(t.TempDir()))
+	db := memdb.NewTestPoolDB(t)
+	cfg := txpoolcfg.DefaultConfig
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	//Setting limits for blobs in the pool
+	cfg.TotalBlobPoolLimit = 6
+
+	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
+	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, common.Big0, nil, common.Big0, nil, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
+	assert.NoError(err)
+	require.True(pool != nil)
+	require.NoError(pool.start(ctx))
+
+	h1 := gointerfaces.ConvertHashToH256([32]byte{})
+	change := &remote.StateChangeBatch{
+		StateVersionId:       0,
+		PendingBlockBaseFee:  200_000,
+		BlockGasLimit:        math.MaxUint64,
+		PendingBlobFeePerGas: 100_000,
+		ChangeBatch: []*remote.StateChange{
+			{BlockHeight: 0, BlockHash: h1},
+		},
+	}
+	var addr [20]byte
+	acc := accounts3.Account{
+		Nonce:       0,
+		Balance:     *uint256.NewInt(1 * common.Ether),
+		CodeHash:    common.Hash{},
+		Incarnation: 1,
+	}
+	v := accounts3.SerialiseV3(&acc)
+	for i := 0; i < 5; i++ {
+		addr[0] = uint8(i + 1)
+		change.ChangeBatch[0].Changes = append(change.ChangeBatch[0].Changes, &remote.AccountChange{
+			Action:  remote.Action_UPSERT,
+			Address: gointerfaces.ConvertAddressToH160(addr),
+			Data:    v,
+		})
+	}
+	err = pool.OnNewBlock(ctx, change, TxnSlots{}, TxnSlots{}, TxnSlots{})
+	assert.NoError(err)
+
+	// fill the blob pool with remote txns of 3 senders, paying different blob fees
+	blobFeeCaps := []uint64{150_000, 120_000, 180_000}
+	var idHashes []common.Hash
+	for i, blobFeeCap := range blobFeeCaps {
+		txnSlots := TxnSlots{}
+		addr[0] = uint8(i + 1)
+		blobTxn := makeBlobTxn() // makes a txn with 2 blobs
+		blobTxn.IDHash[0] = uint8(i + 1)
+		blobTxn.Nonce = 0
+		blobTxn.BlobFeeCap = *uint256.NewInt(blobFeeCap)
+		idHashes = append(idHashes, blobTxn.IDHash)
+		txnSlots.Append(&blobTxn, addr[:], true)
+		pool.AddRemoteTxns(ctx, txnSlots)
+	}
+	require.NoError(pool.processRemoteTxns(ctx))
+	require.Equal(uint64(6), pool.totalBlobsInPool.Load())
+
+	// a txn paying less for blobs than any of the pooled ones is rejected
+	txnSlots := TxnSlots{}
+	addr[0] = 4
+	blobTxn := makeBlobTxn()
+	blobTxn.IDHash[0] = 4
+	blobTxn.Nonce = 0
+	blobTxn.BlobFeeCap = *uint256.NewInt(110_000)
+	txnSlots.Append(&blobTxn, addr[:], true)
+	reasons, err := pool.AddLocalTxns(ctx, txnSlots)
+	assert.NoError(err)
+	assert.Equal([]txpoolcfg.DiscardReason{txpoolcfg.BlobPoolOverflow}, reasons)
+
+	// a txn paying more evicts the cheapest one
+	txnSlots = TxnSlots{}
+	addr[0] = 5
+	blobTxn = makeBlobTxn()
+	blobTxn.IDHash[0] = 5
+	blobTxn.Nonce = 0
+	blobTxn.BlobFeeCap = *uint256.NewInt(200_000)
+	idHashes = append(idHashes, blobTxn.IDHash)
+	txnSlots.Append(&blobTxn, addr[:], true)
+	reasons, err = pool.AddLocalTxns(ctx, txnSlots)
+	assert.NoError(err)
+	assert.Equal([]txpoolcfg.DiscardReason{txpoolcfg.Success}, reasons)
+
+	assert.Equal(uint64(6), pool.totalBlobsInPool.Load())
+	_, ok := pool.byHash[string(idHashes[1][:])]
+	assert.False(ok)
+	require.NoError(pool.blobStore.flush())
+	stored, err := pool.blobStore.has(idHashes[1])
+	require.NoError(err)
+	assert.False(stored)
+	for _, idHash := range []common.Hash{idHashes[0], idHashes[2], idHashes[3]} {
+		_, ok := pool.byHash[string(idHash[:])]
+		assert.True(ok)
+		stored, err := pool.blobStore.has(idHash)
+		require.NoError(err)
+		assert.True(stored)
+	}
+}
+
 func TestGetBlobsV1(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
 	ch := make(chan Announcements, 5)
@@ -1477,7 +1657,7 @@ func TestGetBlobsV1(t *testing.T) {
 	cfg.TotalBlobPoolLimit = 20
 
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, common.Big0, nil, common.Big0, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
+	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, common.Big0, nil, common.Big0, nil, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
 	assert.NoError(err)
 	require.True(pool != nil)
 	pool.blockGasLimit.Store(30000000)
@@ -1528,6 +1708,8 @@ func TestGetBlobsV1(t *testing.T) {
 	blobTxn.Nonce = 0
 	blobTxn.Gas = 50000
 	txnSlots.Append(&blobTxn, addr[:], true)
+	// the pool moves blobs of the added txn to the blob store
+	expectedBlobs, expectedProofs := slices.Clone(blobTxn.Blobs), slices.Clone(blobTxn.Proofs)
 	reasons, err := pool.AddLocalTxns(ctx, txnSlots)
 	assert.NoError(err)
 	for _, reason := range reasons {
@@ -1538,9 +1720,14 @@ func TestGetBlobsV1(t *testing.T) {
 	blobs, proofs := pool.GetBlobs(blobHashes)
 	require.True(len(blobs) == len(blobHashes))
 	require.True(len(proofs) == len(blobHashes))
-	assert.Equal(blobTxn.Blobs, blobs)
-	assert.Equal(blobTxn.Proofs[0][:], proofs[0])
-	assert.Equal(blobTxn.Proofs[1][:], proofs[1])
+	assert.Equal(expectedBlobs, blobs)
+	assert.Equal(expectedProofs[0][:], proofs[0])
+	assert.Equal(expectedProofs[1][:], proofs[1])
+
+	// blobs with EIP-4844 proofs can't serve engine_getBlobsV2
+	blobs, cellProofs := pool.GetBlobsAndCellProofs(blobHashes)
+	assert.Equal([][]byte{nil, nil}, blobs)
+	assert.Equal([][][]byte{nil, nil}, cellProofs)
 }
 
 func TestGasLimitChanged(t *testing.T) {
@@ -1552,7 +1739,7 @@ func TestGasLimitChanged(t *testing.T) {
 	db := memdb.NewTestPoolDB(t)
 	cfg := txpoolcfg.DefaultConfig
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
+	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
 	assert.NoError(err)
 	require.True(pool != nil)
 	var stateVersionID uint64 = 0
@@ -1626,3 +1813,355 @@ type sender struct {
 func newSender(nonce uint64, balance uint256.Int) *sender {
 	return &sender{nonce: nonce, balance: balance}
 }
+
+// newTestPool starts a pool on top of a block funding each of the given senders with 1 ETH. The returned state change
+// of that block can be modified and applied again to move the chain forward.
+func newTestPool(t *testing.T, cfg txpoolcfg.Config, senders ...[20]byte) (*TxPool, kv.RwDB, *remote.StateChangeBatch) {
+	ch := make(chan Announcements, 100)
+	coreDB, _ := temporaltest.NewTestDB(t, datadir.New(t.TempDir()))
+	db := memdb.NewTestPoolDB(t)
+	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
+	require.NoError(t, err)
+	require.NoError(t, pool.start(ctx))
+
+	change := &remote.StateChangeBatch{
+		StateVersionId:      0,
+		PendingBlockBaseFee: 200_000,
+		BlockGasLimit:       1_000_000,
+		ChangeBatch: []*remote.StateChange{
+			{BlockHeight: 0, BlockHash: gointerfaces.ConvertHashToH256([32]byte{})},
+		},
+	}
+	for _, addr := range senders {
+		acc := accounts3.Account{Nonce: 0, Balance: *uint256.NewInt(1 * common.Ether), Incarnation: 1}
+		change.ChangeBatch[0].Changes = append(change.ChangeBatch[0].Changes, &remote.AccountChange{
+			Action:  remote.Action_UPSERT,
+			Address: gointerfaces.ConvertAddressToH160(addr),
+			Data:    accounts3.SerialiseV3(&acc),
+		})
+	}
+	require.NoError(t, pool.OnNewBlock(ctx, change, TxnSlots{}, TxnSlots{}, TxnSlots{}))
+	return pool, db, change
+}
+
+func TestLocalJournal(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	cfg := txpoolcfg.DefaultConfig
+	cfg.PendingSubPoolLimit = 2
+	var addr1, addr2 [20]byte
+	addr1[0], addr2[0] = 1, 2
+	pool, db, change := newTestPool(t, cfg, addr1, addr2)
+
+	// only txns with known rlp get journaled, so the cheapest one is the only journaled txn
+	journaled := &TxnSlot{Tip: *uint256.NewInt(300_000), FeeCap: *uint256.NewInt(300_000), Gas: 100_000, Nonce: 0, Rlp: []byte{0x01}}
+	journaled.IDHash[0] = 1
+	var txnSlots TxnSlots
+	txnSlots.Append(journaled, addr1[:], true)
+	for i := uint64(0); i < 2; i++ {
+		txnSlot := &TxnSlot{Tip: *uint256.NewInt(500_000), FeeCap: *uint256.NewInt(500_000), Gas: 100_000, Nonce: i}
+		txnSlot.IDHash[0] = byte(2 + i)
+		txnSlots.Append(txnSlot, addr2[:], true)
+	}
+	reasons, err := pool.AddLocalTxns(ctx, txnSlots)
+	require.NoError(err)
+	assert.Equal([]txpoolcfg.DiscardReason{txpoolcfg.Success, txpoolcfg.Success, txpoolcfg.PendingPoolOverflow}, reasons)
+
+	// pending sub-pool overflow must evict a non-journaled txn, even though the journaled one is the worst
+	statuses := pool.Lifecycle(ctx, []common.Hash{journaled.IDHash, txnSlots.Txns[2].IDHash})
+	assert.Equal(TxnStatusPending, statuses[0].Status)
+	assert.True(statuses[0].Local)
+	assert.True(statuses[0].Journaled)
+	assert.Equal(TxnStatusDropped, statuses[1].Status)
+	assert.Equal(txpoolcfg.PendingPoolOverflow, statuses[1].DiscardReason)
+	assert.False(statuses[1].Journaled)
+
+	statuses = pool.Lifecycle(ctx, nil)
+	require.Len(statuses, 1)
+	assert.Equal(common.Hash(journaled.IDHash), statuses[0].Hash)
+
+	// journal is persisted
+	_, err = pool.flush(ctx)
+	require.NoError(err)
+	require.NoError(db.View(ctx, func(tx kv.Tx) error {
+		restored := newLocalJournal(cfg.LocalJournalLimit)
+		require.NoError(restored.load(tx))
+		assert.Equal(1, restored.len())
+		assert.True(restored.has(string(journaled.IDHash[:])))
+		return nil
+	}))
+
+	// and released once mined
+	var minedTxns TxnSlots
+	minedTxns.Append(journaled, addr1[:], true)
+	change.ChangeBatch[0].BlockHeight = 1
+	change.ChangeBatch[0].Changes = change.ChangeBatch[0].Changes[:1]
+	acc := accounts3.Account{Nonce: 1, Balance: *uint256.NewInt(1 * common.Ether), Incarnation: 1}
+	change.ChangeBatch[0].Changes[0].Data = accounts3.SerialiseV3(&acc)
+	require.NoError(pool.OnNewBlock(ctx, change, TxnSlots{}, TxnSlots{}, minedTxns))
+
+	statuses = pool.Lifecycle(ctx, []common.Hash{journaled.IDHash})
+	assert.Equal(TxnStatusMined, statuses[0].Status)
+	assert.False(statuses[0].Journaled)
+	assert.Empty(pool.Lifecycle(ctx, nil))
+
+	_, err = pool.flush(ctx)
+	require.NoError(err)
+	require.NoError(db.View(ctx, func(tx kv.Tx) error {
+		restored := newLocalJournal(cfg.LocalJournalLimit)
+		require.NoError(restored.load(tx))
+		assert.Zero(restored.len())
+		return nil
+	}))
+}
+
+// TestLocalJournalRestart - a journaled txn dropped by the pool on overflow is kept by the journal, and is re-injected on restart
+func TestLocalJournalRestart(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	ch := make(chan Announcements, 100)
+	coreDB, _ := temporaltest.NewTestDB(t, datadir.New(t.TempDir()))
+	db := memdb.NewTestPoolDB(t)
+	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
+	cfg := txpoolcfg.DefaultConfig
+	newPool := func() *TxPool {
+		pool, err := New(ctx, ch, db, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, nil, nil, nil, nil, func() {}, nil, log.New(), WithFeeCalculator(nil))
+		require.NoError(err)
+		require.NoError(pool.start(ctx))
+		return pool
+	}
+	pool := newPool()
+
+	// the journal keeps txns in the network form, which is parsed on restart
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(err)
+	addr := crypto.PubkeyToAddress(privateKey.PublicKey)
+	acc := accounts3.Account{Nonce: 0, Balance: *uint256.NewInt(1 * common.Ether), Incarnation: 1}
+	change := &remote.StateChangeBatch{
+		PendingBlockBaseFee: 200_000,
+		BlockGasLimit:       1_000_000,
+		ChangeBatch: []*remote.StateChange{{
+			BlockHeight: 0,
+			BlockHash:   gointerfaces.ConvertHashToH256([32]byte{}),
+			Changes: []*remote.AccountChange{{
+				Action:  remote.Action_UPSERT,
+				Address: gointerfaces.ConvertAddressToH160(addr),
+				Data:    accounts3.SerialiseV3(&acc),
+			}},
+		}},
+	}
+	require.NoError(pool.OnNewBlock(ctx, change, TxnSlots{}, TxnSlots{}, TxnSlots{}))
+
+	txn, err := types.SignTx(types.NewTransaction(0, common.Address{}, uint256.NewInt(0), 100_000, uint256.NewInt(300_000), nil), *types.LatestSignerForChainID(big.NewInt(1)), privateKey)
+	require.NoError(err)
+	var rlp bytes.Buffer
+	require.NoError(txn.MarshalBinary(&rlp))
+	txnSlot := &TxnSlot{Tip: *uint256.NewInt(300_000), FeeCap: *uint256.NewInt(300_000), Gas: 100_000, Nonce: 0, Rlp: rlp.Bytes(), IDHash: txn.Hash()}
+	var txnSlots TxnSlots
+	txnSlots.Append(txnSlot, addr[:], true)
+	reasons, err := pool.AddLocalTxns(ctx, txnSlots)
+	require.NoError(err)
+	require.Equal([]txpoolcfg.DiscardReason{txpoolcfg.Success}, reasons)
+
+	// evicted on overflow, as the sub-pool does with the worst txns
+	pool.lock.Lock()
+	mt := pool.byHash[string(txnSlot.IDHash[:])]
+	require.NotNil(mt)
+	pool.pending.Remove(mt, "test", pool.logger)
+	pool.discardLocked(mt, txpoolcfg.PendingPoolOverflow)
+	pool.lock.Unlock()
+
+	statuses := pool.Lifecycle(ctx, []common.Hash{txnSlot.IDHash})
+	assert.Equal(TxnStatusDropped, statuses[0].Status)
+	assert.Equal(txpoolcfg.PendingPoolOverflow, statuses[0].DiscardReason)
+	assert.True(statuses[0].Journaled)
+	_, err = pool.flush(ctx)
+	require.NoError(err)
+
+	// re-injected on restart
+	pool = newPool()
+	statuses = pool.Lifecycle(ctx, []common.Hash{txnSlot.IDHash})
+	assert.Equal(TxnStatusPending, statuses[0].Status)
+	assert.True(statuses[0].Journaled)
+}
+
+func TestLocalJournalLimit(t *testing.T) {
+	j := newLocalJournal(1)
+	require.True(t, j.add("a", common.Address{1}, []byte{0x01}))
+	require.True(t, j.add("a", common.Address{1}, []byte{0x01}))
+	require.False(t, j.add("b", common.Address{1}, []byte{0x02}))
+	j.full = true
+
+	// making room clears the overflow state, so that the next overflow is reported again
+	j.remove("a")
+	require.False(t, j.full)
+	require.True(t, j.add("b", common.Address{1}, []byte{0x02}))
+}
+
+func TestPrivateTxns(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	var addr [20]byte
+	addr[0] = 1
+	pool, db, change := newTestPool(t, txpoolcfg.DefaultConfig, addr)
+
+	txnSlot := &TxnSlot{Tip: *uint256.NewInt(300_000), FeeCap: *uint256.NewInt(300_000), Gas: 100_000, Nonce: 0, Rlp: []byte{0x01}}
+	txnSlot.IDHash[0] = 1
+	var txnSlots TxnSlots
+	txnSlots.Append(txnSlot, addr[:], true)
+	reasons, err := pool.AddPrivateTxns(ctx, txnSlots, 2)
+	require.NoError(err)
+	assert.Equal([]txpoolcfg.DiscardReason{txpoolcfg.Success}, reasons)
+	assert.True(pool.IsPrivate(txnSlot.IDHash[:]))
+
+	// not announced, but still available for local block building
+	_, _, hashes := pool.AppendAllAnnouncements(nil, nil, nil)
+	assert.Empty(hashes)
+	txns := TxnsRlp{}
+	_, err = pool.PeekBest(ctx, 10, &txns, 0, 1_000_000, 0)
+	require.NoError(err)
+	assert.Empty(txns.Txns)
+	_, count, err := pool.YieldBest(ctx, 10, &txns, 0, 1_000_000, 0, mapset.NewThreadUnsafeSet[[32]byte]())
+	require.NoError(err)
+	assert.Equal(1, count)
+
+	// private flag is persisted
+	_, err = pool.flush(ctx)
+	require.NoError(err)
+	require.NoError(db.View(ctx, func(tx kv.Tx) error {
+		restored := newPrivateTxns()
+		require.NoError(restored.load(tx))
+		assert.True(restored.has(string(txnSlot.IDHash[:])))
+		return nil
+	}))
+
+	// a known txn can't be made private, it may have been gossiped already
+	var publicSlots TxnSlots
+	publicTxn := &TxnSlot{Tip: *uint256.NewInt(300_000), FeeCap: *uint256.NewInt(300_000), Gas: 100_000, Nonce: 1, Rlp: []byte{0x02}}
+	publicTxn.IDHash[0] = 2
+	publicSlots.Append(publicTxn, addr[:], true)
+	reasons, err = pool.AddLocalTxns(ctx, publicSlots)
+	require.NoError(err)
+	assert.Equal([]txpoolcfg.DiscardReason{txpoolcfg.Success}, reasons)
+	reasons, err = pool.AddPrivateTxns(ctx, publicSlots, 0)
+	require.NoError(err)
+	assert.Equal([]txpoolcfg.DiscardReason{txpoolcfg.AlreadyPublic}, reasons)
+	assert.False(pool.IsPrivate(publicTxn.IDHash[:]))
+	_, _, hashes = pool.AppendAllAnnouncements(nil, nil, nil)
+	assert.Equal(publicTxn.IDHash[:], hashes)
+
+	// stays private up to and including max block
+	for _, blockNum := range []uint64{1, 2} {
+		change.ChangeBatch[0].BlockHeight = blockNum
+		require.NoError(pool.OnNewBlock(ctx, change, TxnSlots{}, TxnSlots{}, TxnSlots{}))
+		assert.True(pool.IsPrivate(txnSlot.IDHash[:]))
+	}
+
+	// falls back to public gossip once max block is passed
+	change.ChangeBatch[0].BlockHeight = 3
+	require.NoError(pool.OnNewBlock(ctx, change, TxnSlots{}, TxnSlots{}, TxnSlots{}))
+	assert.False(pool.IsPrivate(txnSlot.IDHash[:]))
+	assert.Equal(1, pool.promoted.Len())
+	_, _, hashes = pool.AppendAllAnnouncements(nil, nil, nil)
+	assert.ElementsMatch([][]byte{txnSlot.IDHash[:], publicTxn.IDHash[:]}, [][]byte{hashes[:32], hashes[32:]})
+}
+
+func TestTxnEvents(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	var addr1, addr2 [20]byte
+	addr1[0], addr2[0] = 1, 2
+	pool, _, change := newTestPool(t, txpoolcfg.DefaultConfig, addr1, addr2)
+
+	replies, remove := pool.SubscribeTxnEvents([]common.Address{addr1})
+	defer remove()
+
+	var txnSlots TxnSlots
+	first := &TxnSlot{Tip: *uint256.NewInt(300_000), FeeCap: *uint256.NewInt(300_000), Gas: 100_000, Nonce: 0}
+	first.IDHash[0] = 1
+	txnSlots.Append(first, addr1[:], true)
+	other := &TxnSlot{Tip: *uint256.NewInt(300_000), FeeCap: *uint256.NewInt(300_000), Gas: 100_000, Nonce: 0}
+	other.IDHash[0] = 2
+	txnSlots.Append(other, addr2[:], true)
+	underpriced := &TxnSlot{Tip: *uint256.NewInt(300_001), FeeCap: *uint256.NewInt(300_001), Gas: 100_000, Nonce: 0}
+	underpriced.IDHash[0] = 3
+	txnSlots.Append(underpriced, addr1[:], true)
+	_, err := pool.AddLocalTxns(ctx, txnSlots)
+	require.NoError(err)
+
+	txnSlots = TxnSlots{}
+	replacement := &TxnSlot{Tip: *uint256.NewInt(400_000), FeeCap: *uint256.NewInt(400_000), Gas: 100_000, Nonce: 0}
+	replacement.IDHash[0] = 4
+	txnSlots.Append(replacement, addr1[:], true)
+	_, err = pool.AddLocalTxns(ctx, txnSlots)
+	require.NoError(err)
+
+	// private txns are kept out of the stream
+	txnSlots = TxnSlots{}
+	private := &TxnSlot{Tip: *uint256.NewInt(300_000), FeeCap: *uint256.NewInt(300_000), Gas: 100_000, Nonce: 1}
+	private.IDHash[0] = 5
+	txnSlots.Append(private, addr1[:], true)
+	_, err = pool.AddPrivateTxns(ctx, txnSlots, 0)
+	require.NoError(err)
+
+	var minedTxns TxnSlots
+	minedTxns.Append(replacement, addr1[:], true)
+	change.ChangeBatch[0].BlockHeight = 1
+	change.ChangeBatch[0].Changes = change.ChangeBatch[0].Changes[:1]
+	acc := accounts3.Account{Nonce: 1, Balance: *uint256.NewInt(1 * common.Ether), Incarnation: 1}
+	change.ChangeBatch[0].Changes[0].Data = accounts3.SerialiseV3(&acc)
+	require.NoError(pool.OnNewBlock(ctx, change, TxnSlots{}, TxnSlots{}, minedTxns))
+
+	pool.publishTxnEvents()
+	var events []*txpoolproto.TxnEvent
+	for len(replies) > 0 {
+		events = append(events, (<-replies).Events...)
+	}
+	type event struct {
+		hash          byte
+		typ           txpoolproto.TxnEvent_Type
+		subPool       txpoolproto.AllReply_TxnType
+		discardReason string
+	}
+	expected := []event{
+		{1, txpoolproto.TxnEvent_ADDED, txpoolproto.AllReply_QUEUED, ""},
+		{3, txpoolproto.TxnEvent_DISCARDED, 0, txpoolcfg.NotReplaced.String()},
+		{1, txpoolproto.TxnEvent_PROMOTED, txpoolproto.AllReply_PENDING, ""},
+		{1, txpoolproto.TxnEvent_REPLACED, 0, txpoolcfg.ReplacedByHigherTip.String()},
+		{4, txpoolproto.TxnEvent_ADDED, txpoolproto.AllReply_QUEUED, ""},
+		{4, txpoolproto.TxnEvent_PROMOTED, txpoolproto.AllReply_PENDING, ""},
+		{4, txpoolproto.TxnEvent_MINED, 0, ""},
+	}
+	actual := make([]event, len(events))
+	for i, e := range events {
+		assert.Equal(common.Address(addr1), common.Address(gointerfaces.ConvertH160toAddress(e.Sender)))
+		actual[i] = event{gointerfaces.ConvertH256ToHash(e.Hash)[0], e.Type, e.SubPool, e.DiscardReason}
+	}
+	assert.Equal(expected, actual)
+
+	remove()
+	assert.False(pool.txnEventsStreams.HasSubscribers())
+}
+
+func TestTxnEventsSlowSubscriber(t *testing.T) {
+	var streams TxnEventsStreams
+	replies, remove := streams.Add(nil)
+	defer remove()
+
+	events := []TxnEvent{{Type: TxnEventAdded, SubPool: QueuedSubPool}}
+	for i := 0; i < maxPendingTxnEventReplies; i++ {
+		streams.Broadcast(events, log.New())
+	}
+	require.True(t, streams.HasSubscribers())
+
+	// one more reply than the subscriber has room for disconnects it
+	streams.Broadcast(events, log.New())
+	require.False(t, streams.HasSubscribers())
+	for range replies { // closed once drained
+	}
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/erigontech/erigon-lib/common/u256"
 	"github.com/erigontech/erigon-lib/crypto"
 	"github.com/erigontech/erigon-lib/rlp"
+	"github.com/erigontech/erigon/core/types"
 )
 
 const (
@@ -192,6 +193,23 @@ func (ctx *TxnParseContext) ParseTransaction(payload []byte, pos int, slot *TxnS
 			return 0, fmt.Errorf("%w: unexpected leftover after blob txn body", ErrParseTxn)
 		}
 
+		// EIP-7594 wrapper has the version between the txn body and the blobs
+		_, _, isList, err := rlp.Prefix(payload, p)
+		if err != nil {
+			return 0, fmt.Errorf("%w: blobs wrapper: %s", ErrParseTxn, err) //nolint
+		}
+		if !isList {
+			var version uint64
+			p, version, err = rlp.ParseU64(payload, p)
+			if err != nil {
+				return 0, fmt.Errorf("%w: wrapper version: %s", ErrParseTxn, err) //nolint
+			}
+			if version != uint64(types.BlobTxWrapperVersion1) {
+				return 0, fmt.Errorf("%w: unknown wrapper version: %d", ErrParseTxn, version)
+			}
+			slot.BlobWrapperVersion = byte(version)
+		}
+
 		dataPos, dataLen, err = rlp.ParseList(payload, p)
 		if err != nil {
 			return 0, fmt.Errorf("%w: blobs len: %s", ErrParseTxn, err) //nolint
@@ -684,7 +702,9 @@ type TxnSlot struct {
 	BlobHashes  []common.Hash
 	Blobs       [][]byte
 	Commitments []gokzg4844.KZGCommitment
-	Proofs      []gokzg4844.KZGProof
+	Proofs      []gokzg4844.KZGProof // fixedgas.CellsPerExtBlob cell proofs per blob for BlobWrapperVersion 1 (EIP-7594)
+
+	BlobWrapperVersion byte
 
 	// EIP-7702: set code tx
 	Authorizations []Signature
@@ -0,0 +1,138 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/erigontech/erigon-lib/kv"
+	"github.com/erigontech/erigon-lib/kv/order"
+	"github.com/erigontech/erigon/txnprovider/txpool/txpoolcfg"
+)
+
+// privateTxns tracks txns submitted via eth_sendPrivateRawTransaction. Such txns:
+//   - are never announced or broadcast to peers, and are not served in PooledTransactions replies
+//   - are only handed out for locally built blocks (YieldBest), not to external consumers of the pool
+//   - become regular local txns once the chain passes their max block (if set), they stay private up to and including it
+//
+// Not thread-safe: guarded by TxPool.lock
+type privateTxns struct {
+	maxBlocks map[string]uint64 // txn_hash => max_block (0 - stays private until mined or dropped)
+	dirty     bool              // changed since last flush
+}
+
+func newPrivateTxns() *privateTxns {
+	return &privateTxns{maxBlocks: map[string]uint64{}}
+}
+
+func (pt *privateTxns) add(hash string, maxBlock uint64) {
+	pt.maxBlocks[hash] = maxBlock
+	pt.dirty = true
+}
+
+func (pt *privateTxns) remove(hash string) {
+	if _, ok := pt.maxBlocks[hash]; !ok {
+		return
+	}
+	delete(pt.maxBlocks, hash)
+	pt.dirty = true
+}
+
+func (pt *privateTxns) has(hash string) bool {
+	_, ok := pt.maxBlocks[hash]
+	return ok
+}
+
+// expire removes txns whose max block is passed by blockNum, returns their hashes
+func (pt *privateTxns) expire(blockNum uint64) (expired []string) {
+	for hash, maxBlock := range pt.maxBlocks {
+		if maxBlock != 0 && blockNum > maxBlock {
+			expired = append(expired, hash)
+		}
+	}
+	for _, hash := range expired {
+		pt.remove(hash)
+	}
+	return expired
+}
+
+func (pt *privateTxns) flush(tx kv.RwTx) error {
+	if !pt.dirty {
+		return nil
+	}
+	if err := tx.ClearBucket(kv.PoolPrivateTransaction); err != nil {
+		return err
+	}
+	v := make([]byte, 8)
+	for hash, maxBlock := range pt.maxBlocks {
+		binary.BigEndian.PutUint64(v, maxBlock)
+		if err := tx.Put(kv.PoolPrivateTransaction, []byte(hash), v); err != nil {
+			return err
+		}
+	}
+	pt.dirty = false
+	return nil
+}
+
+func (pt *privateTxns) load(tx kv.Tx) error {
+	it, err := tx.Range(kv.PoolPrivateTransaction, nil, nil, order.Asc, kv.Unlim)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for it.HasNext() {
+		k, v, err := it.Next()
+		if err != nil {
+			return err
+		}
+		if len(v) != 8 {
+			continue
+		}
+		pt.maxBlocks[string(k)] = binary.BigEndian.Uint64(v)
+	}
+	return nil
+}
+
+// AddPrivateTxns adds local txns which must not be gossiped. If maxBlock is set and the txns
+// are not mined by that block, they are announced to peers as regular local txns.
+func (p *TxPool) AddPrivateTxns(ctx context.Context, newTxns TxnSlots, maxBlock uint64) ([]txpoolcfg.DiscardReason, error) {
+	return p.addLocalTxns(ctx, newTxns, true, maxBlock)
+}
+
+// IsPrivate reports whether the txn must be kept out of p2p gossip
+func (p *TxPool) IsPrivate(idHash []byte) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.privateTxns.has(string(idHash))
+}
+
+// publishExpiredPrivateLocked turns private txns whose max block is passed into regular local txns,
+// and announces those already in the pending sub-pool
+func (p *TxPool) publishExpiredPrivateLocked(blockNum uint64, announcements *Announcements) {
+	for _, hash := range p.privateTxns.expire(blockNum) {
+		mt, ok := p.byHash[hash]
+		if !ok || mt.currentSubPool != PendingSubPool {
+			continue
+		}
+		if mt.TxnSlot.Traced {
+			p.logger.Info("TX TRACING: private txn max block passed, publishing", "idHash", fmt.Sprintf("%x", mt.TxnSlot.IDHash), "blockNum", blockNum)
+		}
+		announcements.Append(mt.TxnSlot.Type, mt.TxnSlot.Size, mt.TxnSlot.IDHash[:])
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright 2025 The Erigon Authors
+// This file is part of Erigon.
+//
+// Erigon is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// Erigon is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with Erigon. If not, see <http://www.gnu.org/licenses/>.
+
+package txpool
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/erigontech/erigon-lib/common"
+	"github.com/erigontech/erigon-lib/gointerfaces"
+	txpool_proto "github.com/erigontech/erigon-lib/gointerfaces/txpoolproto"
+	"github.com/erigontech/erigon-lib/log/v3"
+	"github.com/erigontech/erigon/txnprovider/txpool/txpoolcfg"
+)
+
+// maxBufferedTxnEvents - events not yet published to subscribers, newer events are dropped when exceeded
+const maxBufferedTxnEvents = 64 * 1024
+
+// maxPendingTxnEventReplies - replies not yet sent to a subscriber, which is disconnected when exceeded
+const maxPendingTxnEventReplies = 1024
+
+var ErrSlowTxnEventsSubscriber = errors.New("txn events subscriber is too slow")
+
+type TxnEventType uint8
+
+const (
+	TxnEventAdded     TxnEventType = iota // entered the pool (always into the queued sub-pool first)
+	TxnEventPromoted                      // moved to a better sub-pool
+	TxnEventDemoted                       // moved to a worse sub-pool
+	TxnEventReplaced                      // replaced by a txn with the same sender and nonce, but higher tip
+	TxnEventDiscarded                     // rejected or evicted, see DiscardReason
+	TxnEventMined
+)
+
+type TxnEvent struct {
+	Hash          common.Hash
+	Sender        common.Address
+	Type          TxnEventType
+	SubPool       SubPoolType             // set for TxnEventAdded, TxnEventPromoted, TxnEventDemoted
+	DiscardReason txpoolcfg.DiscardReason // set for TxnEventReplaced, TxnEventDiscarded
+}
+
+// txnEventLocked records a lifecycle event of a txn which is (or was) in the pool. Private txns are kept out of the stream.
+func (p *TxPool) txnEventLocked(mt *metaTxn, eventType TxnEventType, subPool SubPoolType, reason txpoolcfg.DiscardReason) {
+	if !p.txnEventsStreams.HasSubscribers() || p.privateTxns.has(string(mt.TxnSlot.IDHash[:])) {
+		return
+	}
+	sender, _ := p.senders.getAddr(mt.TxnSlot.SenderID)
+	p.appendTxnEventLocked(TxnEvent{Hash: mt.TxnSlot.IDHash, Sender: sender, Type: eventType, SubPool: subPool, DiscardReason: reason})
+}
+
+// rejectedTxnEventLocked records rejection of a txn which never made it to the pool
+func (p *TxPool) rejectedTxnEventLocked(txn *TxnSlot, sender []byte, reason txpoolcfg.DiscardReason) {
+	if !p.txnEventsStreams.HasSubscribers() || p.privateTxns.has(string(txn.IDHash[:])) {
+		return
+	}
+	p.appendTxnEventLocked(TxnEvent{Hash: txn.IDHash, Sender: common.BytesToAddress(sender), Type: TxnEventDiscarded, DiscardReason: reason})
+}
+
+func (p *TxPool) discardTxnEventLocked(mt *metaTxn, reason txpoolcfg.DiscardReason) {
+	switch reason {
+	case txpoolcfg.Mined:
+		p.txnEventLocked(mt, TxnEventMined, 0, txpoolcfg.NotSet)
+	case txpoolcfg.ReplacedByHigherTip:
+		p.txnEventLocked(mt, TxnEventReplaced, 0, reason)
+	default:
+		p.txnEventLocked(mt, TxnEventDiscarded, 0, reason)
+	}
+}
+
+func (p *TxPool) appendTxnEventLocked(event TxnEvent) {
+	if len(p.txnEvents) >= maxBufferedTxnEvents {
+		p.txnEventsDropped++
+		return
+	}
+	p.txnEvents = append(p.txnEvents, event)
+	select {
+	case p.txnEventsReady <- struct{}{}:
+	default:
+	}
+}
+
+// publishTxnEvents sends buffered events to subscribers, outside of the pool lock
+func (p *TxPool) publishTxnEvents() {
+	p.lock.Lock()
+	events, dropped := p.txnEvents, p.txnEventsDropped
+	p.txnEvents, p.txnEventsDropped = nil, 0
+	p.lock.Unlock()
+
+	if dropped > 0 {
+		p.logger.Warn("[txpool] txn events buffer was full, events dropped", "dropped", dropped, "limit", maxBufferedTxnEvents)
+	}
+	if len(events) > 0 {
+		p.txnEventsStreams.Broadcast(events, p.logger)
+	}
+}
+
+// SubscribeTxnEvents adds a subscriber of txns lifecycle events. If senders are given - only their txns are streamed.
+// The returned channel is closed if the subscriber doesn't keep up with the events.
+func (p *TxPool) SubscribeTxnEvents(senders []common.Address) (replies <-chan *txpool_proto.TxnEventsReply, remove func()) {
+	return p.txnEventsStreams.Add(senders)
+}
+
+type txnEventsSubscriber struct {
+	replies chan *txpool_proto.TxnEventsReply
+	senders map[common.Address]struct{} // empty - all senders
+}
+
+// TxnEventsStreams - it's safe to use this class as non-pointer
+type TxnEventsStreams struct {
+	chans map[uint]*txnEventsSubscriber
+	mu    sync.Mutex
+	id    uint
+	count atomic.Int32
+}
+
+func (s *TxnEventsStreams) Add(senders []common.Address) (replies <-chan *txpool_proto.TxnEventsReply, remove func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.chans == nil {
+		s.chans = make(map[uint]*txnEventsSubscriber)
+	}
+	sub := &txnEventsSubscriber{
+		replies: make(chan *txpool_proto.TxnEventsReply, maxPendingTxnEventReplies),
+		senders: make(map[common.Address]struct{}, len(senders)),
+	}
+	for _, sender := range senders {
+		sub.senders[sender] = struct{}{}
+	}
+	s.id++
+	id := s.id
+	s.chans[id] = sub
+	s.count.Store(int32(len(s.chans)))
+	return sub.replies, func() { s.remove(id) }
+}
+
+// HasSubscribers allows to not collect events at all while nobody listens
+func (s *TxnEventsStreams) HasSubscribers() bool {
+	return s.count.Load() > 0
+}
+
+// Broadcast never blocks: subscribers whose replies queue is full are disconnected
+func (s *TxnEventsStreams) Broadcast(events []TxnEvent, logger log.Logger) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.chans) == 0 {
+		return
+	}
+	all := make([]*txpool_proto.TxnEvent, len(events))
+	for i := range events {
+		all[i] = mapTxnEventToProto(&events[i])
+	}
+	for id, sub := range s.chans {
+		reply := &txpool_proto.TxnEventsReply{Events: all}
+		if len(sub.senders) > 0 {
+			reply.Events = nil
+			for i := range events {
+				if _, ok := sub.senders[events[i].Sender]; ok {
+					reply.Events = append(reply.Events, all[i])
+				}
+			}
+			if len(reply.Events) == 0 {
+				continue
+			}
+		}
+		select {
+		case sub.replies <- reply:
+		default:
+			logger.Warn("[txpool] disconnecting slow txn events subscriber", "pendingReplies", len(sub.replies))
+			close(sub.replies)
+			delete(s.chans, id)
+			s.count.Store(int32(len(s.chans)))
+		}
+	}
+}
+
+func (s *TxnEventsStreams) remove(id uint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.chans[id]
+	if !ok { // double-unsubscribe support, or already disconnected as slow
+		return
+	}
+	delete(s.chans, id)
+	s.count.Store(int32(len(s.chans)))
+}
+
+func mapTxnEventToProto(event *TxnEvent) *txpool_proto.TxnEvent {
+	res := &txpool_proto.TxnEvent{
+		Hash:   gointerfaces.ConvertHashToH256(event.Hash),
+		Sender: gointerfaces.ConvertAddressToH160(event.Sender),
+	}
+	switch event.Type {
+	case TxnEventAdded:
+		res.Type = txpool_proto.TxnEvent_ADDED
+	case TxnEventPromoted:
+		res.Type = txpool_proto.TxnEvent_PROMOTED
+	case TxnEventDemoted:
+		res.Type = txpool_proto.TxnEvent_DEMOTED
+	case TxnEventReplaced:
+		res.Type = txpool_proto.TxnEvent_REPLACED
+	case TxnEventDiscarded:
+		res.Type = txpool_proto.TxnEvent_DISCARDED
+	case TxnEventMined:
+		res.Type = txpool_proto.TxnEvent_MINED
+	}
+	switch event.SubPool {
+	case PendingSubPool:
+		res.SubPool = txpool_proto.AllReply_PENDING
+	case BaseFeeSubPool:
+		res.SubPool = txpool_proto.AllReply_BASE_FEE
+	case QueuedSubPool:
+		res.SubPool = txpool_proto.AllReply_QUEUED
+	}
+	if event.DiscardReason != txpoolcfg.NotSet {
+		res.DiscardReason = event.DiscardReason.String()
+	}
+	return res
+}
@@ -55,11 +55,15 @@ type txPool interface {
 	PeekBest(ctx context.Context, n int, txns *TxnsRlp, onTopOf, availableGas, availableBlobGas uint64) (bool, error)
 	GetRlp(tx kv.Tx, hash []byte) ([]byte, error)
 	AddLocalTxns(ctx context.Context, newTxns TxnSlots) ([]txpoolcfg.DiscardReason, error)
+	AddPrivateTxns(ctx context.Context, newTxns TxnSlots, maxBlock uint64) ([]txpoolcfg.DiscardReason, error)
 	deprecatedForEach(_ context.Context, f func(rlp []byte, sender common.Address, t SubPoolType), tx kv.Tx)
 	CountContent() (int, int, int)
 	IdHashKnown(tx kv.Tx, hash []byte) (bool, error)
 	NonceFromAddress(addr [20]byte) (nonce uint64, inPool bool)
 	GetBlobs(blobhashes []common.Hash) (blobs [][]byte, proofs [][]byte)
+	GetBlobsAndCellProofs(blobhashes []common.Hash) (blobs [][]byte, cellProofs [][][]byte)
+	Lifecycle(ctx context.Context, hashes []common.Hash) []TxnLifecycle
+	SubscribeTxnEvents(senders []common.Address) (replies <-chan *txpool_proto.TxnEventsReply, remove func())
 }
 
 var _ txpool_proto.TxpoolServer = (*GrpcServer)(nil)   // compile-time interface check
@@ -98,6 +102,12 @@ func (*GrpcDisabled) Status(ctx context.Context, request *txpool_proto.StatusReq
 func (*GrpcDisabled) Nonce(ctx context.Context, request *txpool_proto.NonceRequest) (*txpool_proto.NonceReply, error) {
 	return nil, ErrPoolDisabled
 }
+func (*GrpcDisabled) Lifecycle(ctx context.Context, request *txpool_proto.LifecycleRequest) (*txpool_proto.LifecycleReply, error) {
+	return nil, ErrPoolDisabled
+}
+func (*GrpcDisabled) TxnEvents(request *txpool_proto.TxnEventsRequest, server txpool_proto.Txpool_TxnEventsServer) error {
+	return ErrPoolDisabled
+}
 
 type GrpcServer struct {
 	txpool_proto.UnimplementedTxpoolServer
@@ -208,7 +218,12 @@ func (s *GrpcServer) Add(ctx context.Context, in *txpool_proto.AddRequest) (*txp
 		}
 	}
 
-	discardReasons, err := s.txPool.AddLocalTxns(ctx, slots)
+	var discardReasons []txpoolcfg.DiscardReason
+	if in.Private {
+		discardReasons, err = s.txPool.AddPrivateTxns(ctx, slots, in.PrivateMaxBlock)
+	} else {
+		discardReasons, err = s.txPool.AddLocalTxns(ctx, slots)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -238,6 +253,15 @@ func (s *GrpcServer) GetBlobs(ctx context.Context, in *txpool_proto.GetBlobsRequ
 	for i := range in.BlobHashes {
 		hashes[i] = gointerfaces.ConvertH256ToHash(in.BlobHashes[i])
 	}
+	if in.CellProofs {
+		blobs, cellProofs := s.txPool.GetBlobsAndCellProofs(hashes)
+		reply := &txpool_proto.GetBlobsReply{BlobsAndCellProofs: make([]*txpool_proto.BlobAndProofV2, len(blobs))}
+		for i := range blobs {
+			reply.BlobsAndCellProofs[i] = &txpool_proto.BlobAndProofV2{Blob: blobs[i], Proofs: cellProofs[i]}
+		}
+		return reply, nil
+	}
+
 	blobs, proofs := s.txPool.GetBlobs(hashes)
 	reply := &txpool_proto.GetBlobsReply{BlobsAndProofs: make([]*txpool_proto.BlobAndProofV1, len(blobs))}
 
@@ -251,7 +275,7 @@ func mapDiscardReasonToProto(reason txpoolcfg.DiscardReason) txpool_proto.Import
 	switch reason {
 	case txpoolcfg.Success:
 		return txpool_proto.ImportResult_SUCCESS
-	case txpoolcfg.AlreadyKnown:
+	case txpoolcfg.AlreadyKnown, txpoolcfg.AlreadyPublic:
 		return txpool_proto.ImportResult_ALREADY_EXISTS
 	case txpoolcfg.UnderPriced, txpoolcfg.ReplaceUnderpriced, txpoolcfg.FeeTooLow:
 		return txpool_proto.ImportResult_FEE_TOO_LOW
@@ -322,6 +346,70 @@ func (s *GrpcServer) Nonce(ctx context.Context, in *txpool_proto.NonceRequest) (
 	}, nil
 }
 
+func (s *GrpcServer) Lifecycle(ctx context.Context, in *txpool_proto.LifecycleRequest) (*txpool_proto.LifecycleReply, error) {
+	hashes := make([]common.Hash, len(in.Hashes))
+	for i := range in.Hashes {
+		hashes[i] = gointerfaces.ConvertH256ToHash(in.Hashes[i])
+	}
+	txns := s.txPool.Lifecycle(ctx, hashes)
+	reply := &txpool_proto.LifecycleReply{Txns: make([]*txpool_proto.TxnLifecycle, len(txns))}
+	for i, txn := range txns {
+		reply.Txns[i] = &txpool_proto.TxnLifecycle{
+			Hash:      gointerfaces.ConvertHashToH256(txn.Hash),
+			Status:    mapTxnStatusToProto(txn.Status),
+			Local:     txn.Local,
+			Journaled: txn.Journaled,
+		}
+		if txn.Status == TxnStatusDropped {
+			reply.Txns[i].DiscardReason = txn.DiscardReason.String()
+		}
+	}
+	return reply, nil
+}
+
+func (s *GrpcServer) TxnEvents(req *txpool_proto.TxnEventsRequest, stream txpool_proto.Txpool_TxnEventsServer) error {
+	senders := make([]common.Address, len(req.Senders))
+	for i := range req.Senders {
+		senders[i] = gointerfaces.ConvertH160toAddress(req.Senders[i])
+	}
+	s.logger.Info("New txn events subscriber joined", "senders", len(senders))
+	//txpool.Loop does send messages to this streams
+	replies, remove := s.txPool.SubscribeTxnEvents(senders)
+	defer remove()
+	for {
+		select {
+		case reply, ok := <-replies:
+			if !ok {
+				return ErrSlowTxnEventsSubscriber
+			}
+			if err := stream.Send(reply); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		}
+	}
+}
+
+func mapTxnStatusToProto(status TxnStatus) txpool_proto.TxnLifecycle_Status {
+	switch status {
+	case TxnStatusPending:
+		return txpool_proto.TxnLifecycle_PENDING
+	case TxnStatusBaseFee:
+		return txpool_proto.TxnLifecycle_BASE_FEE
+	case TxnStatusQueued:
+		return txpool_proto.TxnLifecycle_QUEUED
+	case TxnStatusDropped:
+		return txpool_proto.TxnLifecycle_DROPPED
+	case TxnStatusMined:
+		return txpool_proto.TxnLifecycle_MINED
+	default:
+		return txpool_proto.TxnLifecycle_UNKNOWN
+	}
+}
+
 // NewSlotsStreams - it's safe to use this class as non-pointer
 type NewSlotsStreams struct {
 	chans map[uint]txpool_proto.Txpool_OnAddServer
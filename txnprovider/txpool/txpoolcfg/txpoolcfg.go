@@ -31,7 +31,7 @@ const BorDefaultTxPoolPriceLimit = 25 * common.GWei
 
 type Config struct {
 	Disable             bool
-	DBDir               string
+	DBDir               string   // sidecars of blob txns are kept in its "blobs" sub-directory, in memory if empty
 	TracedSenders       []string // List of senders for which txn pool should print out debugging info
 	PendingSubPoolLimit int
 	BaseFeeSubPoolLimit int
@@ -43,12 +43,19 @@ type Config struct {
 	PriceBump           uint64 // Price bump percentage to replace an already existing transaction
 	BlobPriceBump       uint64 //Price bump percentage to replace an existing 4844 blob txn (type-3)
 	OverridePragueTime  *big.Int
+	LocalJournalLimit   int // Max number of local txns retained regardless of sub-pool limits, until mined or replaced
+
+	// local block building
+	OrderingPolicy string           // order in which pending txns are offered for block building: TipOrdering, FifoOrdering, SenderFairOrdering
+	AllowedSenders []common.Address // if not empty - only txns of these senders are offered for block building
+	DeniedSenders  []common.Address // txns of these senders are never offered for block building
 
 	// regular batch tasks processing
 	SyncToNewPeersEvery    time.Duration
 	ProcessRemoteTxnsEvery time.Duration
 	CommitEvery            time.Duration
 	LogEvery               time.Duration
+	RebroadcastLocalsEvery time.Duration // 0 - disables periodic re-broadcast of journaled local txns
 
 	//txpool db
 	MdbxPageSize    datasize.ByteSize
@@ -59,11 +66,18 @@ type Config struct {
 	NoGossip bool // this mode doesn't broadcast any txns, and if receive remote-txn - skip it
 }
 
+const (
+	TipOrdering        = "tip"         // highest effective tip first
+	FifoOrdering       = "fifo"        // in order of arrival to the pool
+	SenderFairOrdering = "sender-fair" // round-robin between senders
+)
+
 var DefaultConfig = Config{
 	SyncToNewPeersEvery:    5 * time.Second,
 	ProcessRemoteTxnsEvery: 100 * time.Millisecond,
 	CommitEvery:            15 * time.Second,
 	LogEvery:               30 * time.Second,
+	RebroadcastLocalsEvery: time.Minute,
 
 	PendingSubPoolLimit: 10_000,
 	BaseFeeSubPoolLimit: 30_000,
@@ -75,6 +89,8 @@ var DefaultConfig = Config{
 	TotalBlobPoolLimit: 480, // Default for a total of 10 different accounts hitting the above limit
 	PriceBump:          10,  // Price bump percentage to replace an already existing transaction
 	BlobPriceBump:      100,
+	LocalJournalLimit:  4096,
+	OrderingPolicy:     TipOrdering,
 
 	NoGossip:     false,
 	MdbxWriteMap: false,
@@ -118,6 +134,9 @@ const (
 	NoAuthorizations     DiscardReason = 32 // EIP-7702 transactions with an empty authorization list are invalid
 	GasLimitTooHigh      DiscardReason = 33 // Gas limit is too high
 	ErrAuthorityReserved DiscardReason = 34 // EIP-7702 transaction with authority already reserved
+	BlobWrapperVersion   DiscardReason = 35 // EIP-7594 cell proofs are required after Osaka activation, and blob proofs before it
+	BlobPoolEvicted      DiscardReason = 36 // Evicted from the full blob pool by a blob txn paying a higher blob fee
+	AlreadyPublic        DiscardReason = 37 // A txn submitted as private is already known to the pool as a public one
 )
 
 func (r DiscardReason) String() string {
@@ -192,6 +211,12 @@ func (r DiscardReason) String() string {
 		return "blob_versioned_hashes, blobs, commitments and proofs must have equal number"
 	case ErrAuthorityReserved:
 		return "EIP-7702 transaction with authority already reserved"
+	case BlobWrapperVersion:
+		return "blob transaction wrapper version doesn't match the active fork"
+	case BlobPoolEvicted:
+		return "evicted from full blob pool by transaction with higher blob fee"
+	case AlreadyPublic:
+		return "transaction is already known and may have been gossiped, it can't be made private"
 	default:
 		panic(fmt.Sprintf("discard reason: %d", r))
 	}